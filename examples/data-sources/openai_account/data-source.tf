@@ -0,0 +1,12 @@
+# Fail fast with a clear error if the provider's key is rejected
+data "openai_account" "current" {}
+
+resource "openai_project" "example" {
+  name = "Example Project"
+
+  depends_on = [data.openai_account.current]
+}
+
+output "organization_id" {
+  value = data.openai_account.current.organization_id
+}
@@ -0,0 +1,14 @@
+# Find admin API keys that have not been used since a given time
+variable "stale_before" {
+  description = "RFC 3339 timestamp; keys last used before it are reported as stale"
+  type        = string
+}
+
+data "openai_admin_api_keys" "all" {}
+
+output "stale_api_keys" {
+  value = [
+    for k in data.openai_admin_api_keys.all.api_keys : k.name
+    if k.last_used_at == null || timecmp(k.last_used_at, var.stale_before) < 0
+  ]
+}
@@ -0,0 +1,33 @@
+# Retrieve a stored chat completion by ID
+data "openai_chat_completion" "stored" {
+  completion_id = "chatcmpl-abc123"
+}
+
+# Generate a completion at plan time. The model is called on every plan and
+# apply, so the content can change from run to run.
+data "openai_chat_completion" "codename" {
+  model = "gpt-4o-mini"
+
+  messages = [
+    {
+      role    = "user"
+      content = "Suggest a two-word codename for an internal billing service. Reply with the codename only."
+    },
+  ]
+
+  max_tokens = 16
+}
+
+# Keep the first generated value: later runs still call the model, but the
+# stored input is never updated.
+resource "terraform_data" "codename" {
+  input = data.openai_chat_completion.codename.content
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
+
+output "codename" {
+  value = terraform_data.codename.output
+}
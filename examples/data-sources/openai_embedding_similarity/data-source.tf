@@ -0,0 +1,27 @@
+# Compare two texts directly
+data "openai_embedding_similarity" "titles" {
+  model  = "text-embedding-3-small"
+  inputs = ["How do I reset my password?", "Steps to recover account access"]
+}
+
+# Or compare embeddings already created with openai_embedding
+resource "openai_embedding" "faq" {
+  model = "text-embedding-3-small"
+  input = "How do I reset my password?"
+}
+
+resource "openai_embedding" "article" {
+  model = "text-embedding-3-small"
+  input = "Steps to recover account access"
+}
+
+data "openai_embedding_similarity" "stored" {
+  vectors = [
+    jsondecode(openai_embedding.faq.embedding),
+    jsondecode(openai_embedding.article.embedding),
+  ]
+}
+
+output "titles_similarity" {
+  value = data.openai_embedding_similarity.titles.similarity
+}
@@ -0,0 +1,20 @@
+# List the checkpoints saved during a fine-tuning job
+data "openai_fine_tuning_checkpoints" "example" {
+  fine_tuning_job_id = openai_fine_tuning_job.example.id
+}
+
+# Pick the checkpoint with the lowest full validation loss
+locals {
+  scored = [
+    for c in data.openai_fine_tuning_checkpoints.example.checkpoints : c
+    if c.metrics != null && c.metrics.full_valid_loss != null
+  ]
+  best_checkpoint = length(local.scored) > 0 ? [
+    for c in local.scored : c.fine_tuned_model_checkpoint
+    if c.metrics.full_valid_loss == min([for s in local.scored : s.metrics.full_valid_loss]...)
+  ][0] : null
+}
+
+output "best_checkpoint_model" {
+  value = local.best_checkpoint
+}
@@ -0,0 +1,20 @@
+# Read the full event log of a fine-tuning job
+data "openai_fine_tuning_events" "all" {
+  fine_tuning_job_id = openai_fine_tuning_job.example.id
+}
+
+# Only the errors, e.g. to debug a job stuck in "running"
+data "openai_fine_tuning_events" "errors" {
+  fine_tuning_job_id = openai_fine_tuning_job.example.id
+  level              = "error"
+}
+
+# The ten most recent events
+data "openai_fine_tuning_events" "recent" {
+  fine_tuning_job_id = openai_fine_tuning_job.example.id
+  limit              = 10
+}
+
+output "fine_tuning_errors" {
+  value = [for e in data.openai_fine_tuning_events.errors.events : e.message]
+}
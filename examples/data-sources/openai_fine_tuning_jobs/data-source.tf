@@ -1,7 +1,16 @@
 # List all fine-tuning jobs
-data "openai_fine_tuning_jobs" "all" {
-  # Optional: Limit the number of jobs returned
-  # limit = 20
+data "openai_fine_tuning_jobs" "all" {}
+
+# The 20 most recent jobs
+data "openai_fine_tuning_jobs" "recent" {
+  limit = 20
+}
+
+# Jobs tagged with a given metadata value
+data "openai_fine_tuning_jobs" "experiment" {
+  metadata = {
+    experiment_id = "EXP-2024-001"
+  }
 }
 
 # Output total job count
@@ -9,3 +18,10 @@ output "total_jobs" {
   value = length(data.openai_fine_tuning_jobs.all.jobs)
 }
 
+# Jobs that produced a model, e.g. to audit or import them
+output "fine_tuned_models" {
+  value = {
+    for j in data.openai_fine_tuning_jobs.all.jobs : j.id => j.fine_tuned_model
+    if j.status == "succeeded"
+  }
+}
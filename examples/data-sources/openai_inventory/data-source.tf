@@ -0,0 +1,11 @@
+# List every project, vector store and file in the organization
+data "openai_inventory" "all" {}
+
+# List only vector stores and files
+data "openai_inventory" "storage" {
+  types = ["vector_store", "file"]
+}
+
+output "vector_store_ids" {
+  value = [for i in data.openai_inventory.all.items : i.id if i.type == "vector_store"]
+}
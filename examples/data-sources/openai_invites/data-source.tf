@@ -1,8 +1,18 @@
-# List all invites (no filtering arguments supported)
+# List all invites
 data "openai_invites" "all" {
 }
 
+# List only invites that have not been accepted yet
+data "openai_invites" "pending" {
+  status = "pending"
+}
+
 # Output total invites count
 output "total_invites" {
   value = length(data.openai_invites.all.invites)
 }
+
+# Emails still waiting on an invite
+output "pending_emails" {
+  value = [for i in data.openai_invites.pending.invites : i.email]
+}
@@ -0,0 +1,14 @@
+# Resolve the alias to the snapshot it points at today
+data "openai_model_snapshot" "gpt4o" {
+  alias = "gpt-4o"
+}
+
+# Pin the snapshot so a later alias update does not change behaviour
+resource "openai_response" "summary" {
+  model = data.openai_model_snapshot.gpt4o.snapshot
+  input = "Summarize the release notes."
+}
+
+output "gpt4o_snapshot" {
+  value = data.openai_model_snapshot.gpt4o.snapshot
+}
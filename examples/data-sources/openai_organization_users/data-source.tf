@@ -1,10 +1,30 @@
 # List all users in the organization
 data "openai_organization_users" "all" {}
 
-# List all organization users without filtering
-data "openai_organization_users" "everyone" {}
+# Resolve organization user IDs by email
+data "openai_organization_users" "team" {
+  emails = ["alice@example.com", "bob@example.com"]
+}
+
+# Only the organization's owners
+data "openai_organization_users" "owners" {
+  role = "owner"
+}
+
+data "openai_project_role" "member" {
+  project_id = "proj_abc123"
+  name       = "member"
+}
+
+resource "openai_project_user" "team" {
+  for_each = { for u in data.openai_organization_users.team.users : u.email => u.id }
+
+  project_id = "proj_abc123"
+  user_id    = each.value
+  role_ids   = [data.openai_project_role.member.id]
+}
 
 # Output user statistics
 output "all_users_count" {
-  value = length(data.openai_organization_users.everyone.users)
+  value = length(data.openai_organization_users.all.users)
 }
@@ -0,0 +1,8 @@
+data "openai_project_service_accounts" "bots" {
+  project_id = "proj_abc123"
+}
+
+# Audit the non-human identities in the project
+output "service_account_names" {
+  value = [for sa in data.openai_project_service_accounts.bots.service_accounts : sa.name]
+}
@@ -0,0 +1,15 @@
+# Look up a project member by email
+data "openai_project_user" "alice" {
+  project_id = "proj-abc123"
+  email      = "alice@example.com"
+}
+
+# Look up a project member by user ID
+data "openai_project_user" "bob" {
+  project_id = "proj-abc123"
+  user_id    = "user-abc123"
+}
+
+output "alice_user_id" {
+  value = data.openai_project_user.alice.user_id
+}
@@ -1,7 +1,7 @@
 # List all projects in the organization
 data "openai_projects" "all" {
-  # Optional: Set include_archived to true to include archived projects
-  # include_archived = true
+  # Optional: "active" (default), "archived" or "all"
+  # status = "all"
 }
 
 # Output total project count
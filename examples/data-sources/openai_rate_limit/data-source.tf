@@ -0,0 +1,10 @@
+# Read one rate limit of a project. Model names match exactly, so this
+# never returns the limit of gpt-4o-mini-2024-07-18.
+data "openai_rate_limit" "mini" {
+  project_id = "proj_abc123"
+  model      = "gpt-4o-mini"
+}
+
+output "mini_tokens_per_minute" {
+  value = data.openai_rate_limit.mini.max_tokens_per_minute
+}
@@ -0,0 +1,18 @@
+# Halve the default request limit of gpt-4o-mini for a sandbox project
+data "openai_rate_limit_defaults" "gpt_4o_mini" {
+  model = "gpt-4o-mini"
+}
+
+resource "openai_rate_limit" "sandbox" {
+  project_id              = "proj_abc123"
+  model                   = "gpt-4o-mini"
+  max_requests_per_minute = floor(data.openai_rate_limit_defaults.gpt_4o_mini.max_requests_per_minute / 2)
+  max_tokens_per_minute   = data.openai_rate_limit_defaults.gpt_4o_mini.max_tokens_per_minute
+}
+
+# List the defaults of every known model
+data "openai_rate_limit_defaults" "all" {}
+
+output "default_requests_per_minute" {
+  value = { for m in data.openai_rate_limit_defaults.all.models : m.model => m.max_requests_per_minute }
+}
@@ -0,0 +1,11 @@
+# Effective rate limits for every known model in a project, with the
+# provider's defaults filled in for models that have no explicit limit
+data "openai_rate_limits" "production" {
+  project_id       = "proj_abc123"
+  include_defaults = true
+}
+
+# Models whose limits have been changed from the defaults
+output "customized_models" {
+  value = [for rl in data.openai_rate_limits.production.rate_limits : rl.model if !rl.is_default]
+}
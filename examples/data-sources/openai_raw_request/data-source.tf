@@ -0,0 +1,24 @@
+# Read an endpoint the provider does not model yet
+data "openai_raw_request" "model" {
+  method = "GET"
+  path   = "models/gpt-4o"
+}
+
+output "model_owner" {
+  value = jsondecode(data.openai_raw_request.model.response_body).owned_by
+}
+
+# POSTs are sent on every plan and refresh, so they must be allowed explicitly
+data "openai_raw_request" "moderation" {
+  method             = "POST"
+  path               = "moderations"
+  allow_side_effects = true
+  body = jsonencode({
+    model = "omni-moderation-latest"
+    input = "Terraform is great."
+  })
+}
+
+output "flagged" {
+  value = jsondecode(data.openai_raw_request.moderation.response_body).results[0].flagged
+}
@@ -0,0 +1,12 @@
+# Read a response generated outside this configuration
+data "openai_response" "summary" {
+  response_id = "resp_abc123"
+}
+
+output "summary_text" {
+  value = data.openai_response.summary.content
+}
+
+output "summary_tokens" {
+  value = data.openai_response.summary.usage.total_tokens
+}
@@ -0,0 +1,15 @@
+# Daily completions usage and spend of one project for October 2025
+data "openai_usage" "october" {
+  start_time   = 1759276800 # 2025-10-01T00:00:00Z
+  end_time     = 1761955200 # 2025-11-01T00:00:00Z
+  bucket_width = "1d"
+  project_ids  = ["proj_abc123"]
+}
+
+output "october_spend" {
+  value = "${data.openai_usage.october.total_cost} ${data.openai_usage.october.currency}"
+}
+
+output "daily_requests" {
+  value = { for b in data.openai_usage.october.buckets : b.start_time => b.num_model_requests }
+}
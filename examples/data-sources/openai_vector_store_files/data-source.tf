@@ -0,0 +1,15 @@
+# List every file in a vector store
+data "openai_vector_store_files" "all" {
+  vector_store_id = openai_vector_store.docs.id
+}
+
+# List only files that failed to index
+data "openai_vector_store_files" "failed" {
+  vector_store_id = openai_vector_store.docs.id
+  filter          = "failed"
+}
+
+# Surface failed files so they can be re-uploaded
+output "failed_file_ids" {
+  value = [for f in data.openai_vector_store_files.failed.files : f.file_id]
+}
@@ -0,0 +1,21 @@
+# Check that a freshly indexed store returns the expected document
+data "openai_vector_store_search" "refunds" {
+  vector_store_id = openai_vector_store.support.id
+  query           = "How long do customers have to request a refund?"
+  max_num_results = 5
+
+  # Only search files tagged for the support team
+  filters = jsonencode({
+    type  = "eq"
+    key   = "team"
+    value = "support"
+  })
+
+  ranking_options = {
+    score_threshold = 0.5
+  }
+}
+
+output "top_match" {
+  value = try(data.openai_vector_store_search.refunds.results[0].filename, null)
+}
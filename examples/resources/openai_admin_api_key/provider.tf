@@ -3,6 +3,9 @@ terraform {
     openai = {
       source = "mkdev-me/openai"
     }
+    time = {
+      source = "hashicorp/time"
+    }
   }
 }
 
@@ -26,6 +26,19 @@ resource "openai_admin_api_key" "org_admin" {
   # expires_at = 1735689599  # 2024-12-31T23:59:59Z
 }
 
+# Reissue a key every 90 days: time_rotating moves expires_at forward, and
+# recreate_on_expiry replaces the key if an apply comes after it has expired.
+resource "time_rotating" "ci_key" {
+  rotation_days = 90
+}
+
+resource "openai_admin_api_key" "ci" {
+  name               = "ci-admin-key"
+  scopes             = ["api.management.read"]
+  expires_at         = time_rotating.ci_key.unix + 90 * 24 * 60 * 60
+  recreate_on_expiry = true
+}
+
 # Output the created admin API key ID
 output "admin_key_id" {
   value = openai_admin_api_key.org_admin.id
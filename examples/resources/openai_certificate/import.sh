@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing organization certificate by its ID
+terraform import openai_certificate.example cert_abc123
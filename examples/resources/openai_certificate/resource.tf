@@ -0,0 +1,18 @@
+# Upload a client certificate for mutual TLS and activate it for the whole
+# organization. Requires an admin API key.
+resource "openai_certificate" "gateway" {
+  name    = "gateway-client"
+  content = file("${path.module}/certs/gateway.pem")
+  active  = true
+}
+
+# Activate a certificate only for selected projects.
+resource "openai_certificate" "batch_jobs" {
+  name        = "batch-jobs"
+  content     = file("${path.module}/certs/batch.pem")
+  project_ids = ["proj_abc123"]
+}
+
+output "gateway_certificate_expires_at" {
+  value = openai_certificate.gateway.expires_at
+}
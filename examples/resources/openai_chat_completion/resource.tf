@@ -1,15 +1,16 @@
 resource "openai_chat_completion" "example" {
   model = "gpt-4o-mini"
 
-  messages {
-    role    = "system"
-    content = "You are a helpful assistant."
-  }
-
-  messages {
-    role    = "user"
-    content = "Hello! What's the weather like today?"
-  }
+  messages = [
+    {
+      role    = "system"
+      content = "You are a helpful assistant."
+    },
+    {
+      role    = "user"
+      content = "Hello! What's the weather like today?"
+    },
+  ]
 
   temperature = 0.7
   max_tokens  = 150
@@ -18,3 +19,39 @@ resource "openai_chat_completion" "example" {
 output "chat_response" {
   value = openai_chat_completion.example.choices[0].message[0].content
 }
+
+# Let the model call a function; the requested calls are recorded in
+# choices[0].message[0].tool_calls.
+resource "openai_chat_completion" "weather" {
+  model = "gpt-4o-mini"
+
+  messages = [
+    {
+      role    = "user"
+      content = "What's the weather like in Paris?"
+    },
+  ]
+
+  tools = [
+    {
+      type = "function"
+      function = [
+        {
+          name        = "get_weather"
+          description = "Get the current weather for a city."
+          parameters = jsonencode({
+            type       = "object"
+            properties = { city = { type = "string" } }
+            required   = ["city"]
+          })
+        },
+      ]
+    },
+  ]
+
+  tool_choice = "get_weather"
+}
+
+output "weather_tool_calls" {
+  value = openai_chat_completion.weather.choices[0].message[0].tool_calls
+}
@@ -0,0 +1,25 @@
+# Generate text with an instruct model through the legacy Completions API.
+resource "openai_completion" "tagline" {
+  model       = "gpt-3.5-turbo-instruct"
+  prompt      = "Write a one-line tagline for a Terraform provider:"
+  max_tokens  = 32
+  temperature = 0.7
+  stop        = ["\n"]
+}
+
+# Request token log probabilities to inspect how confident the model was.
+resource "openai_completion" "classification" {
+  model       = "davinci-002"
+  prompt      = "Sentiment of \"I love this!\" (positive/negative):"
+  max_tokens  = 1
+  temperature = 0
+  logprobs    = 2
+}
+
+output "tagline" {
+  value = openai_completion.tagline.choices[0].text
+}
+
+output "classification_top_logprobs" {
+  value = openai_completion.classification.choices[0].logprobs.top_logprobs
+}
@@ -68,6 +68,16 @@ resource "openai_fine_tuning_job" "reproducible_model" {
   }
 }
 
+# Wait for training to finish so the fine-tuned model can be used in the
+# same apply
+resource "openai_fine_tuning_job" "waited_model" {
+  training_file = openai_file.training_data.id
+  model         = "gpt-4o-mini-2024-07-18"
+
+  wait_for_completion = true
+  poll_interval       = 60
+}
+
 # Output the fine-tuning job details
 output "fine_tuning_job_id" {
   value       = openai_fine_tuning_job.custom_model.id
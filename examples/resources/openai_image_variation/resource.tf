@@ -50,6 +50,9 @@ resource "openai_image_variation" "base64_variants" {
   n               = 2
   size            = "256x256"
   response_format = "b64_json" # Returns base64 encoded images
+
+  # Save the variations as img-var-<created>-<index>.png
+  output_dir = "${path.module}/variations"
 }
 
 # Output the first variation URL
@@ -8,6 +8,30 @@ resource "openai_project" "production" {
   name = "Production API Services"
 }
 
+# Create a project that starts with the organization's standard limits
+resource "openai_project" "governed" {
+  name = "Team Sandbox"
+
+  default_rate_limits = [
+    {
+      model                   = "gpt-4o"
+      max_requests_per_minute = 500
+      max_tokens_per_minute   = 30000
+    },
+    {
+      model                  = "gpt-4o-mini"
+      max_tokens_per_minute  = 200000
+      max_requests_per_1_day = 10000
+    },
+  ]
+}
+
+# Take over a project created outside Terraform, or create it if it is missing
+resource "openai_project" "shared" {
+  name           = "Shared Services"
+  adopt_existing = true
+}
+
 # Output the project ID
 output "dev_project_id" {
   value       = openai_project.development.id
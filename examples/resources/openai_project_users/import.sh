@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import the membership of an existing project by its ID
+terraform import openai_project_users.production proj_abc123def456
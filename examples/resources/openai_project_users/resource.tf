@@ -0,0 +1,28 @@
+resource "openai_project" "production" {
+  name = "Production API"
+}
+
+# Manage the complete membership of the project. Users not listed here are
+# removed; organization owners are never touched.
+resource "openai_project_users" "production" {
+  project_id = openai_project.production.id
+
+  users = [
+    {
+      email = "alice@example.com"
+      role  = "owner"
+    },
+    {
+      email = "bob@example.com"
+      role  = "member"
+    },
+    {
+      user_id = "user-abc123"
+      role    = "member"
+    },
+  ]
+}
+
+output "alice_user_id" {
+  value = openai_project_users.production.user_ids["alice@example.com"]
+}
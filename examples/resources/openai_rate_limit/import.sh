@@ -0,0 +1,6 @@
+#!/bin/bash
+# Import a project's rate limit by project ID and model
+terraform import openai_rate_limit.gpt4o proj_abc123def456:gpt-4o
+
+# Or by project ID and rate limit ID
+terraform import openai_rate_limit.gpt4o proj_abc123def456:rl-gpt-4o
@@ -48,11 +48,53 @@ resource "openai_response" "full_example" {
   tool_choice         = "auto"
   parallel_tool_calls = true
 
-  # Output Format
-  response_format = "text" # or json_object, json_schema
-  include         = ["web_search_call.action.sources"]
+  include = ["web_search_call.action.sources"]
 }
 
 output "full_example_output" {
   value = openai_response.full_example.content
 }
+
+# Structured Outputs: the content is JSON matching the schema
+resource "openai_response" "structured_example" {
+  model = "gpt-5.2"
+  input = "Extract the city and country from: 'I flew into Lisbon last week.'"
+
+  response_format = {
+    type   = "json_schema"
+    name   = "location"
+    strict = true
+    schema = jsonencode({
+      type = "object"
+      properties = {
+        city    = { type = "string" }
+        country = { type = "string" }
+      }
+      required             = ["city", "country"]
+      additionalProperties = false
+    })
+  }
+}
+
+output "structured_example_city" {
+  value = jsondecode(openai_response.structured_example.content).city
+}
+
+# Long reasoning task run in background mode; apply waits for it to finish
+resource "openai_response" "background_example" {
+  model            = "o3"
+  input            = "Write a detailed proof that there are infinitely many primes."
+  reasoning_effort = "high"
+  background       = true
+}
+
+# Long generation streamed over server-sent events
+resource "openai_response" "stream_example" {
+  model  = "gpt-5.2"
+  input  = "Draft a long-form onboarding guide for new engineers."
+  stream = true
+}
+
+output "stream_example_tokens" {
+  value = openai_response.stream_example.usage.total_tokens
+}
@@ -59,3 +59,9 @@ output "knowledge_base_id" {
   value       = openai_vector_store.knowledge_base.id
   description = "The ID of the knowledge base vector store"
 }
+
+# Reuse the store named "Product Manuals" if it already exists
+resource "openai_vector_store" "manuals" {
+  name           = "Product Manuals"
+  adopt_existing = true
+}
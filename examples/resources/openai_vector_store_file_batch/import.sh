@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import a file batch by vector store ID and batch ID
+terraform import openai_vector_store_file_batch.documentation_batch vs_abc123:vsfb_abc123
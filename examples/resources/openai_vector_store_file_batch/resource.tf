@@ -56,6 +56,9 @@ resource "openai_vector_store_file_batch" "documentation_batch" {
     type                  = "static"
     max_chunk_size_tokens = 600
   }
+
+  # Optional: Block until every file has been indexed
+  wait_for_completion = true
 }
 
 # Example: Knowledge base with mixed content types
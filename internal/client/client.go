@@ -1,28 +1,50 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
-	"regexp"
+	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
+
+	"github.com/mkdev-me/terraform-provider-openai/internal/version"
 )
 
 // OpenAIClient is a client for interacting with the OpenAI API
 type OpenAIClient struct {
 	APIKey         string
 	OrganizationID string
+	ProjectID      string // Sent as OpenAI-Project when set
 	APIURL         string
 	HTTPClient     *http.Client
-	Timeout        time.Duration // Timeout for all requests
+	Timeout        time.Duration     // Timeout for all requests
+	DryRun         bool              // Requests are answered by DryRunTransport instead of sent
+	DefaultHeaders map[string]string // Extra headers sent on every request; never replaces Authorization
+	UserAgent      string            // Sent as User-Agent; DefaultHeaders can override it
+	TLSConfig      *tls.Config       // Custom CA or skip-verify for proxies; nil keeps Go's defaults
+	// MaxResponseBytes caps response text stored in state; 0 means no limit.
+	// See TruncateForState.
+	MaxResponseBytes int
+}
+
+// UserAgent returns the User-Agent the client sends, naming the provider and
+// Go versions so a request can be traced back to the build that made it.
+func UserAgent() string {
+	return fmt.Sprintf("terraform-provider-openai/%s (go/%s)", version.Version, runtime.Version())
 }
 
 // NewClient creates a new instance of the OpenAI client
@@ -68,7 +90,8 @@ func NewClient(apiKey, organizationID, apiURL string) *OpenAIClient {
 			Transport: transport,
 			Timeout:   defaultTimeout,
 		},
-		Timeout: defaultTimeout,
+		Timeout:   defaultTimeout,
+		UserAgent: UserAgent(),
 	}
 
 	return client
@@ -76,10 +99,15 @@ func NewClient(apiKey, organizationID, apiURL string) *OpenAIClient {
 
 // ClientConfig contains configuration options for the OpenAI client
 type ClientConfig struct {
-	APIKey         string
-	OrganizationID string
-	APIURL         string
-	Timeout        time.Duration // Timeout for all operations
+	APIKey           string
+	OrganizationID   string
+	ProjectID        string
+	APIURL           string
+	Timeout          time.Duration     // Timeout for all operations
+	DryRun           bool              // Answer requests locally with DryRunTransport
+	DefaultHeaders   map[string]string // Extra headers sent on every request
+	TLSConfig        *tls.Config       // See NewTLSConfig
+	MaxResponseBytes int               // See TruncateForState; 0 means no limit
 }
 
 // NewClientWithConfig creates a new instance of the OpenAI client with custom configuration
@@ -121,16 +149,95 @@ func NewClientWithConfig(config ClientConfig) *OpenAIClient {
 		MaxIdleConnsPerHost:   10,
 	}
 
+	if config.TLSConfig != nil {
+		transport.TLSClientConfig = config.TLSConfig.Clone()
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if config.DryRun {
+		roundTripper = &DryRunTransport{}
+	}
+	if len(config.DefaultHeaders) > 0 {
+		// Requests built outside the client and sent with Do carry the
+		// headers too, but only towards the API host.
+		if u, err := url.Parse(config.APIURL); err == nil {
+			roundTripper = &DefaultHeadersTransport{
+				Base:    roundTripper,
+				Host:    u.Host,
+				Headers: config.DefaultHeaders,
+			}
+		}
+	}
+
 	return &OpenAIClient{
 		APIKey:         config.APIKey,
 		OrganizationID: config.OrganizationID,
+		ProjectID:      config.ProjectID,
 		APIURL:         config.APIURL,
 		HTTPClient: &http.Client{
-			Transport: transport,
+			Transport: roundTripper,
 			Timeout:   config.Timeout,
 		},
-		Timeout: config.Timeout,
+		Timeout:          config.Timeout,
+		DryRun:           config.DryRun,
+		DefaultHeaders:   config.DefaultHeaders,
+		UserAgent:        UserAgent(),
+		TLSConfig:        config.TLSConfig,
+		MaxResponseBytes: config.MaxResponseBytes,
+	}
+}
+
+// TruncateForState cuts s to MaxResponseBytes, backing off to a UTF-8
+// character boundary, so generated text such as completions cannot bloat
+// Terraform state. It reports whether s was cut. Response bodies are always
+// read and parsed in full; only the values copied into state are limited.
+func (c *OpenAIClient) TruncateForState(s string) (string, bool) {
+	if c.MaxResponseBytes <= 0 || len(s) <= c.MaxResponseBytes {
+		return s, false
+	}
+	n := c.MaxResponseBytes
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n], true
+}
+
+// SetDefaultHeaders adds the User-Agent and the configured default headers to
+// req. Authorization is skipped so a gateway header can never replace the API
+// key.
+func (c *OpenAIClient) SetDefaultHeaders(req *http.Request) {
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	setHeaders(req.Header, c.DefaultHeaders)
+}
+
+func setHeaders(h http.Header, headers map[string]string) {
+	for name, value := range headers {
+		if http.CanonicalHeaderKey(name) == "Authorization" {
+			continue
+		}
+		h.Set(name, value)
+	}
+}
+
+// DefaultHeadersTransport adds Headers to requests for Host, covering
+// requests built outside the client. Requests to other hosts, such as
+// downloads of generated files, pass through untouched so gateway
+// credentials are not sent elsewhere.
+type DefaultHeadersTransport struct {
+	Base    http.RoundTripper
+	Host    string
+	Headers map[string]string
+}
+
+func (t *DefaultHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.Host || len(t.Headers) == 0 {
+		return t.Base.RoundTrip(req)
 	}
+	req = req.Clone(req.Context())
+	setHeaders(req.Header, t.Headers)
+	return t.Base.RoundTrip(req)
 }
 
 // SetTimeout updates the timeout for the client
@@ -139,14 +246,37 @@ func (c *OpenAIClient) SetTimeout(timeout time.Duration) {
 	c.HTTPClient.Timeout = timeout
 }
 
+// Do sends a request built outside the client through HTTPClient, so it
+// shares the client's transport (TLS settings, dry run) rather than
+// http.DefaultTransport. The caller sets authentication and reads the
+// response as with http.Client.Do.
+//
+// The User-Agent and default headers are set, and 429 and transient 5xx
+// responses retried, as on the client's own requests. ProjectID is sent as
+// OpenAI-Project unless the request sets its own or is authenticated with an
+// admin key, whose calls are organization-wide.
+func (c *OpenAIClient) Do(req *http.Request) (*http.Response, error) {
+	c.SetDefaultHeaders(req)
+	if c.ProjectID != "" && req.Header.Get("OpenAI-Project") == "" {
+		key := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if KeyScope(key) != KeyScopeAdmin {
+			req.Header.Set("OpenAI-Project", c.ProjectID)
+		}
+	}
+	return c.send(req)
+}
+
 // Project represents a project in OpenAI
 type Project struct {
-	Object     string `json:"object"`
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	CreatedAt  *int64 `json:"created_at"`
-	ArchivedAt *int64 `json:"archived_at"`
-	Status     string `json:"status"`
+	Object         string  `json:"object"`
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	IsDefault      *bool   `json:"is_default,omitempty"`
+	OrganizationID *string `json:"organization_id,omitempty"`
+	CreatedAt      *int64  `json:"created_at"`
+	ArchivedAt     *int64  `json:"archived_at"`
+	Status         string  `json:"status"`
 }
 
 // ProjectUser represents a user associated with a project
@@ -158,17 +288,19 @@ type ProjectUser struct {
 	AddedAt int64  `json:"added_at"`
 }
 
-// RateLimit represents a rate limit configuration for a project
+// RateLimit represents a rate limit configuration for a project.
+// The optional limits are pointers because the API omits them for models
+// they don't apply to; an absent key must stay nil rather than decode as 0.
 type RateLimit struct {
 	ID                          string `json:"id"`
 	Object                      string `json:"object"`
 	Model                       string `json:"model"`
 	MaxRequestsPer1Minute       int    `json:"max_requests_per_1_minute"`
 	MaxTokensPer1Minute         int    `json:"max_tokens_per_1_minute"`
-	MaxImagesPer1Minute         int    `json:"max_images_per_1_minute"`
-	Batch1DayMaxInputTokens     int    `json:"batch_1_day_max_input_tokens"`
-	MaxAudioMegabytesPer1Minute int    `json:"max_audio_megabytes_per_1_minute"`
-	MaxRequestsPer1Day          int    `json:"max_requests_per_1_day"`
+	MaxImagesPer1Minute         *int   `json:"max_images_per_1_minute,omitempty"`
+	Batch1DayMaxInputTokens     *int   `json:"batch_1_day_max_input_tokens,omitempty"`
+	MaxAudioMegabytesPer1Minute *int   `json:"max_audio_megabytes_per_1_minute,omitempty"`
+	MaxRequestsPer1Day          *int   `json:"max_requests_per_1_day,omitempty"`
 }
 
 // RateLimitListResponse represents the response from the API when listing rate limits
@@ -205,6 +337,88 @@ type ErrorResponse struct {
 	} `json:"error"`
 }
 
+// APIError is returned by DoRequest and DoRequestContext when the API
+// responds with an error status. Message and the other error fields are
+// empty when the body was not an OpenAI error object.
+type APIError struct {
+	StatusCode int
+	Type       string
+	Code       string
+	Param      string
+	Message    string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// newAPIError builds an APIError from an error response, filling in the
+// OpenAI error fields when body holds an error object.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(body)}
+	var errorResp ErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		apiErr.Type = errorResp.Error.Type
+		apiErr.Code = errorResp.Error.Code
+		apiErr.Param = errorResp.Error.Param
+		apiErr.Message = errorResp.Error.Message
+	}
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// API key scopes, as told apart by KeyScope.
+const (
+	KeyScopeAdmin   = "admin"
+	KeyScopeProject = "project"
+)
+
+// KeyScope classifies an API key by its prefix: KeyScopeAdmin for
+// "sk-admin-" keys, KeyScopeProject for project ("sk-proj-") and service
+// account ("sk-svcacct-") keys, and "" for anything else, such as legacy
+// user keys, whose scope can only be learned from the API.
+func KeyScope(key string) string {
+	switch {
+	case strings.HasPrefix(key, "sk-admin-"):
+		return KeyScopeAdmin
+	case strings.HasPrefix(key, "sk-proj-"), strings.HasPrefix(key, "sk-svcacct-"):
+		return KeyScopeProject
+	default:
+		return ""
+	}
+}
+
+// KeyScopeError is returned, before any request is sent, when an operation
+// needs a key of a different scope than the one the client holds.
+type KeyScopeError struct {
+	Operation string
+	Required  string
+	Actual    string
+}
+
+func (e *KeyScopeError) Error() string {
+	return fmt.Sprintf("%s requires an %s API key, but the configured key is a %s key", e.Operation, e.Required, e.Actual)
+}
+
+// requireAdminKey returns a *KeyScopeError for operation when the client's
+// key is recognisably not an admin key. Keys of unknown scope are let
+// through for the API to judge.
+func (c *OpenAIClient) requireAdminKey(operation string) error {
+	if scope := KeyScope(c.APIKey); scope != "" && scope != KeyScopeAdmin {
+		return &KeyScopeError{Operation: operation, Required: KeyScopeAdmin, Actual: scope}
+	}
+	return nil
+}
+
 // ListProjectsResponse represents the response from the API when listing projects
 type ListProjectsResponse struct {
 	Object  string    `json:"object"`
@@ -238,6 +452,8 @@ type AdminAPIKeyResponse struct {
 type ListAPIKeysResponse struct {
 	Object  string        `json:"object"`
 	Data    []AdminAPIKey `json:"data"`
+	FirstID string        `json:"first_id,omitempty"`
+	LastID  string        `json:"last_id,omitempty"`
 	HasMore bool          `json:"has_more"`
 }
 
@@ -248,6 +464,46 @@ type CreateAPIKeyRequest struct {
 	Scopes    []string `json:"scopes,omitempty"`
 }
 
+// Certificate is a client certificate uploaded to the organization for
+// mutual TLS. Active is only reported by the list endpoints.
+type Certificate struct {
+	Object             string             `json:"object"`
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	CreatedAt          int64              `json:"created_at"`
+	CertificateDetails CertificateDetails `json:"certificate_details"`
+	Active             bool               `json:"active,omitempty"`
+}
+
+// CertificateDetails holds the validity window of a certificate, and its PEM
+// content when it was requested.
+type CertificateDetails struct {
+	ValidAt   int64  `json:"valid_at"`
+	ExpiresAt int64  `json:"expires_at"`
+	Content   string `json:"content,omitempty"`
+}
+
+// ListCertificatesResponse represents the API response when listing
+// certificates
+type ListCertificatesResponse struct {
+	Object  string        `json:"object"`
+	Data    []Certificate `json:"data"`
+	FirstID string        `json:"first_id,omitempty"`
+	LastID  string        `json:"last_id,omitempty"`
+	HasMore bool          `json:"has_more"`
+}
+
+// UploadCertificateRequest represents the request to upload a certificate
+type UploadCertificateRequest struct {
+	Name    string `json:"name,omitempty"`
+	Content string `json:"content"`
+}
+
+// certificateIDsRequest is the body of the activate and deactivate calls.
+type certificateIDsRequest struct {
+	CertificateIDs []string `json:"certificate_ids"`
+}
+
 // CreateRateLimitRequest represents the request to create a rate limit
 type CreateRateLimitRequest struct {
 	ResourceType string `json:"resource_type"` // "request" or "token"
@@ -325,7 +581,9 @@ type ChatCompletionRequest struct {
 	PresencePenalty  float64                 `json:"presence_penalty,omitempty"`  // Presence penalty parameter
 	FrequencyPenalty float64                 `json:"frequency_penalty,omitempty"` // Frequency penalty parameter
 	LogitBias        map[string]float64      `json:"logit_bias,omitempty"`        // Optional token bias
-	User             string                  `json:"user,omitempty"`              // Optional user identifier
+	User             string                  `json:"user,omitempty"`              // Deprecated end-user identifier; use SafetyIdentifier
+	SafetyIdentifier string                  `json:"safety_identifier,omitempty"` // Stable end-user identifier for abuse detection
+	PromptCacheKey   string                  `json:"prompt_cache_key,omitempty"`  // Groups similar requests to improve cache hits
 }
 
 // ChatCompletionResponse represents the API response for chat completions
@@ -467,6 +725,92 @@ type VectorStoreFileBatchUpdateParams struct {
 	Attributes    map[string]interface{} `json:"attributes,omitempty"`
 }
 
+// VectorStoreSearchParams contains parameters for searching a vector store
+type VectorStoreSearchParams struct {
+	VectorStoreID  string                           `json:"-"`
+	Query          string                           `json:"query"`
+	MaxNumResults  int                              `json:"max_num_results,omitempty"`
+	Filters        map[string]interface{}           `json:"filters,omitempty"`
+	RankingOptions *VectorStoreSearchRankingOptions `json:"ranking_options,omitempty"`
+}
+
+// VectorStoreSearchRankingOptions tunes how search results are ranked
+type VectorStoreSearchRankingOptions struct {
+	Ranker         string   `json:"ranker,omitempty"`
+	ScoreThreshold *float64 `json:"score_threshold,omitempty"`
+}
+
+// VectorStoreSearchResult is a single chunk returned by a vector store search
+type VectorStoreSearchResult struct {
+	FileID     string                     `json:"file_id"`
+	Filename   string                     `json:"filename"`
+	Score      float64                    `json:"score"`
+	Attributes map[string]interface{}     `json:"attributes,omitempty"`
+	Content    []VectorStoreSearchContent `json:"content"`
+}
+
+// VectorStoreSearchContent is a piece of text content in a search result
+type VectorStoreSearchContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// VectorStoreSearchResponse represents the API response for a vector store search
+type VectorStoreSearchResponse struct {
+	Object  string                    `json:"object"`
+	Data    []VectorStoreSearchResult `json:"data"`
+	HasMore bool                      `json:"has_more"`
+}
+
+// FineTuningCheckpoint represents an intermediate checkpoint of a fine-tuning job
+type FineTuningCheckpoint struct {
+	ID                       string                       `json:"id"`
+	Object                   string                       `json:"object"`
+	CreatedAt                int64                        `json:"created_at"`
+	FineTunedModelCheckpoint string                       `json:"fine_tuned_model_checkpoint"`
+	FineTuningJobID          string                       `json:"fine_tuning_job_id"`
+	StepNumber               int64                        `json:"step_number"`
+	Metrics                  *FineTuningCheckpointMetrics `json:"metrics,omitempty"`
+}
+
+// FineTuningCheckpointMetrics contains the training metrics recorded at a checkpoint
+type FineTuningCheckpointMetrics struct {
+	Step                       *float64 `json:"step,omitempty"`
+	TrainLoss                  *float64 `json:"train_loss,omitempty"`
+	TrainMeanTokenAccuracy     *float64 `json:"train_mean_token_accuracy,omitempty"`
+	ValidLoss                  *float64 `json:"valid_loss,omitempty"`
+	ValidMeanTokenAccuracy     *float64 `json:"valid_mean_token_accuracy,omitempty"`
+	FullValidLoss              *float64 `json:"full_valid_loss,omitempty"`
+	FullValidMeanTokenAccuracy *float64 `json:"full_valid_mean_token_accuracy,omitempty"`
+}
+
+// FineTuningCheckpointList represents a page of fine-tuning checkpoints
+type FineTuningCheckpointList struct {
+	Object  string                 `json:"object"`
+	Data    []FineTuningCheckpoint `json:"data"`
+	FirstID string                 `json:"first_id"`
+	LastID  string                 `json:"last_id"`
+	HasMore bool                   `json:"has_more"`
+}
+
+// FineTuningEvent represents a single fine-tuning job event
+type FineTuningEvent struct {
+	ID        string          `json:"id"`
+	Object    string          `json:"object"`
+	CreatedAt int64           `json:"created_at"`
+	Level     string          `json:"level"`
+	Message   string          `json:"message"`
+	Type      string          `json:"type,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// FineTuningEventList represents a page of fine-tuning job events
+type FineTuningEventList struct {
+	Object  string            `json:"object"`
+	Data    []FineTuningEvent `json:"data"`
+	HasMore bool              `json:"has_more"`
+}
+
 // ModelResponseOutputContent represents the content part of a message
 type ModelResponseOutputContent struct {
 	Type string `json:"type"`
@@ -599,7 +943,7 @@ func (c *OpenAIClient) ListUsers(after string, limit int, emails []string) (*Use
 	}
 
 	// Construct the URL for the request
-	url := "/v1/organization/users"
+	url := "organization/users"
 	if len(queryParams) > 0 {
 		url = url + "?" + queryParams.Encode()
 	}
@@ -622,6 +966,32 @@ func (c *OpenAIClient) ListUsers(after string, limit int, emails []string) (*Use
 	return &usersResponse, nil
 }
 
+// ListAllUsers retrieves every user in the organization, optionally filtered
+// by email, following the `after` cursor across pages.
+func (c *OpenAIClient) ListAllUsers(emails []string) ([]User, error) {
+	var allUsers []User
+	after := ""
+
+	for {
+		page, err := c.ListUsers(after, 100, emails)
+		if err != nil {
+			return nil, err
+		}
+
+		allUsers = append(allUsers, page.Data...)
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		after = page.LastID
+		if after == "" {
+			after = page.Data[len(page.Data)-1].ID
+		}
+	}
+
+	return allUsers, nil
+}
+
 // FindUserByEmail finds a user in the organization by their email address
 //
 // Parameters:
@@ -661,7 +1031,7 @@ func (c *OpenAIClient) FindUserByEmail(email string) (*User, bool, error) {
 // GetUser retrieves a user by ID
 func (c *OpenAIClient) GetUser(userID string) (*User, bool, error) {
 	// Construct the correct URL using the API format
-	url := fmt.Sprintf("/v1/organization/users/%s", userID)
+	url := fmt.Sprintf("organization/users/%s", userID)
 
 	// Debug the request
 	fmt.Printf("[DEBUG] Getting user with ID: %s\n", userID)
@@ -694,7 +1064,7 @@ func (c *OpenAIClient) UpdateUserRole(userID string, role string) (*User, error)
 	}
 
 	// Construct the correct URL using the API format
-	url := fmt.Sprintf("/v1/organization/users/%s", userID)
+	url := fmt.Sprintf("organization/users/%s", userID)
 
 	// Debug the request
 	fmt.Printf("[DEBUG] Updating user %s to role %s\n", userID, role)
@@ -717,7 +1087,7 @@ func (c *OpenAIClient) UpdateUserRole(userID string, role string) (*User, error)
 // DeleteUser removes a user from the organization
 func (c *OpenAIClient) DeleteUser(userID string) error {
 	// Construct the correct URL using the API format
-	url := fmt.Sprintf("/v1/organization/users/%s", userID)
+	url := fmt.Sprintf("organization/users/%s", userID)
 
 	// Debug the request
 	fmt.Printf("[DEBUG] Deleting user with ID: %s\n", userID)
@@ -733,12 +1103,16 @@ func (c *OpenAIClient) DeleteUser(userID string) error {
 
 // DoRequest performs an HTTP request with the given method, path, and body, and returns the response.
 func (c *OpenAIClient) DoRequest(method, path string, body interface{}) ([]byte, error) {
+	return c.DoRequestContext(context.Background(), method, path, body)
+}
+
+// DoRequestContext is DoRequest bound to ctx, so the request is abandoned
+// when ctx is cancelled or its deadline passes. Error responses are returned
+// as *APIError.
+func (c *OpenAIClient) DoRequestContext(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
 	var jsonBody []byte
 	var err error
 
-	// Print base configuration for debugging
-	fmt.Printf("OpenAI client config: API URL=%s, Organization ID=%s\n", c.APIURL, c.OrganizationID)
-
 	// If body is provided, marshal it to JSON
 	if body != nil {
 		jsonBody, err = json.Marshal(body)
@@ -747,35 +1121,36 @@ func (c *OpenAIClient) DoRequest(method, path string, body interface{}) ([]byte,
 		}
 	}
 
-	// Construct the URL correctly, handling the case where path starts with "/v1"
-	// and APIURL ends with "/v1" to avoid duplication
-	var u string
-	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
-		// Path is already a full URL
-		u = path
-	} else {
-		// Check if we would have a duplicate /v1 in the path
-		if strings.HasSuffix(c.APIURL, "/v1") && strings.HasPrefix(path, "/v1") {
-			// Remove the /v1 prefix from the path to avoid duplication
-			path = strings.TrimPrefix(path, "/v1")
-		}
-		u = SafeJoinURL(c.APIURL, path)
+	return c.DoRawRequestContext(ctx, method, path, "application/json", jsonBody)
+}
+
+// DoRawRequestContext sends body to path unmodified with the given
+// Content-Type, and the same authentication and headers as
+// DoRequestContext. It is the escape hatch for endpoints the client does
+// not model. Error responses are returned as *APIError.
+func (c *OpenAIClient) DoRawRequestContext(ctx context.Context, method, path, contentType string, body []byte) ([]byte, error) {
+	// Print base configuration for debugging
+	fmt.Printf("OpenAI client config: API URL=%s, Organization ID=%s\n", c.APIURL, c.OrganizationID)
+
+	u, err := joinURL(c.APIURL, path)
+	if err != nil {
+		return nil, err
 	}
 
 	// Log the request details for debugging
 	fmt.Printf("Making API request: %s %s\n", method, u)
 	if body != nil {
-		fmt.Printf("Request body: %s\n", string(jsonBody))
+		fmt.Printf("Request body: %s\n", string(body))
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest(method, u, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	// Add headers
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Authorization", "Bearer "+c.APIKey)
 
 	// ALWAYS add the organization ID as header, regardless of the URL
@@ -784,9 +1159,13 @@ func (c *OpenAIClient) DoRequest(method, path string, body interface{}) ([]byte,
 		req.Header.Set("OpenAI-Organization", c.OrganizationID)
 		fmt.Printf("Setting OpenAI-Organization header: %s\n", c.OrganizationID)
 	}
+	if c.ProjectID != "" {
+		req.Header.Set("OpenAI-Project", c.ProjectID)
+	}
+	c.SetDefaultHeaders(req)
 
 	// Make the request
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -800,16 +1179,94 @@ func (c *OpenAIClient) DoRequest(method, path string, body interface{}) ([]byte,
 
 	// Check for error status codes
 	if resp.StatusCode >= 400 {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(responseBody, &errorResp); err != nil {
-			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(responseBody))
+		return nil, newAPIError(resp.StatusCode, responseBody)
+	}
+
+	return responseBody, nil
+}
+
+// PostMultipart POSTs a multipart/form-data request to path, with fields as
+// form values and files mapping form field names to local file paths. Error
+// responses are returned as *APIError.
+func (c *OpenAIClient) PostMultipart(ctx context.Context, path string, fields, files map[string]string) ([]byte, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for _, name := range sortedKeys(fields) {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			return nil, fmt.Errorf("error writing form field %s: %w", name, err)
+		}
+	}
+	for _, name := range sortedKeys(files) {
+		if err := writeFormFile(writer, name, files[name]); err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API error: %s", errorResp.Error.Message)
 	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error closing multipart form: %w", err)
+	}
+
+	u, err := joinURL(c.APIURL, path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, &body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	if c.OrganizationID != "" {
+		req.Header.Set("OpenAI-Organization", c.OrganizationID)
+	}
+	if c.ProjectID != "" {
+		req.Header.Set("OpenAI-Project", c.ProjectID)
+	}
+	c.SetDefaultHeaders(req)
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
 
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, newAPIError(resp.StatusCode, responseBody)
+	}
 	return responseBody, nil
 }
 
+// writeFormFile copies the file at filePath into the form as field name.
+func writeFormFile(writer *multipart.Writer, name, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", name, err)
+	}
+	defer f.Close()
+
+	part, err := writer.CreateFormFile(name, filepath.Base(filePath))
+	if err != nil {
+		return fmt.Errorf("error adding %s to form: %w", name, err)
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return fmt.Errorf("error reading %s: %w", name, err)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // doRequest performs an HTTP request with the given method, path, and body using the client's API key
 func (c *OpenAIClient) doRequest(method, path string, body interface{}) ([]byte, error) {
 	fmt.Printf("[REQUEST-DEBUG] ========== HTTP REQUEST DEBUG ==========\n")
@@ -831,30 +1288,36 @@ func (c *OpenAIClient) doRequest(method, path string, body interface{}) ([]byte,
 		fmt.Printf("[API-KEY-DEBUG] No API key configured\n")
 	}
 
-	// Test network connectivity first
-	connectivityErr := c.TestNetworkConnectivity()
-	if connectivityErr != nil {
-		fmt.Printf("[REQUEST-DEBUG] Network connectivity test failed: %v\n", connectivityErr)
-		// Continue anyway, but log the warning
-		fmt.Printf("[REQUEST-DEBUG] Proceeding with request despite connectivity test failure\n")
-	} else {
-		fmt.Printf("[REQUEST-DEBUG] Network connectivity test passed\n")
-	}
+	// The connectivity checks below dial out directly, bypassing the
+	// transport, so they are skipped in dry-run mode.
+	if !c.DryRun {
+		// Test network connectivity first
+		connectivityErr := c.TestNetworkConnectivity()
+		if connectivityErr != nil {
+			fmt.Printf("[REQUEST-DEBUG] Network connectivity test failed: %v\n", connectivityErr)
+			// Continue anyway, but log the warning
+			fmt.Printf("[REQUEST-DEBUG] Proceeding with request despite connectivity test failure\n")
+		} else {
+			fmt.Printf("[REQUEST-DEBUG] Network connectivity test passed\n")
+		}
 
-	// Network environment debugging
-	fmt.Printf("[NETWORK-DEBUG] Go Version: %s\n", runtime.Version())
-	fmt.Printf("[NETWORK-DEBUG] GODEBUG env: %s\n", os.Getenv("GODEBUG"))
+		// Network environment debugging
+		fmt.Printf("[NETWORK-DEBUG] Go Version: %s\n", runtime.Version())
+		fmt.Printf("[NETWORK-DEBUG] GODEBUG env: %s\n", os.Getenv("GODEBUG"))
 
-	// Check if we can resolve api.openai.com directly
-	ips, resolveErr := net.LookupIP("api.openai.com")
-	if resolveErr != nil {
-		fmt.Printf("[NETWORK-DEBUG] DNS resolution error: %v\n", resolveErr)
-	} else {
-		fmt.Printf("[NETWORK-DEBUG] Resolved IPs for api.openai.com: %v\n", ips)
+		// Check if we can resolve api.openai.com directly
+		ips, resolveErr := net.LookupIP("api.openai.com")
+		if resolveErr != nil {
+			fmt.Printf("[NETWORK-DEBUG] DNS resolution error: %v\n", resolveErr)
+		} else {
+			fmt.Printf("[NETWORK-DEBUG] Resolved IPs for api.openai.com: %v\n", ips)
+		}
 	}
 
-	// Construct the full URL using SafeJoinURL for proper path handling
-	fullURL := SafeJoinURL(c.APIURL, path)
+	fullURL, err := joinURL(c.APIURL, path)
+	if err != nil {
+		return nil, err
+	}
 	fmt.Printf("[REQUEST-DEBUG] Final full URL: %s\n", fullURL)
 
 	// Parse the URL to check its components
@@ -900,9 +1363,11 @@ func (c *OpenAIClient) doRequest(method, path string, body interface{}) ([]byte,
 		req.Header.Set("OpenAI-Organization", c.OrganizationID)
 		fmt.Printf("[REQUEST-DEBUG] Set OpenAI-Organization header: %s\n", c.OrganizationID)
 	}
+	if c.ProjectID != "" {
+		req.Header.Set("OpenAI-Project", c.ProjectID)
+	}
 
-	// Additional useful headers for debugging
-	req.Header.Set("User-Agent", "Terraform-Provider-OpenAI/1.0")
+	c.SetDefaultHeaders(req)
 
 	// Print all headers for debugging (excluding auth token)
 	fmt.Printf("[REQUEST-DEBUG] Request headers:\n")
@@ -948,7 +1413,7 @@ func (c *OpenAIClient) doRequest(method, path string, body interface{}) ([]byte,
 	startTime := time.Now()
 
 	// Do the HTTP request with more error context
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.send(req)
 	requestDuration := time.Since(startTime)
 	fmt.Printf("[NETWORK-DEBUG] Request took %v\n", requestDuration)
 
@@ -1001,18 +1466,12 @@ func (c *OpenAIClient) doRequest(method, path string, body interface{}) ([]byte,
 	if resp.StatusCode >= 400 {
 		fmt.Printf("[REQUEST-DEBUG] Error status code detected: %d\n", resp.StatusCode)
 
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(responseBody, &errorResp); err != nil {
-			fmt.Printf("[REQUEST-DEBUG] Failed to parse error response: %v\n", err)
-			fmt.Printf("[REQUEST-DEBUG] ========== END HTTP REQUEST DEBUG ==========\n")
-			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(responseBody))
-		}
-
-		fmt.Printf("[REQUEST-DEBUG] Error message: %s\n", errorResp.Error.Message)
-		fmt.Printf("[REQUEST-DEBUG] Error type: %s\n", errorResp.Error.Type)
-		fmt.Printf("[REQUEST-DEBUG] Error code: %s\n", errorResp.Error.Code)
+		apiErr := newAPIError(resp.StatusCode, responseBody)
+		fmt.Printf("[REQUEST-DEBUG] Error message: %s\n", apiErr.Message)
+		fmt.Printf("[REQUEST-DEBUG] Error type: %s\n", apiErr.Type)
+		fmt.Printf("[REQUEST-DEBUG] Error code: %s\n", apiErr.Code)
 		fmt.Printf("[REQUEST-DEBUG] ========== END HTTP REQUEST DEBUG ==========\n")
-		return nil, fmt.Errorf("API error: %s", errorResp.Error.Message)
+		return nil, apiErr
 	}
 
 	fmt.Printf("[REQUEST-DEBUG] Request successful\n")
@@ -1041,7 +1500,7 @@ func (c *OpenAIClient) ListProjects(limit int, includeArchived bool, after strin
 	}
 
 	// Use the exact endpoint structure consistent with the curl command
-	url := fmt.Sprintf("/v1/organization/projects%s", queryString)
+	url := fmt.Sprintf("organization/projects%s", queryString)
 
 	// Debug info
 	fmt.Printf("Listing projects\n")
@@ -1061,6 +1520,30 @@ func (c *OpenAIClient) ListProjects(limit int, includeArchived bool, after strin
 	return &resp, nil
 }
 
+// ListAllProjects retrieves every project in the organization, following the
+// `after` cursor across pages until the API reports no more results.
+// ListProjects remains the single-page primitive.
+func (c *OpenAIClient) ListAllProjects(includeArchived bool) ([]Project, error) {
+	var allProjects []Project
+	after := ""
+
+	for {
+		page, err := c.ListProjects(100, includeArchived, after)
+		if err != nil {
+			return nil, err
+		}
+
+		allProjects = append(allProjects, page.Data...)
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		after = page.Data[len(page.Data)-1].ID
+	}
+
+	return allProjects, nil
+}
+
 // CreateProject creates a new project with the given name
 func (c *OpenAIClient) CreateProject(name string) (*Project, error) {
 	// Create the request body
@@ -1073,7 +1556,7 @@ func (c *OpenAIClient) CreateProject(name string) (*Project, error) {
 	fmt.Printf("Request body: %+v\n", requestBody)
 
 	// Use the exact endpoint from the curl command that works
-	url := "/v1/organization/projects"
+	url := "organization/projects"
 
 	// Debug the URL
 	fmt.Printf("Using URL for project creation: %s\n", url)
@@ -1096,7 +1579,7 @@ func (c *OpenAIClient) CreateProject(name string) (*Project, error) {
 // GetProject retrieves a project by its ID
 func (c *OpenAIClient) GetProject(id string) (*Project, error) {
 	// Use the exact endpoint structure consistent with the curl command
-	url := fmt.Sprintf("/v1/organization/projects/%s", id)
+	url := fmt.Sprintf("organization/projects/%s", id)
 
 	// Debug info
 	fmt.Printf("Getting project with ID: %s\n", id)
@@ -1124,7 +1607,7 @@ func (c *OpenAIClient) UpdateProject(id, name string) (*Project, error) {
 	}
 
 	// Use the exact endpoint structure consistent with the curl command
-	url := fmt.Sprintf("/v1/organization/projects/%s", id)
+	url := fmt.Sprintf("organization/projects/%s", id)
 
 	// Debug info
 	fmt.Printf("Updating project with ID: %s\n", id)
@@ -1148,7 +1631,7 @@ func (c *OpenAIClient) UpdateProject(id, name string) (*Project, error) {
 // DeleteProject deletes (archives) a project by its ID
 func (c *OpenAIClient) DeleteProject(id string) error {
 	// Use the archive endpoint as per the OpenAI API documentation
-	url := fmt.Sprintf("/v1/organization/projects/%s/archive", id)
+	url := fmt.Sprintf("organization/projects/%s/archive", id)
 
 	// Debug info
 	fmt.Printf("Archiving project with ID: %s\n", id)
@@ -1166,7 +1649,7 @@ func (c *OpenAIClient) DeleteProject(id string) error {
 // ListAPIKeys retrieves the list of API keys for the organization
 func (c *OpenAIClient) ListAPIKeys(limit int, after string) (*ListAPIKeysResponse, error) {
 	// Construct the URL for the request with query params
-	url := "/v1/organization/admin_api_keys"
+	url := "organization/admin_api_keys"
 
 	// Add query parameters if present
 	queryParams := make([]string, 0)
@@ -1203,10 +1686,36 @@ func (c *OpenAIClient) ListAPIKeys(limit int, after string) (*ListAPIKeysRespons
 	return &listResponse, nil
 }
 
+// ListAllAPIKeys retrieves every admin API key in the organization, following
+// the after/has_more cursor until the last page.
+func (c *OpenAIClient) ListAllAPIKeys() ([]AdminAPIKey, error) {
+	var allKeys []AdminAPIKey
+	after := ""
+
+	for {
+		page, err := c.ListAPIKeys(100, after)
+		if err != nil {
+			return nil, err
+		}
+
+		allKeys = append(allKeys, page.Data...)
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		after = page.LastID
+		if after == "" {
+			after = page.Data[len(page.Data)-1].ID
+		}
+	}
+
+	return allKeys, nil
+}
+
 // GetAPIKey retrieves information about a specific API key
 func (c *OpenAIClient) GetAPIKey(apiKeyID string) (*AdminAPIKey, error) {
 	// Construct the URL for the request
-	url := fmt.Sprintf("/v1/organization/admin_api_keys/%s", apiKeyID)
+	url := fmt.Sprintf("organization/admin_api_keys/%s", apiKeyID)
 
 	// Make the request
 	respBody, err := c.doRequest(http.MethodGet, url, nil)
@@ -1226,7 +1735,7 @@ func (c *OpenAIClient) GetAPIKey(apiKeyID string) (*AdminAPIKey, error) {
 // CreateAPIKey creates a new API key
 func (c *OpenAIClient) CreateAPIKey(name string, expiresAt *int64, scopes []string) (*AdminAPIKeyResponse, error) {
 	// Construct the URL for the request
-	url := "/v1/organization/admin_api_keys"
+	url := "organization/admin_api_keys"
 
 	// Create the request body
 	req := CreateAPIKeyRequest{
@@ -1253,7 +1762,7 @@ func (c *OpenAIClient) CreateAPIKey(name string, expiresAt *int64, scopes []stri
 // DeleteAPIKey deletes an API key
 func (c *OpenAIClient) DeleteAPIKey(apiKeyID string) error {
 	// Construct the URL for the request
-	url := fmt.Sprintf("/v1/organization/admin_api_keys/%s", apiKeyID)
+	url := fmt.Sprintf("organization/admin_api_keys/%s", apiKeyID)
 
 	// Make the request
 	_, err := c.doRequest(http.MethodDelete, url, nil)
@@ -1264,92 +1773,185 @@ func (c *OpenAIClient) DeleteAPIKey(apiKeyID string) error {
 	return nil
 }
 
-// CreateRateLimit creates a new rate limit for a project.
-// It allows you to set restrictions on API usage based on requests or tokens per minute.
-//
-// Parameters:
-//   - projectID: The ID of the project to apply the rate limit to
-//   - resourceType: The type of resource to limit ("request" or "token")
-//   - limitType: The type of limit to apply ("rpm" for requests per minute or "tpm" for tokens per minute)
-//   - value: The numeric limit value
-//
-// Returns:
-//   - A RateLimit object with details about the created rate limit
-//   - An error if the operation failed
-func (c *OpenAIClient) CreateRateLimit(projectID, resourceType, limitType string, value int) (*RateLimit, error) {
-	// Create the request body
-	req := CreateRateLimitRequest{
-		ResourceType: resourceType,
-		LimitType:    limitType,
-		Value:        value,
+// certificatesPath returns the certificates collection of a project, or of
+// the organization when projectID is empty.
+func certificatesPath(projectID string) string {
+	if projectID == "" {
+		return "organization/certificates"
 	}
+	return fmt.Sprintf("organization/projects/%s/certificates", projectID)
+}
 
-	// Construct the URL for the request
-	url := fmt.Sprintf("/v1/organization/projects/%s/rate_limits", projectID)
+// UploadCertificate uploads a PEM-encoded certificate to the organization.
+// It is not used for mutual TLS until it is activated.
+func (c *OpenAIClient) UploadCertificate(name, content string) (*Certificate, error) {
+	if err := c.requireAdminKey("uploading certificates"); err != nil {
+		return nil, err
+	}
 
-	// Make the request
-	respBody, err := c.doRequest(http.MethodPost, url, req)
+	respBody, err := c.DoRequest(http.MethodPost, certificatesPath(""), UploadCertificateRequest{Name: name, Content: content})
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse the response
-	var rateLimit RateLimit
-	if err := json.Unmarshal(respBody, &rateLimit); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal rate limit response: %v", err)
+	var cert Certificate
+	if err := json.Unmarshal(respBody, &cert); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal certificate response: %v", err)
 	}
-
-	return &rateLimit, nil
+	return &cert, nil
 }
 
-// GetRateLimit retrieves information about a specific rate limit by model name or rate limit ID.
-// It lists all rate limits for the project and finds the matching one.
-//
-// Parameters:
-//   - projectID: The ID of the project the rate limit belongs to
-//   - modelOrRateLimitID: Either a model name (e.g., "gpt-4o") or rate limit ID (e.g., "rl-gpt-4o")
-//
-// Returns:
-//   - A RateLimit object with details about the requested rate limit
-//   - An error if the operation failed or the rate limit doesn't exist
-func (c *OpenAIClient) GetRateLimit(projectID, modelOrRateLimitID string) (*RateLimit, error) {
-	// Pagination loop to find the rate limit
-	var allRateLimits []RateLimit
-	limit := 100
-	after := ""
+// GetCertificate retrieves a certificate, including its PEM content when
+// includeContent is set.
+func (c *OpenAIClient) GetCertificate(certificateID string, includeContent bool) (*Certificate, error) {
+	url := fmt.Sprintf("%s/%s", certificatesPath(""), certificateID)
+	if includeContent {
+		url += "?include[]=content"
+	}
 
-	for {
-		rateLimits, err := c.ListRateLimits(projectID, limit, after)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list rate limits: %w", err)
+	respBody, err := c.DoRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := json.Unmarshal(respBody, &cert); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal certificate response: %v", err)
+	}
+	return &cert, nil
+}
+
+// UpdateCertificate renames a certificate; its content cannot be changed.
+func (c *OpenAIClient) UpdateCertificate(certificateID, name string) (*Certificate, error) {
+	url := fmt.Sprintf("%s/%s", certificatesPath(""), certificateID)
+	respBody, err := c.DoRequest(http.MethodPost, url, map[string]string{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := json.Unmarshal(respBody, &cert); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal certificate response: %v", err)
+	}
+	return &cert, nil
+}
+
+// ListCertificates retrieves every certificate of a project, or of the
+// organization when projectID is empty, following the after cursor across
+// pages. Active reports whether each is active at that level.
+func (c *OpenAIClient) ListCertificates(projectID string) ([]Certificate, error) {
+	var all []Certificate
+	after := ""
+
+	for {
+		url := certificatesPath(projectID) + "?limit=100"
+		if after != "" {
+			url += "&after=" + after
+		}
+		respBody, err := c.DoRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
 		}
 
-		allRateLimits = append(allRateLimits, rateLimits.Data...)
+		var page ListCertificatesResponse
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal certificates list response: %v", err)
+		}
+		all = append(all, page.Data...)
 
-		if !rateLimits.HasMore {
-			break
+		if !page.HasMore || len(page.Data) == 0 {
+			return all, nil
+		}
+		after = page.LastID
+		if after == "" {
+			after = page.Data[len(page.Data)-1].ID
 		}
-		after = rateLimits.LastID
 	}
+}
+
+// ActivateCertificate activates a certificate for mutual TLS in a project,
+// or for the whole organization when projectID is empty.
+func (c *OpenAIClient) ActivateCertificate(projectID, certificateID string) error {
+	_, err := c.DoRequest(http.MethodPost, certificatesPath(projectID)+"/activate", certificateIDsRequest{CertificateIDs: []string{certificateID}})
+	return err
+}
 
-	// Normalize the search - if it's a rate limit ID, extract the model name
-	searchModel := modelOrRateLimitID
-	if strings.HasPrefix(modelOrRateLimitID, "rl-") {
-		searchModel = strings.TrimPrefix(modelOrRateLimitID, "rl-")
+// DeactivateCertificate deactivates a certificate in a project, or for the
+// organization when projectID is empty.
+func (c *OpenAIClient) DeactivateCertificate(projectID, certificateID string) error {
+	_, err := c.DoRequest(http.MethodPost, certificatesPath(projectID)+"/deactivate", certificateIDsRequest{CertificateIDs: []string{certificateID}})
+	return err
+}
+
+// DeleteCertificate deletes a certificate. The API refuses to delete one
+// that is still active for the organization or any project.
+func (c *OpenAIClient) DeleteCertificate(certificateID string) error {
+	_, err := c.DoRequest(http.MethodDelete, fmt.Sprintf("%s/%s", certificatesPath(""), certificateID), nil)
+	return err
+}
+
+// CreateRateLimit creates a new rate limit for a project.
+// It allows you to set restrictions on API usage based on requests or tokens per minute.
+//
+// Parameters:
+//   - projectID: The ID of the project to apply the rate limit to
+//   - resourceType: The type of resource to limit ("request" or "token")
+//   - limitType: The type of limit to apply ("rpm" for requests per minute or "tpm" for tokens per minute)
+//   - value: The numeric limit value
+//
+// Returns:
+//   - A RateLimit object with details about the created rate limit
+//   - An error if the operation failed
+func (c *OpenAIClient) CreateRateLimit(projectID, resourceType, limitType string, value int) (*RateLimit, error) {
+	if err := c.requireAdminKey("creating a rate limit"); err != nil {
+		return nil, err
 	}
 
-	// Search for exact model match first
-	for i := range allRateLimits {
-		if allRateLimits[i].Model == searchModel {
-			return &allRateLimits[i], nil
-		}
+	// Create the request body
+	req := CreateRateLimitRequest{
+		ResourceType: resourceType,
+		LimitType:    limitType,
+		Value:        value,
 	}
 
-	// Try exact ID match
-	for i := range allRateLimits {
-		if allRateLimits[i].ID == modelOrRateLimitID {
-			return &allRateLimits[i], nil
-		}
+	// Construct the URL for the request
+	url := fmt.Sprintf("organization/projects/%s/rate_limits", projectID)
+
+	// Make the request
+	respBody, err := c.doRequest(http.MethodPost, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the response
+	var rateLimit RateLimit
+	if err := json.Unmarshal(respBody, &rateLimit); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rate limit response: %v", err)
+	}
+
+	return &rateLimit, nil
+}
+
+// GetRateLimit retrieves information about a specific rate limit by model name or rate limit ID.
+// It lists all rate limits for the project and finds the matching one using findRateLimit.
+//
+// Parameters:
+//   - projectID: The ID of the project the rate limit belongs to
+//   - modelOrRateLimitID: Either a model name (e.g., "gpt-4o"), an API rate limit ID
+//     (e.g., "rl-gpt-4o") or a provider ID of the form "rl-<model>-<last 8
+//     characters of projectID>"
+//
+// Returns:
+//   - A RateLimit object with details about the requested rate limit
+//   - An error if the operation failed or the rate limit doesn't exist
+func (c *OpenAIClient) GetRateLimit(projectID, modelOrRateLimitID string) (*RateLimit, error) {
+	allRateLimits, err := c.ListAllRateLimits(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rl := findRateLimit(allRateLimits, projectID, modelOrRateLimitID); rl != nil {
+		return rl, nil
 	}
 
 	return nil, fmt.Errorf("rate limit not found for model/ID '%s' in project '%s'", modelOrRateLimitID, projectID)
@@ -1358,6 +1960,10 @@ func (c *OpenAIClient) GetRateLimit(projectID, modelOrRateLimitID string) (*Rate
 // UpdateRateLimit modifies an existing rate limit for a project.
 // Uses POST to /v1/organization/projects/{project_id}/rate_limits/{rate_limit_id}
 func (c *OpenAIClient) UpdateRateLimit(projectID, modelOrRateLimitID string, maxRequestsPerMinute, maxTokensPerMinute, maxImagesPerMinute, batch1DayMaxInputTokens, maxAudioMegabytesPer1Minute, maxRequestsPer1Day *int) (*RateLimit, error) {
+	if err := c.requireAdminKey("updating a rate limit"); err != nil {
+		return nil, err
+	}
+
 	// First, find the rate limit to get its ID
 	targetRateLimit, err := c.GetRateLimit(projectID, modelOrRateLimitID)
 	if err != nil {
@@ -1365,7 +1971,7 @@ func (c *OpenAIClient) UpdateRateLimit(projectID, modelOrRateLimitID string, max
 	}
 
 	// Construct the API path
-	path := fmt.Sprintf("/v1/organization/projects/%s/rate_limits/%s", projectID, targetRateLimit.ID)
+	path := fmt.Sprintf("organization/projects/%s/rate_limits/%s", projectID, targetRateLimit.ID)
 
 	// Create the request body with only non-nil fields
 	// Note: API uses "max_requests_per_1_minute" format (with _1_)
@@ -1416,6 +2022,10 @@ func (c *OpenAIClient) UpdateRateLimit(projectID, modelOrRateLimitID string, max
 // Returns:
 //   - An error if the operation failed
 func (c *OpenAIClient) DeleteRateLimit(projectID, modelOrRateLimitID string) error {
+	if err := c.requireAdminKey("resetting a rate limit"); err != nil {
+		return err
+	}
+
 	// Find the rate limit to get its ID and model
 	targetRateLimit, err := c.GetRateLimit(projectID, modelOrRateLimitID)
 	if err != nil {
@@ -1423,7 +2033,7 @@ func (c *OpenAIClient) DeleteRateLimit(projectID, modelOrRateLimitID string) err
 	}
 
 	// Construct the API path
-	path := fmt.Sprintf("/v1/organization/projects/%s/rate_limits/%s", projectID, targetRateLimit.ID)
+	path := fmt.Sprintf("organization/projects/%s/rate_limits/%s", projectID, targetRateLimit.ID)
 
 	// Get default values for this model
 	defaultValues := getDefaultRateLimitValues(targetRateLimit.Model)
@@ -1435,17 +2045,17 @@ func (c *OpenAIClient) DeleteRateLimit(projectID, modelOrRateLimitID string) err
 	}
 
 	// Add optional fields if they exist in the default values
-	if defaultValues.MaxImagesPer1Minute > 0 {
-		req["max_images_per_1_minute"] = defaultValues.MaxImagesPer1Minute
+	if defaultValues.MaxImagesPer1Minute != nil {
+		req["max_images_per_1_minute"] = *defaultValues.MaxImagesPer1Minute
 	}
-	if defaultValues.MaxAudioMegabytesPer1Minute > 0 {
-		req["max_audio_megabytes_per_1_minute"] = defaultValues.MaxAudioMegabytesPer1Minute
+	if defaultValues.MaxAudioMegabytesPer1Minute != nil {
+		req["max_audio_megabytes_per_1_minute"] = *defaultValues.MaxAudioMegabytesPer1Minute
 	}
-	if defaultValues.Batch1DayMaxInputTokens > 0 {
-		req["batch_1_day_max_input_tokens"] = defaultValues.Batch1DayMaxInputTokens
+	if defaultValues.Batch1DayMaxInputTokens != nil {
+		req["batch_1_day_max_input_tokens"] = *defaultValues.Batch1DayMaxInputTokens
 	}
-	if defaultValues.MaxRequestsPer1Day > 0 {
-		req["max_requests_per_1_day"] = defaultValues.MaxRequestsPer1Day
+	if defaultValues.MaxRequestsPer1Day != nil {
+		req["max_requests_per_1_day"] = *defaultValues.MaxRequestsPer1Day
 	}
 
 	// Send POST request to reset the rate limit to default values
@@ -1478,7 +2088,7 @@ func (c *OpenAIClient) AddProjectUser(projectID, userID, role string) (*ProjectU
 	}
 
 	// Construct the URL for the request
-	url := fmt.Sprintf("/v1/organization/projects/%s/users", projectID)
+	url := fmt.Sprintf("organization/projects/%s/users", projectID)
 
 	// Log the request for debugging
 	fmt.Printf("[DEBUG] Adding user %s to project %s with role %s\n", userID, projectID, role)
@@ -1520,7 +2130,7 @@ func (c *OpenAIClient) ListProjectUsers(projectID, after string, limit int) (*Pr
 	}
 
 	// Construct the URL for the request
-	urlPath := fmt.Sprintf("/v1/organization/projects/%s/users", projectID)
+	urlPath := fmt.Sprintf("organization/projects/%s/users", projectID)
 	if len(queryParams) > 0 {
 		urlPath = urlPath + "?" + queryParams.Encode()
 	}
@@ -1543,6 +2153,55 @@ func (c *OpenAIClient) ListProjectUsers(projectID, after string, limit int) (*Pr
 	return &userList, nil
 }
 
+// ListAllProjectUsers retrieves every user in a project, following the
+// `after` cursor across pages.
+func (c *OpenAIClient) ListAllProjectUsers(projectID string) ([]ProjectUser, error) {
+	var allUsers []ProjectUser
+	after := ""
+
+	for {
+		page, err := c.ListProjectUsers(projectID, after, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		allUsers = append(allUsers, page.Data...)
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		after = page.LastID
+		if after == "" {
+			after = page.Data[len(page.Data)-1].ID
+		}
+	}
+
+	return allUsers, nil
+}
+
+// FindProjectUserByEmail finds a user in a project by their email address.
+// The project users endpoint has no email filter, so every page is listed
+// and the email compared case-insensitively.
+//
+// Returns:
+//   - The found ProjectUser if it exists
+//   - A boolean indicating if the user was found
+//   - An error if the operation failed
+func (c *OpenAIClient) FindProjectUserByEmail(projectID, email string) (*ProjectUser, bool, error) {
+	users, err := c.ListAllProjectUsers(projectID)
+	if err != nil {
+		return nil, false, fmt.Errorf("error finding project user by email: %w", err)
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].Email, email) {
+			return &users[i], true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
 // RemoveProjectUser removes a user from a project.
 // Users who are organization owners cannot be removed from projects.
 //
@@ -1554,7 +2213,7 @@ func (c *OpenAIClient) ListProjectUsers(projectID, after string, limit int) (*Pr
 //   - An error if the operation failed
 func (c *OpenAIClient) RemoveProjectUser(projectID, userID string) error {
 	// Construct the URL for the request
-	url := fmt.Sprintf("/v1/organization/projects/%s/users/%s", projectID, userID)
+	url := fmt.Sprintf("organization/projects/%s/users/%s", projectID, userID)
 
 	// Log the request for debugging
 	fmt.Printf("[DEBUG] Removing user %s from project %s\n", userID, projectID)
@@ -1596,7 +2255,7 @@ func (c *OpenAIClient) UpdateProjectUser(projectID, userID, role string) (*Proje
 	}
 
 	// Construct the URL for the request
-	url := fmt.Sprintf("/v1/organization/projects/%s/users/%s", projectID, userID)
+	url := fmt.Sprintf("organization/projects/%s/users/%s", projectID, userID)
 
 	// Log the request for debugging
 	fmt.Printf("[DEBUG] Updating user %s in project %s to role %s\n", userID, projectID, role)
@@ -1621,7 +2280,7 @@ func (c *OpenAIClient) UpdateProjectUser(projectID, userID, role string) (*Proje
 // and do not have the limitation of being removed when a user leaves an organization
 func (c *OpenAIClient) CreateProjectServiceAccount(projectID, name string) (*ProjectServiceAccount, error) {
 	// Correct URL format based on the API endpoint structure
-	url := fmt.Sprintf("/v1/organization/projects/%s/service_accounts", projectID)
+	url := fmt.Sprintf("organization/projects/%s/service_accounts", projectID)
 
 	// Create request body
 	req := CreateProjectServiceAccountRequest{
@@ -1649,7 +2308,7 @@ func (c *OpenAIClient) CreateProjectServiceAccount(projectID, name string) (*Pro
 // GetProjectServiceAccount retrieves information about a specific service account in a project
 func (c *OpenAIClient) GetProjectServiceAccount(projectID, serviceAccountID string) (*ProjectServiceAccount, error) {
 	// Correct URL format based on the API endpoint structure
-	url := fmt.Sprintf("/v1/organization/projects/%s/service_accounts/%s", projectID, serviceAccountID)
+	url := fmt.Sprintf("organization/projects/%s/service_accounts/%s", projectID, serviceAccountID)
 
 	// Log the request for debugging
 	fmt.Printf("[DEBUG] Getting service account %s from project %s\n", serviceAccountID, projectID)
@@ -1672,7 +2331,7 @@ func (c *OpenAIClient) GetProjectServiceAccount(projectID, serviceAccountID stri
 // ListProjectServiceAccounts retrieves all service accounts in a project
 func (c *OpenAIClient) ListProjectServiceAccounts(projectID string) (*ProjectServiceAccountList, error) {
 	// Correct URL format based on the API endpoint structure
-	url := fmt.Sprintf("/v1/organization/projects/%s/service_accounts", projectID)
+	url := fmt.Sprintf("organization/projects/%s/service_accounts", projectID)
 
 	// Log the request for debugging
 	fmt.Printf("[DEBUG] Listing service accounts for project %s\n", projectID)
@@ -1695,7 +2354,7 @@ func (c *OpenAIClient) ListProjectServiceAccounts(projectID string) (*ProjectSer
 // DeleteProjectServiceAccount removes a service account from a project
 func (c *OpenAIClient) DeleteProjectServiceAccount(projectID, serviceAccountID string) error {
 	// Correct URL format based on the API endpoint structure
-	url := fmt.Sprintf("/v1/organization/projects/%s/service_accounts/%s", projectID, serviceAccountID)
+	url := fmt.Sprintf("organization/projects/%s/service_accounts/%s", projectID, serviceAccountID)
 
 	// Log the request for debugging
 	fmt.Printf("[DEBUG] Deleting service account %s from project %s\n", serviceAccountID, projectID)
@@ -1711,7 +2370,7 @@ func (c *OpenAIClient) DeleteProjectServiceAccount(projectID, serviceAccountID s
 
 // ChatCompletion makes a request to the OpenAI Chat Completions API
 func (c *OpenAIClient) ChatCompletion(request *ChatCompletionRequest) (*ChatCompletionResponse, error) {
-	url := "/v1/chat/completions"
+	url := "chat/completions"
 
 	body, err := c.DoRequest("POST", url, request)
 	if err != nil {
@@ -1726,9 +2385,66 @@ func (c *OpenAIClient) ChatCompletion(request *ChatCompletionRequest) (*ChatComp
 	return &response, nil
 }
 
+// CompletionRequest is a request to the legacy Completions API. Optional
+// numbers are pointers so that explicit zeros are sent.
+type CompletionRequest struct {
+	Model       string   `json:"model"`
+	Prompt      string   `json:"prompt"`
+	Suffix      string   `json:"suffix,omitempty"`
+	MaxTokens   *int     `json:"max_tokens,omitempty"`
+	Temperature *float64 `json:"temperature,omitempty"`
+	TopP        *float64 `json:"top_p,omitempty"`
+	N           *int     `json:"n,omitempty"`
+	Stop        []string `json:"stop,omitempty"`
+	Logprobs    *int     `json:"logprobs,omitempty"`
+}
+
+// CompletionResponse is the API response for a legacy completion.
+type CompletionResponse struct {
+	ID      string              `json:"id"`
+	Object  string              `json:"object"`
+	Created int                 `json:"created"`
+	Model   string              `json:"model"`
+	Choices []CompletionChoice  `json:"choices"`
+	Usage   ChatCompletionUsage `json:"usage"`
+}
+
+// CompletionChoice is one generated text of a legacy completion.
+type CompletionChoice struct {
+	Text         string              `json:"text"`
+	Index        int                 `json:"index"`
+	Logprobs     *CompletionLogprobs `json:"logprobs"`
+	FinishReason string              `json:"finish_reason"`
+}
+
+// CompletionLogprobs holds the per-token log probabilities of a choice,
+// returned when the request sets logprobs.
+type CompletionLogprobs struct {
+	Tokens        []string             `json:"tokens"`
+	TokenLogprobs []float64            `json:"token_logprobs"`
+	TopLogprobs   []map[string]float64 `json:"top_logprobs"`
+	TextOffset    []int                `json:"text_offset"`
+}
+
+// CreateCompletion makes a request to the legacy Completions API, used by
+// instruct and base models such as gpt-3.5-turbo-instruct and davinci-002.
+func (c *OpenAIClient) CreateCompletion(ctx context.Context, request *CompletionRequest) (*CompletionResponse, error) {
+	body, err := c.DoRequestContext(ctx, http.MethodPost, "completions", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CompletionResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error parsing completion response: %v", err)
+	}
+
+	return &response, nil
+}
+
 // CreateVectorStore creates a new vector store
 func (c *OpenAIClient) CreateVectorStore(ctx context.Context, params *VectorStoreCreateParams) (*VectorStore, error) {
-	req, err := c.newRequest("POST", "v1/vector_stores", params)
+	req, err := c.newRequest("POST", "vector_stores", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1743,7 +2459,7 @@ func (c *OpenAIClient) CreateVectorStore(ctx context.Context, params *VectorStor
 
 // GetVectorStore retrieves a vector store by ID
 func (c *OpenAIClient) GetVectorStore(ctx context.Context, id string) (*VectorStore, error) {
-	req, err := c.newRequest("GET", fmt.Sprintf("v1/vector_stores/%s", id), nil)
+	req, err := c.newRequest("GET", fmt.Sprintf("vector_stores/%s", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1758,7 +2474,7 @@ func (c *OpenAIClient) GetVectorStore(ctx context.Context, id string) (*VectorSt
 
 // UpdateVectorStore updates an existing vector store
 func (c *OpenAIClient) UpdateVectorStore(ctx context.Context, params *VectorStoreUpdateParams) (*VectorStore, error) {
-	req, err := c.newRequest("POST", fmt.Sprintf("v1/vector_stores/%s", params.ID), params)
+	req, err := c.newRequest("POST", fmt.Sprintf("vector_stores/%s", params.ID), params)
 	if err != nil {
 		return nil, err
 	}
@@ -1773,7 +2489,7 @@ func (c *OpenAIClient) UpdateVectorStore(ctx context.Context, params *VectorStor
 
 // DeleteVectorStore deletes a vector store by ID
 func (c *OpenAIClient) DeleteVectorStore(ctx context.Context, id string) error {
-	req, err := c.newRequest("DELETE", fmt.Sprintf("v1/vector_stores/%s", id), nil)
+	req, err := c.newRequest("DELETE", fmt.Sprintf("vector_stores/%s", id), nil)
 	if err != nil {
 		return err
 	}
@@ -1783,7 +2499,7 @@ func (c *OpenAIClient) DeleteVectorStore(ctx context.Context, id string) error {
 
 // AddFileToVectorStore adds a file to a vector store
 func (c *OpenAIClient) AddFileToVectorStore(ctx context.Context, params *VectorStoreFileCreateParams) (*VectorStoreFile, error) {
-	req, err := c.newRequest("POST", fmt.Sprintf("v1/vector_stores/%s/files", params.VectorStoreID), params)
+	req, err := c.newRequest("POST", fmt.Sprintf("vector_stores/%s/files", params.VectorStoreID), params)
 	if err != nil {
 		return nil, err
 	}
@@ -1798,7 +2514,7 @@ func (c *OpenAIClient) AddFileToVectorStore(ctx context.Context, params *VectorS
 
 // GetVectorStoreFile retrieves a file from a vector store
 func (c *OpenAIClient) GetVectorStoreFile(ctx context.Context, vectorStoreID, fileID string) (*VectorStoreFile, error) {
-	req, err := c.newRequest("GET", fmt.Sprintf("v1/vector_stores/%s/files/%s", vectorStoreID, fileID), nil)
+	req, err := c.newRequest("GET", fmt.Sprintf("vector_stores/%s/files/%s", vectorStoreID, fileID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1813,7 +2529,7 @@ func (c *OpenAIClient) GetVectorStoreFile(ctx context.Context, vectorStoreID, fi
 
 // UpdateVectorStoreFile updates a file in a vector store
 func (c *OpenAIClient) UpdateVectorStoreFile(ctx context.Context, params *VectorStoreFileUpdateParams) (*VectorStoreFile, error) {
-	req, err := c.newRequest("POST", fmt.Sprintf("v1/vector_stores/%s/files/%s", params.VectorStoreID, params.FileID), params)
+	req, err := c.newRequest("POST", fmt.Sprintf("vector_stores/%s/files/%s", params.VectorStoreID, params.FileID), params)
 	if err != nil {
 		return nil, err
 	}
@@ -1828,7 +2544,7 @@ func (c *OpenAIClient) UpdateVectorStoreFile(ctx context.Context, params *Vector
 
 // RemoveFileFromVectorStore removes a file from a vector store
 func (c *OpenAIClient) RemoveFileFromVectorStore(ctx context.Context, vectorStoreID, fileID string) error {
-	req, err := c.newRequest("DELETE", fmt.Sprintf("v1/vector_stores/%s/files/%s", vectorStoreID, fileID), nil)
+	req, err := c.newRequest("DELETE", fmt.Sprintf("vector_stores/%s/files/%s", vectorStoreID, fileID), nil)
 	if err != nil {
 		return err
 	}
@@ -1838,7 +2554,7 @@ func (c *OpenAIClient) RemoveFileFromVectorStore(ctx context.Context, vectorStor
 
 // AddFileBatchToVectorStore adds a batch of files to a vector store
 func (c *OpenAIClient) AddFileBatchToVectorStore(ctx context.Context, params *VectorStoreFileBatchCreateParams) (*VectorStoreFileBatch, error) {
-	req, err := c.newRequest("POST", fmt.Sprintf("v1/vector_stores/%s/file_batches", params.VectorStoreID), params)
+	req, err := c.newRequest("POST", fmt.Sprintf("vector_stores/%s/file_batches", params.VectorStoreID), params)
 	if err != nil {
 		return nil, err
 	}
@@ -1853,7 +2569,7 @@ func (c *OpenAIClient) AddFileBatchToVectorStore(ctx context.Context, params *Ve
 
 // GetVectorStoreFileBatch retrieves a file batch from a vector store
 func (c *OpenAIClient) GetVectorStoreFileBatch(ctx context.Context, vectorStoreID, batchID string) (*VectorStoreFileBatch, error) {
-	req, err := c.newRequest("GET", fmt.Sprintf("v1/vector_stores/%s/file_batches/%s", vectorStoreID, batchID), nil)
+	req, err := c.newRequest("GET", fmt.Sprintf("vector_stores/%s/file_batches/%s", vectorStoreID, batchID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1868,7 +2584,7 @@ func (c *OpenAIClient) GetVectorStoreFileBatch(ctx context.Context, vectorStoreI
 
 // UpdateVectorStoreFileBatch updates a file batch in a vector store
 func (c *OpenAIClient) UpdateVectorStoreFileBatch(ctx context.Context, params *VectorStoreFileBatchUpdateParams) (*VectorStoreFileBatch, error) {
-	req, err := c.newRequest("POST", fmt.Sprintf("v1/vector_stores/%s/file_batches/%s", params.VectorStoreID, params.BatchID), params)
+	req, err := c.newRequest("POST", fmt.Sprintf("vector_stores/%s/file_batches/%s", params.VectorStoreID, params.BatchID), params)
 	if err != nil {
 		return nil, err
 	}
@@ -1883,7 +2599,7 @@ func (c *OpenAIClient) UpdateVectorStoreFileBatch(ctx context.Context, params *V
 
 // RemoveFileBatchFromVectorStore removes a file batch from a vector store
 func (c *OpenAIClient) RemoveFileBatchFromVectorStore(ctx context.Context, vectorStoreID, batchID string) error {
-	req, err := c.newRequest("DELETE", fmt.Sprintf("v1/vector_stores/%s/file_batches/%s", vectorStoreID, batchID), nil)
+	req, err := c.newRequest("DELETE", fmt.Sprintf("vector_stores/%s/file_batches/%s", vectorStoreID, batchID), nil)
 	if err != nil {
 		return err
 	}
@@ -1891,34 +2607,234 @@ func (c *OpenAIClient) RemoveFileBatchFromVectorStore(ctx context.Context, vecto
 	return c.do(ctx, req, nil)
 }
 
-// newRequest creates a new HTTP request
-func (c *OpenAIClient) newRequest(method, path string, body interface{}) (*http.Request, error) {
-	// Make sure path has proper formatting
-	if !strings.HasPrefix(path, "/") {
-		if strings.HasPrefix(path, "v1/") {
-			// Path already includes v1 prefix without leading slash
-			path = "/" + path
-		} else if !strings.HasPrefix(path, "v1") {
-			// Path doesn't have v1 prefix at all, add it with leading slash
-			path = "/v1/" + path
-		} else {
-			// Just add leading slash
-			path = "/" + path
+// SearchVectorStore runs a semantic search over the files in a vector store
+func (c *OpenAIClient) SearchVectorStore(ctx context.Context, params *VectorStoreSearchParams) (*VectorStoreSearchResponse, error) {
+	req, err := c.newRequest("POST", fmt.Sprintf("vector_stores/%s/search", params.VectorStoreID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result VectorStoreSearchResponse
+	if err := c.do(ctx, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListFineTuningCheckpoints retrieves one page of a fine-tuning job's checkpoints
+func (c *OpenAIClient) ListFineTuningCheckpoints(ctx context.Context, jobID, after string, limit int) (*FineTuningCheckpointList, error) {
+	queryParams := url.Values{}
+	if after != "" {
+		queryParams.Set("after", after)
+	}
+	if limit > 0 {
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+	}
+
+	path := fmt.Sprintf("fine_tuning/jobs/%s/checkpoints", jobID)
+	if len(queryParams) > 0 {
+		path += "?" + queryParams.Encode()
+	}
+
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result FineTuningCheckpointList
+	if err := c.do(ctx, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListFineTuningEvents retrieves one page of a fine-tuning job's events,
+// newest first
+func (c *OpenAIClient) ListFineTuningEvents(ctx context.Context, jobID, after string, limit int) (*FineTuningEventList, error) {
+	queryParams := url.Values{}
+	if after != "" {
+		queryParams.Set("after", after)
+	}
+	if limit > 0 {
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+	}
+
+	path := fmt.Sprintf("fine_tuning/jobs/%s/events", jobID)
+	if len(queryParams) > 0 {
+		path += "?" + queryParams.Encode()
+	}
+
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result FineTuningEventList
+	if err := c.do(ctx, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllFineTuningCheckpoints retrieves every checkpoint of a fine-tuning job,
+// following the `after` cursor across pages.
+func (c *OpenAIClient) ListAllFineTuningCheckpoints(ctx context.Context, jobID string) ([]FineTuningCheckpoint, error) {
+	var all []FineTuningCheckpoint
+	after := ""
+
+	for {
+		page, err := c.ListFineTuningCheckpoints(ctx, jobID, after, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Data...)
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		after = page.LastID
+		if after == "" {
+			after = page.Data[len(page.Data)-1].ID
 		}
 	}
 
-	// Ensure APIURL doesn't end with /v1 to avoid duplication
-	baseURL := c.APIURL
-	baseURL = strings.TrimSuffix(baseURL, "/v1")
+	return all, nil
+}
+
+// UsageQuery selects the time range and filters of an organization usage or
+// costs report. StartTime is inclusive and EndTime, when set, exclusive.
+type UsageQuery struct {
+	StartTime   int64
+	EndTime     int64
+	BucketWidth string
+	ProjectIDs  []string
+	Models      []string
+}
+
+// UsagePage is one page of usage or costs buckets
+type UsagePage struct {
+	Object   string        `json:"object"`
+	Data     []UsageBucket `json:"data"`
+	HasMore  bool          `json:"has_more"`
+	NextPage string        `json:"next_page"`
+}
+
+// UsageBucket holds the results of one time bucket
+type UsageBucket struct {
+	Object    string        `json:"object"`
+	StartTime int64         `json:"start_time"`
+	EndTime   int64         `json:"end_time"`
+	Results   []UsageResult `json:"results"`
+}
+
+// UsageResult is one result of a bucket. Completions usage sets the token
+// and request counts, costs set Amount.
+type UsageResult struct {
+	Object            string     `json:"object"`
+	InputTokens       int64      `json:"input_tokens"`
+	OutputTokens      int64      `json:"output_tokens"`
+	InputCachedTokens int64      `json:"input_cached_tokens"`
+	NumModelRequests  int64      `json:"num_model_requests"`
+	ProjectID         *string    `json:"project_id,omitempty"`
+	Model             *string    `json:"model,omitempty"`
+	Amount            *UsageCost `json:"amount,omitempty"`
+	LineItem          *string    `json:"line_item,omitempty"`
+}
+
+// UsageCost is the amount of a costs result
+type UsageCost struct {
+	Value    float64 `json:"value"`
+	Currency string  `json:"currency"`
+}
+
+// ListUsagePage retrieves one page of the usage or costs report at path,
+// starting at the page cursor. The costs endpoint cannot filter by model, so
+// q.Models is only sent when withModels is set.
+func (c *OpenAIClient) ListUsagePage(ctx context.Context, path string, q UsageQuery, withModels bool, page string) (*UsagePage, error) {
+	if err := c.requireAdminKey("reading organization usage"); err != nil {
+		return nil, err
+	}
 
-	// Also remove trailing slash from base URL
-	baseURL = strings.TrimSuffix(baseURL, "/")
+	queryParams := url.Values{}
+	queryParams.Set("start_time", fmt.Sprintf("%d", q.StartTime))
+	if q.EndTime > 0 {
+		queryParams.Set("end_time", fmt.Sprintf("%d", q.EndTime))
+	}
+	if q.BucketWidth != "" {
+		queryParams.Set("bucket_width", q.BucketWidth)
+	}
+	for _, id := range q.ProjectIDs {
+		queryParams.Add("project_ids", id)
+	}
+	if withModels {
+		for _, m := range q.Models {
+			queryParams.Add("models", m)
+		}
+	}
+	if page != "" {
+		queryParams.Set("page", page)
+	}
 
-	// Construct the full URL using SafeJoinURL
-	u := SafeJoinURL(baseURL, path)
+	req, err := c.newRequest("GET", path+"?"+queryParams.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UsagePage
+	if err := c.do(ctx, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// listAllUsage retrieves every bucket of the report at path, following the
+// `next_page` cursor across pages.
+func (c *OpenAIClient) listAllUsage(ctx context.Context, path string, q UsageQuery, withModels bool) ([]UsageBucket, error) {
+	var all []UsageBucket
+	page := ""
+
+	for {
+		p, err := c.ListUsagePage(ctx, path, q, withModels, page)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, p.Data...)
+
+		if !p.HasMore || p.NextPage == "" {
+			break
+		}
+		page = p.NextPage
+	}
+
+	return all, nil
+}
+
+// ListCompletionsUsage retrieves the organization's completions usage
+// buckets for q.
+func (c *OpenAIClient) ListCompletionsUsage(ctx context.Context, q UsageQuery) ([]UsageBucket, error) {
+	return c.listAllUsage(ctx, "organization/usage/completions", q, true)
+}
+
+// ListCosts retrieves the organization's costs buckets for q. The API only
+// supports daily buckets and ignores q.Models.
+func (c *OpenAIClient) ListCosts(ctx context.Context, q UsageQuery) ([]UsageBucket, error) {
+	return c.listAllUsage(ctx, "organization/costs", q, false)
+}
+
+// newRequest creates a new HTTP request
+func (c *OpenAIClient) newRequest(method, path string, body interface{}) (*http.Request, error) {
+	u, err := joinURL(c.APIURL, path)
+	if err != nil {
+		return nil, err
+	}
 
 	var req *http.Request
-	var err error
 
 	if body != nil {
 		// Marshal the body to JSON
@@ -1948,6 +2864,10 @@ func (c *OpenAIClient) newRequest(method, path string, body interface{}) (*http.
 	if c.OrganizationID != "" {
 		req.Header.Set("OpenAI-Organization", c.OrganizationID)
 	}
+	if c.ProjectID != "" {
+		req.Header.Set("OpenAI-Project", c.ProjectID)
+	}
+	c.SetDefaultHeaders(req)
 
 	return req, nil
 }
@@ -1962,7 +2882,7 @@ func (c *OpenAIClient) do(ctx context.Context, req *http.Request, v interface{})
 	req = req.WithContext(ctx)
 
 	// Perform the request
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return fmt.Errorf("error performing request: %v", err)
 	}
@@ -1996,167 +2916,6 @@ func (c *OpenAIClient) do(ctx context.Context, req *http.Request, v interface{})
 	return nil
 }
 
-// SafeJoinURL safely joins a base URL with a path
-func SafeJoinURL(baseURL, path string) string {
-	// Debug logging to track URL construction
-	fmt.Printf("[URL-DEBUG] SafeJoinURL called with baseURL=%s, path=%s\n", baseURL, path)
-
-	// Check if the path already includes query parameters
-	pathPart := path
-	queryPart := ""
-	if strings.Contains(path, "?") {
-		parts := strings.SplitN(path, "?", 2)
-		pathPart = parts[0]
-		queryPart = "?" + parts[1]
-	}
-
-	// If the path itself is already a complete URL (containing "://"), parse it and use just the path component
-	if strings.Contains(pathPart, "://") {
-		parsedPath, err := url.Parse(pathPart)
-		if err == nil {
-			fmt.Printf("[URL-DEBUG] Path contains full URL, extracting just the path component\n")
-			// Extract just the path component from the full URL in the path
-			pathPart = parsedPath.Path
-		}
-	}
-
-	// Special handling for rate limit endpoints
-	if strings.Contains(pathPart, "/organization/projects/") && strings.Contains(pathPart, "/rate_limits") {
-		// Extract project ID
-		projectPattern := `/organization/projects/([^/]+)/rate_limits`
-		projectRe := regexp.MustCompile(projectPattern)
-		projectMatches := projectRe.FindStringSubmatch(pathPart)
-
-		// Check if there's a rate limit ID in the path
-		rateLimitPattern := `/organization/projects/([^/]+)/rate_limits/(.+)`
-		rateLimitRe := regexp.MustCompile(rateLimitPattern)
-		rateLimitMatches := rateLimitRe.FindStringSubmatch(pathPart)
-
-		// Ensure the base URL is properly formatted
-		baseWithoutTrailingSlash := strings.TrimSuffix(baseURL, "/")
-		// Remove any "/v1" from the base URL to avoid duplication
-		baseWithoutV1 := strings.TrimSuffix(baseWithoutTrailingSlash, "/v1")
-
-		var correctURL string
-
-		// If we have a rate limit ID in the path, preserve it for PUT/POST/DELETE operations
-		if len(rateLimitMatches) == 3 {
-			projectID := rateLimitMatches[1]
-			rateLimitID := rateLimitMatches[2]
-
-			// Keep rate limit ID in the path for update/delete operations
-			correctURL = fmt.Sprintf("%s/v1/organization/projects/%s/rate_limits/%s",
-				baseWithoutV1, projectID, rateLimitID)
-
-			fmt.Printf("[URL-DEBUG] Constructed rate limit URL with ID: %s\n", correctURL)
-		} else if len(projectMatches) == 2 {
-			// No rate limit ID in the path, this is for list operations
-			projectID := projectMatches[1]
-
-			// For listing, we don't include a rate limit ID
-			correctURL = fmt.Sprintf("%s/v1/organization/projects/%s/rate_limits",
-				baseWithoutV1, projectID)
-
-			fmt.Printf("[URL-DEBUG] Constructed rate limit list URL: %s\n", correctURL)
-		} else {
-			// Fallback to standard URL joining
-			return baseURL + pathPart + queryPart
-		}
-
-		// Include query parameters if they exist
-		if strings.HasPrefix(queryPart, "?") {
-			correctURL += queryPart
-		}
-
-		return correctURL
-	}
-
-	// Try to parse the base URL
-	_, err := url.Parse(baseURL)
-	if err != nil {
-		// If we can't parse the URL, fall back to simple concatenation with v1 check
-		baseWithoutV1 := strings.TrimSuffix(strings.TrimSuffix(baseURL, "/"), "/v1")
-		pathWithV1 := pathPart
-		if !strings.HasPrefix(pathWithV1, "/v1") {
-			pathWithV1 = "/v1" + pathWithV1
-		}
-		result := baseWithoutV1 + pathWithV1 + queryPart
-		fmt.Printf("[URL-DEBUG] Failed to parse base URL, using simple concatenation: %s\n", result)
-		return result
-	}
-
-	// Handle OpenAI organization/projects paths specifically
-	if strings.Contains(pathPart, "/organization/projects/") ||
-		strings.Contains(pathPart, "/v1/organization/projects/") {
-		// Ensure the base URL doesn't have trailing /v1 and the path begins with /v1
-		trimmedBase := strings.TrimSuffix(baseURL, "/v1")
-		trimmedBase = strings.TrimSuffix(trimmedBase, "/")
-
-		// Make sure the path starts with /v1 but remove any duplicate /v1 occurrences
-		pathPart = strings.TrimPrefix(pathPart, "/v1")
-		if !strings.HasPrefix(pathPart, "/") {
-			pathPart = "/" + pathPart
-		}
-
-		// Join them properly
-		result := trimmedBase + "/v1" + pathPart + queryPart
-		fmt.Printf("[URL-DEBUG] Constructed organization/projects URL: %s\n", result)
-		return result
-	}
-
-	// For paths with rate_limits, ensure proper construction
-	if strings.Contains(pathPart, "rate_limits") {
-		// Ensure we don't duplicate any parts
-		trimmedBase := strings.TrimSuffix(baseURL, "/v1")
-		trimmedBase = strings.TrimSuffix(trimmedBase, "/")
-
-		// Extract clean path without any duplicate v1 prefixes
-		cleanPath := pathPart
-		cleanPath = strings.TrimPrefix(cleanPath, "/v1")
-		if !strings.HasPrefix(cleanPath, "/") {
-			cleanPath = "/" + cleanPath
-		}
-
-		// Check if this is a rate limit URL for a specific project
-		projectPattern := `/organization/projects/([^/]+)/rate_limits`
-		if match := regexp.MustCompile(projectPattern).FindStringSubmatch(cleanPath); match != nil {
-			projectID := match[1]
-			// Always use the clean endpoint with no additional path components
-			cleanPath = fmt.Sprintf("/organization/projects/%s/rate_limits", projectID)
-
-			// Only add query parameters if they start with "?"
-			if strings.HasPrefix(queryPart, "?") {
-				cleanPath += queryPart
-				queryPart = "" // Clear query part since we've incorporated it
-			}
-
-			// Log the reconstructed path for debugging
-			fmt.Printf("[URL-DEBUG] Reconstructed rate_limits path: %s\n", cleanPath)
-		}
-
-		// Join the path with the base URL, ensuring just one /v1 prefix
-		result := trimmedBase + "/v1" + cleanPath + queryPart
-		fmt.Printf("[URL-DEBUG] Constructed rate_limits URL: %s\n", result)
-		return result
-	}
-
-	// For other cases, ensure no duplicate v1 in path
-	cleanPath := pathPart
-	cleanPath = strings.TrimPrefix(cleanPath, "/v1")
-
-	// Use standard URL joining with clean paths
-	trimmedBase := strings.TrimSuffix(baseURL, "/v1")
-	trimmedBase = strings.TrimSuffix(trimmedBase, "/")
-
-	if !strings.HasPrefix(cleanPath, "/") {
-		cleanPath = "/" + cleanPath
-	}
-
-	result := trimmedBase + "/v1" + cleanPath + queryPart
-	fmt.Printf("[URL-DEBUG] Standard URL joining result: %s\n", result)
-	return result
-}
-
 // CreateModelResponse creates a model response using the OpenAI API
 func (c *OpenAIClient) CreateModelResponse(request *ModelResponseRequest) (*ModelResponse, error) {
 	fmt.Printf("\n\n[CREATEMODEL-DEBUG] ========== CREATE MODEL RESPONSE DEBUG ==========\n")
@@ -2165,8 +2924,8 @@ func (c *OpenAIClient) CreateModelResponse(request *ModelResponseRequest) (*Mode
 	fmt.Printf("[CREATEMODEL-DEBUG] Function address: %p\n", c.CreateModelResponse)
 	fmt.Printf("[CREATEMODEL-DEBUG] DoRequest address: %p\n", c.DoRequest)
 
-	// Ensure we use the correct endpoint - always use /v1/responses for model response
-	path := "/v1/responses"
+	// Ensure we use the correct endpoint - always the responses API
+	path := "responses"
 	fmt.Printf("[CREATEMODEL-DEBUG] Using path: %s\n", path)
 
 	// Print stack trace to find caller
@@ -2255,7 +3014,7 @@ func (c *OpenAIClient) CreateInvite(email, role string, projects []InviteProject
 	}
 
 	// Prepare URL for the API request
-	url := "/organization/invites"
+	url := "organization/invites"
 
 	// Use the default API key
 	respBody, err := c.DoRequest("POST", url, inviteRequest)
@@ -2276,7 +3035,7 @@ func (c *OpenAIClient) CreateInvite(email, role string, projects []InviteProject
 // GetInvite retrieves an invitation by ID
 func (c *OpenAIClient) GetInvite(inviteID string) (*Invite, error) {
 	// Prepare URL for the API request
-	url := fmt.Sprintf("/v1/organization/invites/%s", inviteID)
+	url := fmt.Sprintf("organization/invites/%s", inviteID)
 
 	// Use the default API key
 	respBody, err := c.DoRequest("GET", url, nil)
@@ -2296,10 +3055,15 @@ func (c *OpenAIClient) GetInvite(inviteID string) (*Invite, error) {
 
 // ListInvites retrieves all pending invitations for the organization
 func (c *OpenAIClient) ListInvites() (*ListInvitesResponse, error) {
-	// Create a client with extended timeout specifically for this operation
-	// which can be slow for organizations with many invites
+	// Listing can be slow for organizations with many invites, so use the
+	// configured timeout and only fall back to an extended default.
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
 	httpClient := &http.Client{
-		Timeout: 2 * time.Minute, // Increase timeout to 2 minutes
+		Timeout:   timeout,
+		Transport: c.HTTPClient.Transport,
 	}
 
 	// Save the original HTTP client to restore it later
@@ -2311,7 +3075,7 @@ func (c *OpenAIClient) ListInvites() (*ListInvitesResponse, error) {
 	}()
 
 	// Prepare URL for the API request
-	url := "/v1/organization/invites"
+	url := "organization/invites"
 
 	// Use the default API key
 	respBody, err := c.DoRequest("GET", url, nil)
@@ -2332,7 +3096,7 @@ func (c *OpenAIClient) ListInvites() (*ListInvitesResponse, error) {
 // DeleteInvite cancels an invitation
 func (c *OpenAIClient) DeleteInvite(inviteID string) error {
 	// Prepare URL for the API request
-	url := fmt.Sprintf("/v1/organization/invites/%s", inviteID)
+	url := fmt.Sprintf("organization/invites/%s", inviteID)
 
 	// Use the default API key
 	_, err := c.DoRequest("DELETE", url, nil)
@@ -2351,7 +3115,11 @@ func (c *OpenAIClient) DeleteInvite(inviteID string) error {
 
 // ListRateLimits retrieves all rate limits for a specific project.
 func (c *OpenAIClient) ListRateLimits(projectID string, limit int, after string) (*RateLimitListResponse, error) {
-	url := fmt.Sprintf("/v1/organization/projects/%s/rate_limits", projectID)
+	if err := c.requireAdminKey("listing rate limits"); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("organization/projects/%s/rate_limits", projectID)
 
 	// Add query parameters
 	queryParams := make([]string, 0)
@@ -2379,31 +3147,102 @@ func (c *OpenAIClient) ListRateLimits(projectID string, limit int, after string)
 	return &response, nil
 }
 
-// Helper function to extract the model name from a rate limit ID
-func extractModelFromRateLimitID(rateLimitID string) string {
-	if !strings.HasPrefix(rateLimitID, "rl-") {
-		return rateLimitID // Not a rate limit ID, return as is
+// ListAllRateLimits retrieves every rate limit in a project, following the
+// `after` cursor across pages.
+func (c *OpenAIClient) ListAllRateLimits(projectID string) ([]RateLimit, error) {
+	var allRateLimits []RateLimit
+	after := ""
+
+	for {
+		rateLimits, err := c.ListRateLimits(projectID, 100, after)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list rate limits: %w", err)
+		}
+
+		allRateLimits = append(allRateLimits, rateLimits.Data...)
+
+		if !rateLimits.HasMore || rateLimits.LastID == "" {
+			break
+		}
+		after = rateLimits.LastID
+	}
+
+	return allRateLimits, nil
+}
+
+// MergeDefaultRateLimits returns explicit plus a default entry for every
+// model in defaultRateLimits that explicit does not cover, giving the
+// effective limits for all known models. Default entries have an empty ID.
+// The result is sorted by model name.
+func MergeDefaultRateLimits(explicit []RateLimit) []RateLimit {
+	seen := make(map[string]bool, len(explicit))
+	merged := make([]RateLimit, 0, len(explicit)+len(defaultRateLimits))
+	for _, rl := range explicit {
+		seen[rl.Model] = true
+		merged = append(merged, rl)
+	}
+
+	for model := range defaultRateLimits {
+		if model == "default" || seen[model] {
+			continue
+		}
+		rl := getDefaultRateLimitValues(model)
+		rl.Object = "project.rate_limit"
+		merged = append(merged, *rl)
 	}
 
-	// Rate limit IDs typically follow the pattern: rl-model[-model-parts][-project-suffix]
-	parts := strings.Split(rateLimitID, "-")
-	if len(parts) < 3 || parts[0] != "rl" {
-		return strings.TrimPrefix(rateLimitID, "rl-") // Simple case
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Model < merged[j].Model })
+	return merged
+}
+
+// findRateLimit returns the rate limit in data identified by idOrModel, or nil.
+//
+// Matching is exact only, so that models sharing a prefix (gpt-4, gpt-4o,
+// gpt-4o-mini, gpt-4-turbo) can never resolve to each other:
+//   - an exact rate limit ID match always wins;
+//   - an "rl-" prefixed value then matches "rl-<model>" exactly, and only
+//     failing that the provider's "rl-<model>-<suffix>" form, where suffix
+//     must be the last 8 characters of projectID (see rateLimitProjectSuffix);
+//   - any other value is treated as a model name and must match exactly.
+func findRateLimit(data []RateLimit, projectID, idOrModel string) *RateLimit {
+	for i := range data {
+		if data[i].ID == idOrModel {
+			return &data[i]
+		}
 	}
 
-	// For complex model names with hyphens, we need to determine
-	// which parts belong to the model and which might be a project suffix
+	if !strings.HasPrefix(idOrModel, "rl-") {
+		for i := range data {
+			if data[i].Model == idOrModel {
+				return &data[i]
+			}
+		}
+		return nil
+	}
 
-	// If it ends with a known project suffix pattern (typically 8 chars),
-	// remove that part. Otherwise, take everything after "rl-"
-	if len(parts) >= 4 && len(parts[len(parts)-1]) == 8 {
-		// Return everything except the first and last parts
-		modelParts := parts[1 : len(parts)-1]
-		return strings.Join(modelParts, "-")
+	for i := range data {
+		if idOrModel == "rl-"+data[i].Model {
+			return &data[i]
+		}
+	}
+	if projectID == "" {
+		return nil
 	}
+	for i := range data {
+		if idOrModel == "rl-"+data[i].Model+"-"+rateLimitProjectSuffix(projectID) {
+			return &data[i]
+		}
+	}
+	return nil
+}
 
-	// Default case - just remove the "rl-" prefix
-	return strings.TrimPrefix(rateLimitID, "rl-")
+// rateLimitProjectSuffix returns the part of projectID the provider appends
+// to rate limit resource IDs: its last 8 characters, or all of it if shorter.
+func rateLimitProjectSuffix(projectID string) string {
+	if len(projectID) > 8 {
+		return projectID[len(projectID)-8:]
+	}
+	return projectID
 }
 
 // defaultRateLimits contains the default rate limit values for each model
@@ -2889,30 +3728,118 @@ func getDefaultRateLimitValues(model string) *RateLimit {
 
 		// If still not found, use fallback values
 		if !ok {
+			unlimited := 1000000 // Very high value to effectively make it unlimited
 			return &RateLimit{
 				Model:                       model,
-				MaxRequestsPer1Minute:       1000000, // Very high value to effectively make it unlimited
-				MaxTokensPer1Minute:         1000000, // Very high value to effectively make it unlimited
-				MaxImagesPer1Minute:         1000000, // Very high value to effectively make it unlimited
-				Batch1DayMaxInputTokens:     1000000, // Very high value to effectively make it unlimited
-				MaxAudioMegabytesPer1Minute: 1000000, // Very high value to effectively make it unlimited
-				MaxRequestsPer1Day:          1000000, // Very high value to effectively make it unlimited
+				MaxRequestsPer1Minute:       unlimited,
+				MaxTokensPer1Minute:         unlimited,
+				MaxImagesPer1Minute:         &unlimited,
+				Batch1DayMaxInputTokens:     &unlimited,
+				MaxAudioMegabytesPer1Minute: &unlimited,
+				MaxRequestsPer1Day:          &unlimited,
 			}
 		}
 	}
 
-	// Return values from the defaults map
+	// Return values from the defaults map. Zero entries mean the limit
+	// doesn't apply to the model, so they are left nil.
 	return &RateLimit{
 		Model:                       model,
 		MaxRequestsPer1Minute:       defaults.MaxRequestsPer1Minute,
 		MaxTokensPer1Minute:         defaults.MaxTokensPer1Minute,
-		MaxImagesPer1Minute:         defaults.MaxImagesPer1Minute,
-		Batch1DayMaxInputTokens:     defaults.Batch1DayMaxInputTokens,
-		MaxAudioMegabytesPer1Minute: defaults.MaxAudioMegabytesPer1Minute,
-		MaxRequestsPer1Day:          defaults.MaxRequestsPer1Day,
+		MaxImagesPer1Minute:         positiveIntPtr(defaults.MaxImagesPer1Minute),
+		Batch1DayMaxInputTokens:     positiveIntPtr(defaults.Batch1DayMaxInputTokens),
+		MaxAudioMegabytesPer1Minute: positiveIntPtr(defaults.MaxAudioMegabytesPer1Minute),
+		MaxRequestsPer1Day:          positiveIntPtr(defaults.MaxRequestsPer1Day),
 	}
 }
 
+// DefaultRateLimit returns the default limits for model and whether model
+// has its own entry in defaultRateLimits. Unknown models get the values of
+// the "default" entry.
+func DefaultRateLimit(model string) (RateLimit, bool) {
+	_, known := defaultRateLimits[model]
+	return *getDefaultRateLimitValues(model), known
+}
+
+// DefaultRateLimits returns every entry of defaultRateLimits, including the
+// "default" fallback, sorted by model name.
+func DefaultRateLimits() []RateLimit {
+	all := make([]RateLimit, 0, len(defaultRateLimits))
+	for model := range defaultRateLimits {
+		all = append(all, *getDefaultRateLimitValues(model))
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Model < all[j].Model })
+	return all
+}
+
+// positiveIntPtr returns a pointer to v, or nil when v is not positive
+func positiveIntPtr(v int) *int {
+	if v <= 0 {
+		return nil
+	}
+	return &v
+}
+
+// AccountInfo describes the account behind the client's API key, as
+// reported by CheckAuth.
+type AccountInfo struct {
+	OrganizationID string
+	KeyScope       string
+}
+
+// CheckAuth makes a cheap authenticated request to confirm that the API key
+// is accepted, and returns the organization the API reports for it. Admin
+// keys are checked against the projects endpoint, since they cannot list
+// models. Rejected keys are returned as *APIError; if the request could not
+// be sent at all, the error includes the result of TestNetworkConnectivity.
+func (c *OpenAIClient) CheckAuth(ctx context.Context) (*AccountInfo, error) {
+	info := &AccountInfo{OrganizationID: c.OrganizationID, KeyScope: KeyScope(c.APIKey)}
+
+	path := "models"
+	if info.KeyScope == KeyScopeAdmin {
+		path = "organization/projects?limit=1"
+	}
+	u, err := joinURL(c.APIURL, path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	if c.OrganizationID != "" {
+		req.Header.Set("OpenAI-Organization", c.OrganizationID)
+	}
+	if c.ProjectID != "" {
+		req.Header.Set("OpenAI-Project", c.ProjectID)
+	}
+	c.SetDefaultHeaders(req)
+
+	resp, err := c.send(req)
+	if err != nil {
+		if netErr := c.TestNetworkConnectivity(); netErr != nil {
+			return nil, fmt.Errorf("error making request: %w (network check: %v)", err, netErr)
+		}
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, newAPIError(resp.StatusCode, body)
+	}
+
+	if org := resp.Header.Get("OpenAI-Organization"); org != "" {
+		info.OrganizationID = org
+	}
+	return info, nil
+}
+
 // TestNetworkConnectivity tests if we can connect to the OpenAI API
 func (c *OpenAIClient) TestNetworkConnectivity() error {
 	fmt.Printf("[NETWORK-TEST] Testing network connectivity to OpenAI API\n")
@@ -2996,6 +3923,10 @@ type CreateResponseRequest struct {
 	Include            []string               `json:"include,omitempty"`
 	Prompt             *PromptConfig          `json:"prompt,omitempty"`
 	Conversation       *string                `json:"conversation,omitempty"` // ID only
+	Background         *bool                  `json:"background,omitempty"`
+	Stream             *bool                  `json:"stream,omitempty"`
+	SafetyIdentifier   *string                `json:"safety_identifier,omitempty"`
+	PromptCacheKey     *string                `json:"prompt_cache_key,omitempty"`
 }
 
 type TextConfig struct {
@@ -3024,13 +3955,37 @@ type ReasoningConfig struct {
 }
 
 type ResponseResponse struct {
-	ID        string          `json:"id"`
-	CreatedAt int64           `json:"created_at"`
-	Output    []APIOutputItem `json:"output"`
+	ID                string                     `json:"id"`
+	Model             string                     `json:"model,omitempty"`
+	CreatedAt         int64                      `json:"created_at"`
+	Status            string                     `json:"status,omitempty"`
+	Output            []APIOutputItem            `json:"output"`
+	Error             *ResponseError             `json:"error,omitempty"`
+	IncompleteDetails *ResponseIncompleteDetails `json:"incomplete_details,omitempty"`
+	Usage             *ResponseUsage             `json:"usage,omitempty"`
+}
+
+// ResponseUsage reports the tokens a response consumed
+type ResponseUsage struct {
+	InputTokens  int64 `json:"input_tokens"`
+	OutputTokens int64 `json:"output_tokens"`
+	TotalTokens  int64 `json:"total_tokens"`
+}
+
+// ResponseError describes why a response failed
+type ResponseError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ResponseIncompleteDetails describes why a response is incomplete
+type ResponseIncompleteDetails struct {
+	Reason string `json:"reason"`
 }
 
 type APIOutputItem struct {
 	Type    string            `json:"type"`
+	Role    string            `json:"role,omitempty"`
 	Content interface{}       `json:"content"`
 	Message *APIOutputMessage `json:"message,omitempty"`
 }
@@ -3041,7 +3996,7 @@ type APIOutputMessage struct {
 
 // CreateResponse calls the /v1/responses API to generate a response
 func (c *OpenAIClient) CreateResponse(req CreateResponseRequest) (*ResponseResponse, error) {
-	url := "/v1/responses"
+	url := "responses"
 	respBody, err := c.DoRequest("POST", url, req)
 	if err != nil {
 		return nil, err
@@ -3057,7 +4012,7 @@ func (c *OpenAIClient) CreateResponse(req CreateResponseRequest) (*ResponseRespo
 
 // RetrieveResponse calls the GET /v1/responses/{id} API
 func (c *OpenAIClient) RetrieveResponse(id string) (*ResponseResponse, error) {
-	url := fmt.Sprintf("/v1/responses/%s", id)
+	url := fmt.Sprintf("responses/%s", id)
 	respBody, err := c.DoRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -3070,3 +4025,172 @@ func (c *OpenAIClient) RetrieveResponse(id string) (*ResponseResponse, error) {
 
 	return &resp, nil
 }
+
+// CancelResponse calls the POST /v1/responses/{id}/cancel API. Only
+// background responses that are still queued or in progress can be cancelled.
+func (c *OpenAIClient) CancelResponse(id string) (*ResponseResponse, error) {
+	url := fmt.Sprintf("responses/%s/cancel", id)
+	respBody, err := c.DoRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ResponseResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// ResponseStreamEndedReason is the incomplete reason CreateResponseStream
+// reports when the event stream ends before the response finished.
+const ResponseStreamEndedReason = "stream_ended"
+
+// responseStreamEvent is a server-sent event from a streamed response. Only
+// the fields the client acts on are decoded.
+type responseStreamEvent struct {
+	Type     string            `json:"type"`
+	Delta    string            `json:"delta,omitempty"`
+	Response *ResponseResponse `json:"response,omitempty"`
+	Code     string            `json:"code,omitempty"`
+	Message  string            `json:"message,omitempty"`
+}
+
+// CreateResponseStream calls the /v1/responses API with stream set and reads
+// the event stream to the end. The response from the final
+// response.completed, response.incomplete or response.failed event is
+// returned. If the stream ends without one, the generation was cut short:
+// the response is assembled from the response.created event and the text
+// deltas received so far, and marked incomplete with the reason
+// ResponseStreamEndedReason.
+func (c *OpenAIClient) CreateResponseStream(ctx context.Context, req CreateResponseRequest) (*ResponseResponse, error) {
+	stream := true
+	req.Stream = &stream
+
+	var created, final *ResponseResponse
+	var text strings.Builder
+	err := c.DoStreamRequestContext(ctx, http.MethodPost, "responses", req, func(data []byte) error {
+		var event responseStreamEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return fmt.Errorf("error parsing stream event: %w", err)
+		}
+		switch event.Type {
+		case "response.created":
+			created = event.Response
+		case "response.output_text.delta":
+			text.WriteString(event.Delta)
+		case "response.completed", "response.incomplete", "response.failed":
+			final = event.Response
+		case "error":
+			return fmt.Errorf("stream error %s: %s", event.Code, event.Message)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if final != nil {
+		return final, nil
+	}
+	if created == nil {
+		return nil, fmt.Errorf("response stream ended before the response was created")
+	}
+	created.Status = "incomplete"
+	created.IncompleteDetails = &ResponseIncompleteDetails{Reason: ResponseStreamEndedReason}
+	if len(created.Output) == 0 && text.Len() > 0 {
+		created.Output = []APIOutputItem{{
+			Type:    "message",
+			Content: []interface{}{map[string]interface{}{"type": "output_text", "text": text.String()}},
+		}}
+	}
+	return created, nil
+}
+
+// DoStreamRequestContext sends a JSON request that answers with a
+// text/event-stream body and calls fn with the data of each event, as read by
+// ReadSSE. Error responses are returned as *APIError before any event is read.
+func (c *OpenAIClient) DoStreamRequestContext(ctx context.Context, method, path string, body interface{}, fn func(data []byte) error) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %w", err)
+	}
+
+	u, err := joinURL(c.APIURL, path)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "text/event-stream")
+	httpReq.Header.Set("Authorization", "Bearer "+c.APIKey)
+	if c.OrganizationID != "" {
+		httpReq.Header.Set("OpenAI-Organization", c.OrganizationID)
+	}
+	if c.ProjectID != "" {
+		httpReq.Header.Set("OpenAI-Project", c.ProjectID)
+	}
+	c.SetDefaultHeaders(httpReq)
+
+	resp, err := c.send(httpReq)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("error reading response: %w", err)
+		}
+		return newAPIError(resp.StatusCode, body)
+	}
+
+	return ReadSSE(resp.Body, fn)
+}
+
+// ReadSSE reads a text/event-stream body and calls fn with the data of each
+// event, joining multi-line data with newlines. Other SSE fields are ignored,
+// since OpenAI events carry their type in the data. Reading stops at the end
+// of the body, at a "[DONE]" sentinel, or at the first error from fn.
+func ReadSSE(r io.Reader, fn func(data []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	var lines []string
+	dispatch := func() (bool, error) {
+		if len(lines) == 0 {
+			return false, nil
+		}
+		data := strings.Join(lines, "\n")
+		lines = lines[:0]
+		if data == "[DONE]" {
+			return true, nil
+		}
+		return false, fn([]byte(data))
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			done, err := dispatch()
+			if err != nil || done {
+				return err
+			}
+			continue
+		}
+		if v, ok := strings.CutPrefix(line, "data:"); ok {
+			lines = append(lines, strings.TrimPrefix(v, " "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading event stream: %w", err)
+	}
+
+	_, err := dispatch()
+	return err
+}
@@ -0,0 +1,1020 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestFindRateLimit(t *testing.T) {
+	const projectID = "proj_abc12345678"
+	data := []RateLimit{
+		{ID: "rl-gpt-4o-mini", Model: "gpt-4o-mini"},
+		{ID: "rl-gpt-4o", Model: "gpt-4o"},
+		{ID: "rl-gpt-4", Model: "gpt-4"},
+	}
+	// Without gpt-4o-mini or gpt-4-turbo in the list, their IDs must not
+	// fall back to the model they share a prefix with.
+	withoutLonger := []RateLimit{
+		{ID: "rl-gpt-4o", Model: "gpt-4o"},
+		{ID: "rl-gpt-4", Model: "gpt-4"},
+	}
+
+	cases := []struct {
+		name      string
+		data      []RateLimit // nil means data
+		idOrModel string
+		wantModel string // empty means no match
+	}{
+		{"model gpt-4", nil, "gpt-4", "gpt-4"},
+		{"model gpt-4o", nil, "gpt-4o", "gpt-4o"},
+		{"model gpt-4o-mini", nil, "gpt-4o-mini", "gpt-4o-mini"},
+		{"api id gpt-4", nil, "rl-gpt-4", "gpt-4"},
+		{"api id gpt-4o", nil, "rl-gpt-4o", "gpt-4o"},
+		{"provider id gpt-4", nil, "rl-gpt-4-12345678", "gpt-4"},
+		{"provider id gpt-4o", nil, "rl-gpt-4o-12345678", "gpt-4o"},
+		{"provider id gpt-4o-mini", nil, "rl-gpt-4o-mini-12345678", "gpt-4o-mini"},
+		{"provider id other project", nil, "rl-gpt-4o-87654321", ""},
+		{"model prefix only", nil, "gpt-4o-m", ""},
+		{"unknown model", nil, "gpt-3.5-turbo", ""},
+		{"unknown id", nil, "rl-gpt-3.5-turbo", ""},
+		{"api id gpt-4o-mini not listed", withoutLonger, "rl-gpt-4o-mini", ""},
+		{"api id gpt-4-turbo not listed", withoutLonger, "rl-gpt-4-turbo", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rls := tc.data
+			if rls == nil {
+				rls = data
+			}
+			got := findRateLimit(rls, projectID, tc.idOrModel)
+			switch {
+			case tc.wantModel == "" && got != nil:
+				t.Errorf("findRateLimit(%q) = %q, want no match", tc.idOrModel, got.Model)
+			case tc.wantModel != "" && got == nil:
+				t.Errorf("findRateLimit(%q) = nil, want %q", tc.idOrModel, tc.wantModel)
+			case got != nil && got.Model != tc.wantModel:
+				t.Errorf("findRateLimit(%q) = %q, want %q", tc.idOrModel, got.Model, tc.wantModel)
+			}
+		})
+	}
+}
+
+func TestListAllProjects_FollowsCursor(t *testing.T) {
+	pages := map[string]string{
+		"":       `{"object":"list","data":[{"id":"proj_1","name":"one"},{"id":"proj_2","name":"two"}],"has_more":true}`,
+		"proj_2": `{"object":"list","data":[{"id":"proj_3","name":"three"}],"has_more":true}`,
+		"proj_3": `{"object":"list","data":[{"id":"proj_4","name":"four"}],"has_more":false}`,
+	}
+
+	var afters []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/projects" {
+			http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
+		if r.URL.Query().Get("include_archived") != "true" {
+			http.Error(w, "include_archived not forwarded", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(pages[after]))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test-admin-key-0000", APIURL: srv.URL + "/v1"})
+
+	projects, err := c.ListAllProjects(true)
+	if err != nil {
+		t.Fatalf("ListAllProjects: %v", err)
+	}
+
+	var ids []string
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	if got, want := strings.Join(ids, ","), "proj_1,proj_2,proj_3,proj_4"; got != want {
+		t.Errorf("projects = %s, want %s", got, want)
+	}
+	if got, want := strings.Join(afters, ","), ",proj_2,proj_3"; got != want {
+		t.Errorf("after cursors = %q, want %q", got, want)
+	}
+}
+
+func TestListAllUsers_FollowsCursorAndForwardsEmails(t *testing.T) {
+	pages := map[string]string{
+		"":       `{"object":"list","data":[{"id":"user_1","email":"a@example.com"}],"last_id":"user_1","has_more":true}`,
+		"user_1": `{"object":"list","data":[{"id":"user_2","email":"b@example.com"}],"last_id":"user_2","has_more":false}`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/users" {
+			http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		if got := strings.Join(r.URL.Query()["emails"], ","); got != "a@example.com,b@example.com" {
+			http.Error(w, "emails not forwarded: "+got, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(pages[r.URL.Query().Get("after")]))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test-admin-key-0000", APIURL: srv.URL + "/v1"})
+
+	users, err := c.ListAllUsers([]string{"a@example.com", "b@example.com"})
+	if err != nil {
+		t.Fatalf("ListAllUsers: %v", err)
+	}
+	if len(users) != 2 || users[0].ID != "user_1" || users[1].ID != "user_2" {
+		t.Errorf("unexpected users: %+v", users)
+	}
+}
+
+func TestSearchVectorStore_SendsParamsAndDecodesResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/vector_stores/vs_1/search" {
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ranking, _ := body["ranking_options"].(map[string]interface{})
+		if body["query"] != "refund policy" || body["max_num_results"] != float64(2) || ranking["score_threshold"] != 0.5 {
+			http.Error(w, "unexpected body", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"object":"vector_store.search_results.page","search_query":["refund policy"],"data":[{"file_id":"file-1","filename":"policies.md","score":0.9,"content":[{"type":"text","text":"Refunds within 30 days."}]}],"has_more":false}`))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test-0000", APIURL: srv.URL + "/v1"})
+
+	threshold := 0.5
+	res, err := c.SearchVectorStore(context.Background(), &VectorStoreSearchParams{
+		VectorStoreID:  "vs_1",
+		Query:          "refund policy",
+		MaxNumResults:  2,
+		RankingOptions: &VectorStoreSearchRankingOptions{ScoreThreshold: &threshold},
+	})
+	if err != nil {
+		t.Fatalf("SearchVectorStore: %v", err)
+	}
+	if len(res.Data) != 1 || res.Data[0].Filename != "policies.md" || res.Data[0].Content[0].Text != "Refunds within 30 days." {
+		t.Errorf("unexpected results: %+v", res.Data)
+	}
+}
+
+func TestListAllFineTuningCheckpoints_FollowsCursor(t *testing.T) {
+	pages := map[string]string{
+		"":         `{"object":"list","data":[{"id":"ftckpt_3","step_number":300,"metrics":{"train_loss":0.2}}],"last_id":"ftckpt_3","has_more":true}`,
+		"ftckpt_3": `{"object":"list","data":[{"id":"ftckpt_2","step_number":200}],"last_id":"ftckpt_2","has_more":false}`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/fine_tuning/jobs/ftjob-1/checkpoints" {
+			http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(pages[r.URL.Query().Get("after")]))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test-0000", APIURL: srv.URL + "/v1"})
+
+	checkpoints, err := c.ListAllFineTuningCheckpoints(context.Background(), "ftjob-1")
+	if err != nil {
+		t.Fatalf("ListAllFineTuningCheckpoints: %v", err)
+	}
+	if len(checkpoints) != 2 || checkpoints[0].ID != "ftckpt_3" || checkpoints[1].ID != "ftckpt_2" {
+		t.Fatalf("unexpected checkpoints: %+v", checkpoints)
+	}
+	if m := checkpoints[0].Metrics; m == nil || m.TrainLoss == nil || *m.TrainLoss != 0.2 {
+		t.Errorf("metrics not decoded: %+v", m)
+	}
+}
+
+func TestListFineTuningEvents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/fine_tuning/jobs/ftjob-1/events" {
+			http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		if q := r.URL.Query(); q.Get("after") != "ftevent-9" || q.Get("limit") != "100" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"object":"list","data":[{"id":"ftevent-8","level":"info","message":"Step 10/100","type":"metrics","data":{"step":10}}],"has_more":true}`))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test-0000", APIURL: srv.URL + "/v1"})
+
+	page, err := c.ListFineTuningEvents(context.Background(), "ftjob-1", "ftevent-9", 100)
+	if err != nil {
+		t.Fatalf("ListFineTuningEvents: %v", err)
+	}
+	if !page.HasMore || len(page.Data) != 1 || page.Data[0].ID != "ftevent-8" || string(page.Data[0].Data) != `{"step":10}` {
+		t.Errorf("unexpected page: %+v", page)
+	}
+}
+
+func TestListCompletionsUsage_FollowsNextPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/usage/completions" {
+			http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("start_time") != "1700000000" || q.Get("bucket_width") != "1d" ||
+			strings.Join(q["project_ids"], ",") != "proj_a,proj_b" || strings.Join(q["models"], ",") != "gpt-4o" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("page") == "" {
+			_, _ = w.Write([]byte(`{"object":"page","data":[{"object":"bucket","start_time":1700000000,"end_time":1700086400,"results":[{"input_tokens":10,"num_model_requests":1}]}],"has_more":true,"next_page":"page_2"}`))
+			return
+		}
+		if q.Get("page") != "page_2" {
+			t.Errorf("page = %q, want page_2", q.Get("page"))
+		}
+		_, _ = w.Write([]byte(`{"object":"page","data":[{"object":"bucket","start_time":1700086400,"end_time":1700172800,"results":[]}],"has_more":false,"next_page":null}`))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-admin-0000", APIURL: srv.URL + "/v1"})
+
+	buckets, err := c.ListCompletionsUsage(context.Background(), UsageQuery{
+		StartTime:   1700000000,
+		BucketWidth: "1d",
+		ProjectIDs:  []string{"proj_a", "proj_b"},
+		Models:      []string{"gpt-4o"},
+	})
+	if err != nil {
+		t.Fatalf("ListCompletionsUsage: %v", err)
+	}
+	if len(buckets) != 2 || buckets[0].Results[0].InputTokens != 10 || buckets[1].StartTime != 1700086400 {
+		t.Fatalf("unexpected buckets: %+v", buckets)
+	}
+}
+
+func TestDoRequestContext_ReturnsAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("OpenAI-Organization") != "org-test" {
+			t.Errorf("OpenAI-Organization = %q", r.Header.Get("OpenAI-Organization"))
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"message":"No such job","type":"invalid_request_error","code":"not_found"}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "k", OrganizationID: "org-test", APIURL: srv.URL + "/v1"})
+	_, err := c.DoRequestContext(context.Background(), http.MethodGet, "/v1/fine_tuning/jobs/ftjob-x", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Code != "not_found" || apiErr.Message != "No such job" {
+		t.Errorf("unexpected APIError: %+v", apiErr)
+	}
+	if !IsNotFound(err) {
+		t.Error("IsNotFound should be true for a 404")
+	}
+	if err.Error() != "API error (status 404): No such job" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestDoRequestContext_HonoursCancellation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent with a cancelled context")
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "k", APIURL: srv.URL + "/v1"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.DoRequestContext(ctx, http.MethodGet, "/v1/models", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestReadSSE_JoinsDataAndStopsAtDone(t *testing.T) {
+	body := ": keep-alive\n\nevent: a\ndata: one\n\ndata: two\ndata: lines\n\ndata: [DONE]\n\ndata: after\n\n"
+
+	var got []string
+	if err := ReadSSE(strings.NewReader(body), func(data []byte) error {
+		got = append(got, string(data))
+		return nil
+	}); err != nil {
+		t.Fatalf("ReadSSE: %v", err)
+	}
+
+	want := []string{"one", "two\nlines"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("events = %q, want %q", got, want)
+	}
+}
+
+func TestCreateResponseStream_UsesCompletedResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["stream"] != true {
+			t.Errorf("stream = %v, want true", body["stream"])
+		}
+		if r.Header.Get("Accept") != "text/event-stream" {
+			t.Errorf("Accept = %q", r.Header.Get("Accept"))
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: response.created\n" +
+			`data: {"type":"response.created","response":{"id":"resp_1","created_at":1,"status":"in_progress","output":[]}}` + "\n\n" +
+			`data: {"type":"response.output_text.delta","delta":"Hel"}` + "\n\n" +
+			`data: {"type":"response.output_text.delta","delta":"lo"}` + "\n\n" +
+			`data: {"type":"response.completed","response":{"id":"resp_1","created_at":1,"status":"completed","output":[{"type":"message","content":[{"type":"output_text","text":"Hello"}]}],"usage":{"input_tokens":3,"output_tokens":2,"total_tokens":5}}}` + "\n\n"))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "k", APIURL: srv.URL + "/v1"})
+	resp, err := c.CreateResponseStream(context.Background(), CreateResponseRequest{Model: "gpt-4o", Input: "hi"})
+	if err != nil {
+		t.Fatalf("CreateResponseStream: %v", err)
+	}
+	if resp.Status != "completed" || len(resp.Output) != 1 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Usage == nil || resp.Usage.TotalTokens != 5 {
+		t.Errorf("usage = %+v, want 5 total tokens", resp.Usage)
+	}
+}
+
+func TestCreateResponseStream_TruncatedStreamIsIncomplete(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(`data: {"type":"response.created","response":{"id":"resp_2","created_at":1,"status":"in_progress","output":[]}}` + "\n\n" +
+			`data: {"type":"response.output_text.delta","delta":"Hel"}` + "\n\n" +
+			`data: {"type":"response.output_text.delta","delta":"lo"}` + "\n\n" +
+			"data: [DONE]\n\n"))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "k", APIURL: srv.URL + "/v1"})
+	resp, err := c.CreateResponseStream(context.Background(), CreateResponseRequest{Model: "gpt-4o", Input: "hi"})
+	if err != nil {
+		t.Fatalf("CreateResponseStream: %v", err)
+	}
+	if resp.ID != "resp_2" || resp.Status != "incomplete" || len(resp.Output) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.IncompleteDetails == nil || resp.IncompleteDetails.Reason != ResponseStreamEndedReason {
+		t.Errorf("incomplete_details = %+v, want the stream_ended reason", resp.IncompleteDetails)
+	}
+	parts, _ := resp.Output[0].Content.([]interface{})
+	if len(parts) != 1 || parts[0].(map[string]interface{})["text"] != "Hello" {
+		t.Errorf("content = %#v, want the deltas received so far", resp.Output[0].Content)
+	}
+}
+
+func TestCreateResponseStream_ReturnsStreamError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(`data: {"type":"error","code":"server_error","message":"boom"}` + "\n\n"))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "k", APIURL: srv.URL + "/v1"})
+	if _, err := c.CreateResponseStream(context.Background(), CreateResponseRequest{Model: "gpt-4o", Input: "hi"}); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("err = %v, want the stream error", err)
+	}
+}
+
+func TestDryRunTransport(t *testing.T) {
+	c := NewClientWithConfig(ClientConfig{APIKey: "test", APIURL: "http://127.0.0.1:1/v1", DryRun: true})
+
+	body, err := c.DoRequest(http.MethodPost, "/v1/chat/completions", map[string]interface{}{"model": "gpt-4o"})
+	if err != nil {
+		t.Fatalf("POST: %v", err)
+	}
+	var created map[string]interface{}
+	if err := json.Unmarshal(body, &created); err != nil {
+		t.Fatalf("POST reply: %v", err)
+	}
+	id, _ := created["id"].(string)
+	if id == "" || created["model"] != "gpt-4o" || created["status"] != "completed" {
+		t.Fatalf("POST reply = %v, want the request echoed with an id and status", created)
+	}
+
+	body, err = c.DoRequest(http.MethodGet, "/v1/chat/completions/chatcmpl-123", nil)
+	if err != nil {
+		t.Fatalf("GET object: %v", err)
+	}
+	if !strings.Contains(string(body), `"id":"chatcmpl-123"`) {
+		t.Fatalf("GET object reply = %s, want the requested id", body)
+	}
+
+	body, err = c.DoRequest(http.MethodGet, "/v1/files", nil)
+	if err != nil {
+		t.Fatalf("GET list: %v", err)
+	}
+	if !strings.Contains(string(body), `"object":"list"`) {
+		t.Fatalf("GET list reply = %s, want an empty list", body)
+	}
+
+	body, err = c.DoRequest(http.MethodDelete, "/v1/files/file-abc1", nil)
+	if err != nil {
+		t.Fatalf("DELETE: %v", err)
+	}
+	if !strings.Contains(string(body), `"deleted":true`) {
+		t.Fatalf("DELETE reply = %s, want deleted", body)
+	}
+}
+
+func TestDryRunTransport_Streams(t *testing.T) {
+	c := NewClientWithConfig(ClientConfig{APIKey: "test", APIURL: "http://127.0.0.1:1/v1", DryRun: true})
+
+	resp, err := c.CreateResponseStream(context.Background(), CreateResponseRequest{Model: "gpt-4o", Input: "hi"})
+	if err != nil {
+		t.Fatalf("response stream: %v", err)
+	}
+	if resp.ID == "" || resp.Status != "completed" {
+		t.Errorf("response = %+v, want a synthetic id and completed", resp)
+	}
+
+	var chunks int
+	err = c.DoStreamRequestContext(context.Background(), http.MethodPost, "chat/completions", map[string]interface{}{"model": "gpt-4o", "stream": true}, func(data []byte) error {
+		chunks++
+		if !strings.Contains(string(data), `"finish_reason":"stop"`) {
+			t.Errorf("chunk = %s, want a finish reason", data)
+		}
+		return nil
+	})
+	if err != nil || chunks != 1 {
+		t.Fatalf("chat stream: %d chunks, err %v; want one chunk", chunks, err)
+	}
+}
+
+func TestDryRunTransport_RejectsInvalidJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "http://example.invalid/v1/embeddings", strings.NewReader(`{"model":`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := (&DryRunTransport{}).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", resp.StatusCode)
+	}
+}
+
+func TestDefaultHeaders(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","data":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewClientWithConfig(ClientConfig{
+		APIKey: "sk-test",
+		APIURL: server.URL + "/v1",
+		DefaultHeaders: map[string]string{
+			"OpenAI-Project": "proj_123",
+			"Authorization":  "Bearer gateway",
+		},
+	})
+
+	if _, err := c.DoRequest(http.MethodGet, "/v1/models", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if v := got.Get("OpenAI-Project"); v != "proj_123" {
+		t.Errorf("OpenAI-Project = %q, want proj_123", v)
+	}
+	if v := got.Get("Authorization"); v != "Bearer sk-test" {
+		t.Errorf("Authorization = %q, want the API key", v)
+	}
+}
+
+func TestDefaultHeadersTransport_OnlyAPIHost(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	apiHost := strings.TrimPrefix(server.URL, "http://")
+	httpClient := &http.Client{Transport: &DefaultHeadersTransport{
+		Base:    http.DefaultTransport,
+		Host:    apiHost,
+		Headers: map[string]string{"Helicone-Auth": "Bearer hk-123"},
+	}}
+
+	resp, err := httpClient.Get(server.URL + "/v1/files")
+	if err != nil {
+		t.Fatalf("GET API host: %v", err)
+	}
+	resp.Body.Close()
+	if v := got.Get("Helicone-Auth"); v != "Bearer hk-123" {
+		t.Errorf("API host: Helicone-Auth = %q, want it set", v)
+	}
+
+	// The same server reached under another host name stands in for a
+	// third-party download URL.
+	other := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	resp, err = httpClient.Get(other + "/image.png")
+	if err != nil {
+		t.Fatalf("GET other host: %v", err)
+	}
+	resp.Body.Close()
+	if v := got.Get("Helicone-Auth"); v != "" {
+		t.Errorf("other host: Helicone-Auth = %q, want it omitted", v)
+	}
+}
+
+func TestDefaultHeaders_PerClient(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	withHeaders := NewClientWithConfig(ClientConfig{APIURL: server.URL + "/v1", DefaultHeaders: map[string]string{"Helicone-Auth": "Bearer hk-123"}})
+	without := NewClientWithConfig(ClientConfig{APIURL: server.URL + "/v1"})
+
+	for _, tc := range []struct {
+		name string
+		c    *OpenAIClient
+		want string
+	}{
+		{"configured client", withHeaders, "Bearer hk-123"},
+		{"other client", without, ""},
+	} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/files", nil)
+		resp, err := tc.c.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		resp.Body.Close()
+		if v := got.Get("Helicone-Auth"); v != tc.want {
+			t.Errorf("%s: Helicone-Auth = %q, want %q", tc.name, v, tc.want)
+		}
+	}
+}
+
+func TestDo_ProjectHeader(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-proj-main", ProjectID: "proj_123", APIURL: server.URL + "/v1"})
+
+	for _, tc := range []struct {
+		key, project, want string
+	}{
+		{"sk-proj-main", "", "proj_123"},
+		{"sk-proj-other", "", "proj_123"},
+		{"sk-proj-main", "proj_456", "proj_456"},
+		{"sk-admin-key", "", ""},
+	} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/files", nil)
+		req.Header.Set("Authorization", "Bearer "+tc.key)
+		if tc.project != "" {
+			req.Header.Set("OpenAI-Project", tc.project)
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if v := got.Get("OpenAI-Project"); v != tc.want {
+			t.Errorf("key %s, request project %q: OpenAI-Project = %q, want %q", tc.key, tc.project, v, tc.want)
+		}
+	}
+}
+
+func TestKeyScope(t *testing.T) {
+	cases := map[string]string{
+		"sk-admin-abc":   KeyScopeAdmin,
+		"sk-proj-abc":    KeyScopeProject,
+		"sk-svcacct-abc": KeyScopeProject,
+		"sk-abc":         "",
+		"test-key":       "",
+	}
+	for key, want := range cases {
+		if got := KeyScope(key); got != want {
+			t.Errorf("KeyScope(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestRateLimits_RejectProjectKeyBeforeRequest(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"object":"list","data":[],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("sk-proj-abc", "", server.URL+"/v1")
+	_, err := c.ListAllRateLimits("proj_1")
+
+	var scopeErr *KeyScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Fatalf("err = %v, want a *KeyScopeError", err)
+	}
+	if scopeErr.Required != KeyScopeAdmin || scopeErr.Actual != KeyScopeProject {
+		t.Errorf("scope error = %+v, want admin required and project supplied", scopeErr)
+	}
+	if calls != 0 {
+		t.Errorf("server received %d requests, want none", calls)
+	}
+
+	if err := c.DeleteRateLimit("proj_1", "gpt-4o"); !errors.As(err, &scopeErr) {
+		t.Errorf("DeleteRateLimit err = %v, want a *KeyScopeError", err)
+	}
+}
+
+func TestProjectHeader(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("OpenAI-Project"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"vs_1","object":"list","data":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", ProjectID: "proj_123", APIURL: server.URL + "/v1"})
+
+	if _, err := c.DoRequest(http.MethodGet, "/v1/models", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	req, err := c.newRequest(http.MethodGet, "v1/vector_stores/vs_1", nil)
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	if v := req.Header.Get("OpenAI-Project"); v != "proj_123" {
+		t.Errorf("newRequest OpenAI-Project = %q, want proj_123", v)
+	}
+	if len(got) != 1 || got[0] != "proj_123" {
+		t.Errorf("DoRequest OpenAI-Project = %v, want proj_123", got)
+	}
+
+	unscoped := NewClient("sk-test", "", server.URL+"/v1")
+	req, _ = unscoped.newRequest(http.MethodGet, "v1/models", nil)
+	if _, ok := req.Header["Openai-Project"]; ok {
+		t.Error("OpenAI-Project must be omitted when no project is configured")
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","data":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+	if !strings.HasPrefix(c.UserAgent, "terraform-provider-openai/") || !strings.Contains(c.UserAgent, "(go/go") {
+		t.Fatalf("UserAgent = %q", c.UserAgent)
+	}
+
+	if _, err := c.DoRequest(http.MethodGet, "/v1/models", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if _, err := c.doRequest(http.MethodGet, server.URL+"/v1/organization/users", nil); err != nil {
+		t.Fatalf("doRequest: %v", err)
+	}
+	req, err := c.newRequest(http.MethodGet, "v1/models", nil)
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	got = append(got, req.Header.Get("User-Agent"))
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/v1/organization/invites", nil)
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+
+	for i, ua := range got {
+		if ua != c.UserAgent {
+			t.Errorf("request %d User-Agent = %q, want %q", i, ua, c.UserAgent)
+		}
+	}
+	if len(got) != 4 {
+		t.Errorf("saw %d requests, want 4", len(got))
+	}
+
+	gateway := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1", DefaultHeaders: map[string]string{"User-Agent": "my-gateway"}})
+	req, _ = gateway.newRequest(http.MethodGet, "v1/models", nil)
+	if ua := req.Header.Get("User-Agent"); ua != "my-gateway" {
+		t.Errorf("default_headers User-Agent = %q, want it to override the provider's", ua)
+	}
+}
+
+func TestNewTLSConfig_TrustsCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","data":[]}`))
+	}))
+	defer server.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(bundle, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	untrusted := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+	if _, err := untrusted.DoRequest(http.MethodGet, "/v1/models", nil); err == nil {
+		t.Fatal("expected a certificate error without the CA bundle")
+	}
+
+	tlsConfig, err := NewTLSConfig(bundle, false)
+	if err != nil {
+		t.Fatalf("NewTLSConfig: %v", err)
+	}
+	trusted := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1", TLSConfig: tlsConfig})
+	if _, err := trusted.DoRequest(http.MethodGet, "/v1/models", nil); err != nil {
+		t.Fatalf("DoRequest with CA bundle: %v", err)
+	}
+
+	if cfg, err := NewTLSConfig("", false); cfg != nil || err != nil {
+		t.Errorf("NewTLSConfig with no options = %v, %v; want nil, nil", cfg, err)
+	}
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	os.WriteFile(empty, []byte("not a certificate"), 0o600)
+	if _, err := NewTLSConfig(empty, false); err == nil {
+		t.Error("expected an error for a bundle without certificates")
+	}
+}
+
+func TestJoinURL(t *testing.T) {
+	cases := []struct {
+		name string
+		base string
+		path string
+		want string
+	}{
+		{"rate limits", "https://api.openai.com/v1", "organization/projects/proj_1/rate_limits", "https://api.openai.com/v1/organization/projects/proj_1/rate_limits"},
+		{"rate limit with query", "https://api.openai.com/v1", "organization/projects/proj_1/rate_limits?limit=100&after=rl_1", "https://api.openai.com/v1/organization/projects/proj_1/rate_limits?limit=100&after=rl_1"},
+		{"organization without v1 base", "https://api.openai.com", "organization/users", "https://api.openai.com/v1/organization/users"},
+		{"organization with trailing slash", "https://api.openai.com/v1/", "organization/invites/inv_1", "https://api.openai.com/v1/organization/invites/inv_1"},
+		{"vector store files", "https://api.openai.com/v1", "vector_stores/vs_1/files?limit=100", "https://api.openai.com/v1/vector_stores/vs_1/files?limit=100"},
+		{"leading slash", "https://api.openai.com/v1", "/vector_stores/vs_1", "https://api.openai.com/v1/vector_stores/vs_1"},
+		{"legacy v1 prefix", "https://api.openai.com/v1", "/v1/organization/projects", "https://api.openai.com/v1/organization/projects"},
+		{"legacy v1 prefix without slash", "https://api.openai.com", "v1/vector_stores", "https://api.openai.com/v1/vector_stores"},
+		{"path starting with v1 word", "https://api.openai.com/v1", "v1beta", "https://api.openai.com/v1/v1beta"},
+		{"gateway prefix", "https://gateway.example.com/openai/v1", "organization/projects", "https://gateway.example.com/openai/v1/organization/projects"},
+		{"gateway prefix without v1", "https://gateway.example.com/openai/", "responses", "https://gateway.example.com/openai/v1/responses"},
+		{"full url", "https://api.openai.com/v1", "https://files.example.com/v1/files/file_1/content", "https://files.example.com/v1/files/file_1/content"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := joinURL(tc.base, tc.path)
+			if err != nil {
+				t.Fatalf("joinURL(%q, %q): %v", tc.base, tc.path, err)
+			}
+			if got != tc.want {
+				t.Errorf("joinURL(%q, %q) = %q, want %q", tc.base, tc.path, got, tc.want)
+			}
+		})
+	}
+
+	if _, err := joinURL("https://api.openai.com/v1", "%zz"); err == nil {
+		t.Error("joinURL accepted an unparseable path")
+	}
+}
+
+func TestBackoffDuration_HonoursRetryAfter(t *testing.T) {
+	// Retry-After header values are honoured exactly (no jitter applied).
+	if got := backoffDuration(0, "5"); got.Seconds() != 5 {
+		t.Errorf("retry-after=5 → %v, want 5s", got)
+	}
+	if got := backoffDuration(0, "120"); got.Seconds() != 60 {
+		t.Errorf("retry-after=120 should cap at 60s, got %v", got)
+	}
+	// Fallback path uses jittered exponential backoff: actual sleep is in
+	// [base/2, base] for base values 1, 2, 4, 8, 16, 30s.
+	for _, tc := range []struct {
+		attempt int
+		base    time.Duration
+	}{
+		{0, 1 * time.Second},
+		{2, 4 * time.Second},
+		{5, 30 * time.Second},
+	} {
+		got := backoffDuration(tc.attempt, "")
+		if got < tc.base/2 || got > tc.base {
+			t.Errorf("attempt %d → %v, want in [%v, %v]", tc.attempt, got, tc.base/2, tc.base)
+		}
+	}
+	got := backoffDuration(0, "not-a-number")
+	if got < 500*time.Millisecond || got > 1*time.Second {
+		t.Errorf("invalid retry-after should fall back to attempt 0 jitter range [500ms, 1s], got %v", got)
+	}
+}
+
+func TestRateLimitResetDelay(t *testing.T) {
+	h := http.Header{}
+	if _, ok := rateLimitResetDelay(h); ok {
+		t.Error("no reset headers should report ok=false")
+	}
+
+	h.Set("x-ratelimit-reset-requests", "20ms")
+	h.Set("x-ratelimit-reset-tokens", "1.5s")
+	if got, ok := rateLimitResetDelay(h); !ok || got != 1500*time.Millisecond {
+		t.Errorf("got %v, %v; want the longer reset 1.5s", got, ok)
+	}
+
+	h.Set("x-ratelimit-reset-tokens", "6m0s")
+	if got, _ := rateLimitResetDelay(h); got != 60*time.Second {
+		t.Errorf("6m0s should cap at 60s, got %v", got)
+	}
+
+	h.Set("x-ratelimit-reset-tokens", "soon")
+	if got, ok := rateLimitResetDelay(h); !ok || got != 20*time.Millisecond {
+		t.Errorf("unparseable header should be ignored; got %v, %v", got, ok)
+	}
+}
+
+func TestDoRequest_WaitsForRateLimitReset(t *testing.T) {
+	var calls []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, time.Now())
+		if len(calls) == 1 {
+			w.Header().Set("x-ratelimit-reset-requests", "50ms")
+			w.Header().Set("x-ratelimit-reset-tokens", "150ms")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"chatcmpl-1"}`))
+	}))
+	defer server.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+	body, err := c.DoRequest(http.MethodPost, "chat/completions", map[string]interface{}{"model": "gpt-4o"})
+	if err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if !strings.Contains(string(body), "chatcmpl-1") {
+		t.Errorf("body = %s, want the retried response", body)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 calls, got %d", len(calls))
+	}
+	if gap := calls[1].Sub(calls[0]); gap < 150*time.Millisecond || gap >= 500*time.Millisecond {
+		t.Errorf("retried after %v, want the 150ms reset (and less than the 500ms backoff floor)", gap)
+	}
+}
+
+func TestDoRequest_LogsRateLimitHeadersBeforeRetry(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("x-ratelimit-remaining-requests", "0")
+			w.Header().Set("x-ratelimit-reset-requests", "10ms")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("x-ratelimit-remaining-requests", "59")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+	if _, err := c.DoRequestContext(ctx, http.MethodGet, "models", nil); err != nil {
+		t.Fatalf("DoRequestContext: %v", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("decoding logs: %v", err)
+	}
+	var retry, final map[string]interface{}
+	for _, e := range entries {
+		switch e["@level"] {
+		case "warn":
+			retry = e
+		case "trace":
+			if _, ok := e["x-ratelimit-remaining-requests"]; ok {
+				final = e
+			}
+		}
+	}
+	if retry == nil || retry["x-ratelimit-remaining-requests"] != "0" || retry["x-ratelimit-reset-requests"] != "10ms" || retry["status"] != float64(429) {
+		t.Errorf("retry log = %v, want the 429's rate limit headers", retry)
+	}
+	if final == nil || final["x-ratelimit-remaining-requests"] != "59" {
+		t.Errorf("trace log = %v, want the final response's rate limit headers", final)
+	}
+}
+
+func TestDoRequest_RetryRespectsCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-reset-requests", "30s")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+	start := time.Now()
+	if _, err := c.DoRequestContext(ctx, http.MethodGet, "models", nil); err == nil {
+		t.Fatal("expected an error once the context is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("cancellation took %v; the reset wait should be interruptible", elapsed)
+	}
+}
+
+func TestDoRequest_GivesUpAfterMaxAttempts(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		http.Error(w, `{"error":{"message":"slow down"}}`, http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+	_, err := c.DoRequest(http.MethodGet, "models", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err = %v, want the final 429 as an APIError", err)
+	}
+	if calls != RetryMaxAttempts {
+		t.Errorf("expected exactly %d calls, got %d", RetryMaxAttempts, calls)
+	}
+}
+
+func TestDoRequest_RetriesServerErrorsOnlyForIdempotentMethods(t *testing.T) {
+	tests := map[string]struct {
+		method     string
+		status     int
+		retryAfter string
+		wantCalls  int
+	}{
+		"GET 502 is retried":        {method: http.MethodGet, status: http.StatusBadGateway, wantCalls: 2},
+		"POST 502 is sent once":     {method: http.MethodPost, status: http.StatusBadGateway, wantCalls: 1},
+		"POST 504 is sent once":     {method: http.MethodPost, status: http.StatusGatewayTimeout, wantCalls: 1},
+		"POST 503 is sent once":     {method: http.MethodPost, status: http.StatusServiceUnavailable, wantCalls: 1},
+		"POST 503 with Retry-After": {method: http.MethodPost, status: http.StatusServiceUnavailable, retryAfter: "0", wantCalls: 2},
+		"POST 429 is retried":       {method: http.MethodPost, status: http.StatusTooManyRequests, retryAfter: "0", wantCalls: 2},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls > 1 {
+					_, _ = w.Write([]byte(`{"id":"x"}`))
+					return
+				}
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				http.Error(w, `{"error":{"message":"upstream"}}`, tt.status)
+			}))
+			defer server.Close()
+
+			c := NewClientWithConfig(ClientConfig{APIKey: "sk-test", APIURL: server.URL + "/v1"})
+			_, _ = c.DoRequest(tt.method, "invites", map[string]string{"email": "a@example.com"})
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// dryRunSeq numbers the IDs DryRunTransport makes up, so objects created in
+// one run get distinct IDs.
+var dryRunSeq atomic.Int64
+
+// DryRunTransport answers every request locally instead of sending it, for
+// exercising plan and apply without network access or API spend.
+//
+// It logs each request, rejects JSON bodies that do not parse (so request
+// building is still checked), and replies 200 with a synthetic object: a POST
+// echoes its JSON body, and every reply carries an id, created timestamps and
+// a terminal status so callers that poll for completion return immediately.
+// A GET on a collection returns an empty list. Streaming requests, which send
+// "Accept: text/event-stream" or "stream": true, are answered with the
+// server-sent events the endpoint would stream instead (see dryRunStream).
+type DryRunTransport struct{}
+
+func (t *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("dry_run: reading request body: %w", err)
+		}
+	}
+
+	log.Printf("[INFO] dry_run: %s %s %s", req.Method, req.URL.Path, dryRunLogBody(req, body))
+
+	if isJSONContentType(req.Header.Get("Content-Type")) && len(bytes.TrimSpace(body)) > 0 && !json.Valid(body) {
+		return dryRunResponse(req, http.StatusBadRequest, map[string]interface{}{
+			"error": map[string]interface{}{
+				"message": "dry_run: request body is not valid JSON",
+				"type":    "invalid_request_error",
+			},
+		}), nil
+	}
+
+	obj := dryRunObject(req, body)
+	if req.Header.Get("Accept") == "text/event-stream" || obj["stream"] == true {
+		return dryRunStream(req, obj), nil
+	}
+	return dryRunResponse(req, http.StatusOK, obj), nil
+}
+
+// dryRunObject builds the synthetic reply for req.
+func dryRunObject(req *http.Request, body []byte) map[string]interface{} {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	last := segments[len(segments)-1]
+	id := ""
+	if looksLikeID(last) {
+		id = last
+	}
+
+	if req.Method == http.MethodGet && id == "" {
+		return map[string]interface{}{"object": "list", "data": []interface{}{}, "has_more": false}
+	}
+
+	obj := map[string]interface{}{}
+	if req.Method == http.MethodPost && isJSONContentType(req.Header.Get("Content-Type")) {
+		_ = json.Unmarshal(body, &obj) // non-object bodies are not echoed
+	}
+
+	if id == "" {
+		id = fmt.Sprintf("dryrun_%d", dryRunSeq.Add(1))
+	}
+	status := "completed"
+	if strings.Contains(req.URL.Path, "fine_tuning") {
+		status = "succeeded"
+	}
+	now := time.Now().Unix()
+	obj["id"] = id
+	obj["created"] = now
+	obj["created_at"] = now
+	obj["status"] = status
+	if req.Method == http.MethodDelete {
+		obj["deleted"] = true
+	}
+	return obj
+}
+
+// dryRunStream answers a streaming request with obj as server-sent events:
+// the Responses API gets response.created and response.completed events,
+// chat completions a single chunk with a finish reason, and any other
+// endpoint obj itself. The stream ends with the [DONE] sentinel.
+func dryRunStream(req *http.Request, obj map[string]interface{}) *http.Response {
+	var events []interface{}
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/responses"):
+		events = []interface{}{
+			map[string]interface{}{"type": "response.created", "response": obj},
+			map[string]interface{}{"type": "response.completed", "response": obj},
+		}
+	case strings.HasSuffix(req.URL.Path, "/chat/completions"):
+		events = []interface{}{map[string]interface{}{
+			"id":      obj["id"],
+			"object":  "chat.completion.chunk",
+			"created": obj["created"],
+			"model":   obj["model"],
+			"choices": []interface{}{map[string]interface{}{
+				"index":         0,
+				"delta":         map[string]interface{}{"role": "assistant", "content": ""},
+				"finish_reason": "stop",
+			}},
+		}}
+	default:
+		events = []interface{}{obj}
+	}
+
+	var payload bytes.Buffer
+	for _, event := range events {
+		data, _ := json.Marshal(event)
+		fmt.Fprintf(&payload, "data: %s\n\n", data)
+	}
+	payload.WriteString("data: [DONE]\n\n")
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body:          io.NopCloser(&payload),
+		ContentLength: int64(payload.Len()),
+		Request:       req,
+	}
+}
+
+// looksLikeID reports whether a path segment is an object ID rather than a
+// collection name. IDs contain a digit; the only short segment with one is
+// the "v1" version prefix.
+func looksLikeID(segment string) bool {
+	return len(segment) > 3 && strings.ContainsAny(segment, "0123456789")
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+// dryRunLogBody returns the body as logged: JSON verbatim, anything else
+// (file uploads) by size only.
+func dryRunLogBody(req *http.Request, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if isJSONContentType(req.Header.Get("Content-Type")) {
+		return string(body)
+	}
+	return fmt.Sprintf("(%d bytes of %s)", len(body), req.Header.Get("Content-Type"))
+}
+
+func dryRunResponse(req *http.Request, status int, v interface{}) *http.Response {
+	payload, _ := json.Marshal(v)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(payload)),
+		ContentLength: int64(len(payload)),
+		Request:       req,
+	}
+}
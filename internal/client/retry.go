@@ -0,0 +1,245 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryMaxAttempts is the maximum number of attempts (including the first)
+// the client makes for a request that keeps failing with a retryable status.
+const RetryMaxAttempts = 6
+
+// retryStatusCodes are HTTP status codes that may trigger a retry with
+// exponential backoff (rate limiting and transient server errors); see
+// isRetryableStatus for which methods retry which codes.
+var retryStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:     true, // 429
+	http.StatusInternalServerError: true, // 500
+	http.StatusBadGateway:          true, // 502
+	http.StatusServiceUnavailable:  true, // 503
+	http.StatusGatewayTimeout:      true, // 504
+}
+
+// send performs req with HTTPClient, retrying on 429 (rate limiting) and
+// transient 5xx responses. Every request the client makes goes through it.
+//
+// Honours the `Retry-After` header when present (seconds). A 429 without it
+// but with `x-ratelimit-reset-requests`/`-tokens` waits until the limit
+// resets (see rateLimitResetDelay). Otherwise falls back to a capped
+// exponential schedule with full jitter (see backoffDuration). Waits are
+// cut short when the request's context is cancelled. The response's rate
+// limit headers are logged at WARN before each retry and at TRACE on the
+// response that is returned.
+//
+// Transport errors and 5xx responses are retried only for idempotent
+// methods, since a POST that timed out or got a 502/504 from a gateway may
+// still have been carried out upstream (an invite sent, a job created or a
+// generation billed). A POST is retried only on 429, and on a 503 that
+// carries Retry-After, both of which mean it was turned away unprocessed.
+// A rejected server certificate is never retried, and a request whose body
+// cannot be replayed (no GetBody) is sent once. After the last attempt the
+// retryable response is returned untouched so its body can be reported.
+func (c *OpenAIClient) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("error replaying request body: %w", err)
+			}
+			req.Body = body
+		}
+		last := attempt == RetryMaxAttempts-1 || (req.Body != nil && req.GetBody == nil)
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			if last || !isRetryableError(req, err) || ctx.Err() != nil {
+				return nil, err
+			}
+			if !sleepContext(ctx, backoffDuration(attempt, "")) {
+				return nil, err
+			}
+			continue
+		}
+
+		if last || !isRetryableStatus(req, resp) {
+			if fields := rateLimitHeaderFields(resp.Header); len(fields) > 0 {
+				tflog.Trace(ctx, "OpenAI rate limit headers", fields)
+			}
+			return resp, nil
+		}
+
+		wait := backoffDuration(attempt, resp.Header.Get("Retry-After"))
+		if resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get("Retry-After") == "" {
+			if d, ok := rateLimitResetDelay(resp.Header); ok {
+				wait = d
+			}
+		}
+		fields := rateLimitHeaderFields(resp.Header)
+		fields["status"] = resp.StatusCode
+		fields["attempt"] = attempt + 1
+		fields["wait"] = wait.String()
+		tflog.Warn(ctx, fmt.Sprintf("Retrying %s %s after HTTP %d", req.Method, req.URL.Path, resp.StatusCode), fields)
+		// Drain and close so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if !sleepContext(ctx, wait) {
+			return nil, fmt.Errorf("retry aborted: %w", ctx.Err())
+		}
+	}
+}
+
+// isRetryableError reports whether the transport error err from sending req
+// may go away on a second attempt.
+func isRetryableError(req *http.Request, err error) bool {
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &certErr) {
+		return false
+	}
+	return isIdempotent(req.Method)
+}
+
+// isRetryableStatus reports whether resp, received for req, should be
+// retried. Rate limiting (429, or a 503 with Retry-After) means the request
+// was not processed, so any method may retry it; other 5xx responses are
+// retried only for idempotent methods.
+func isRetryableStatus(req *http.Request, resp *http.Response) bool {
+	if !retryStatusCodes[resp.StatusCode] {
+		return false
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != "":
+		return true
+	}
+	return isIdempotent(req.Method)
+}
+
+// isIdempotent reports whether sending a request with method twice has the
+// same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// sleepContext waits for d. Returns false if the context was cancelled while
+// waiting.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// rateLimitResetHeaders carry how long until the exhausted request or token
+// budget refills, as Go-style durations such as "1s", "6m0s" or "250ms".
+var rateLimitResetHeaders = []string{
+	"x-ratelimit-reset-requests",
+	"x-ratelimit-reset-tokens",
+}
+
+// rateLimitHeaders are the rate limit headers OpenAI returns on most
+// responses, logged so throttling can be diagnosed from TF_LOG output.
+var rateLimitHeaders = []string{
+	"x-ratelimit-limit-requests",
+	"x-ratelimit-limit-tokens",
+	"x-ratelimit-remaining-requests",
+	"x-ratelimit-remaining-tokens",
+	"x-ratelimit-reset-requests",
+	"x-ratelimit-reset-tokens",
+	"Retry-After",
+}
+
+// rateLimitHeaderFields returns the rate limit headers present in h as log
+// fields keyed by lowercased header name.
+func rateLimitHeaderFields(h http.Header) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for _, name := range rateLimitHeaders {
+		if v := h.Get(name); v != "" {
+			fields[strings.ToLower(name)] = v
+		}
+	}
+	return fields
+}
+
+// rateLimitResetDelay returns how long to wait before retrying a 429 that
+// carries `x-ratelimit-reset-*` headers: the longest of the reset times, since
+// retrying before both budgets refill would just 429 again. Capped at 60s like
+// Retry-After. ok is false when neither header is present and parseable, and
+// the caller falls back to exponential backoff.
+func rateLimitResetDelay(h http.Header) (d time.Duration, ok bool) {
+	for _, name := range rateLimitResetHeaders {
+		v := strings.TrimSpace(h.Get(name))
+		if v == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed < 0 {
+			continue
+		}
+		ok = true
+		if parsed > d {
+			d = parsed
+		}
+	}
+	if d > 60*time.Second {
+		d = 60 * time.Second
+	}
+	return d, ok
+}
+
+// backoffDuration returns the wait time for a given attempt index. If
+// retryAfter (the value of the `Retry-After` header) is a non-negative integer
+// number of seconds, that value wins (capped at 60s; 0 means "retry now").
+// Otherwise we use a capped exponential schedule with "decorrelated" jitter:
+// the actual sleep is uniformly random in [base/2, base] for base values 1,
+// 2, 4, 8, 16, 30s. The jitter avoids thundering-herd when N concurrent
+// requests all 429 at once and would otherwise retry on the same schedule.
+func backoffDuration(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(strings.TrimSpace(retryAfter)); err == nil && secs >= 0 {
+			capped := secs
+			if capped > 60 {
+				capped = 60
+			}
+			return time.Duration(capped) * time.Second
+		}
+	}
+	var base time.Duration
+	switch attempt {
+	case 0:
+		base = 1 * time.Second
+	case 1:
+		base = 2 * time.Second
+	case 2:
+		base = 4 * time.Second
+	case 3:
+		base = 8 * time.Second
+	case 4:
+		base = 16 * time.Second
+	default:
+		base = 30 * time.Second
+	}
+	half := base / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
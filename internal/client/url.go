@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// joinURL resolves an API path against base, the configured API URL.
+//
+// Paths are given relative to the API version, e.g. "organization/projects"
+// or "files?purpose=batch"; a leading "/" or "/v1/" is tolerated and
+// dropped. base may or may not end in /v1, and may carry a gateway prefix
+// such as https://gateway.example.com/openai/v1: the result is always base
+// without a trailing /v1, then /v1, then the path, with the path's query
+// string preserved. A path that is already an absolute URL is returned
+// unchanged.
+func joinURL(base, path string) (string, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid request path %q: %w", path, err)
+	}
+	if ref.IsAbs() {
+		return path, nil
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid API URL %q: %w", base, err)
+	}
+
+	clean := strings.TrimPrefix(ref.Path, "/")
+	if clean == "v1" || strings.HasPrefix(clean, "v1/") {
+		clean = strings.TrimPrefix(strings.TrimPrefix(clean, "v1"), "/")
+	}
+
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/v1")
+	u.RawPath = ""
+	u = u.JoinPath("v1", clean)
+	u.RawQuery = ref.RawQuery
+	return u.String(), nil
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ datasource.DataSource = &AccountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+type AccountDataSource struct {
+	client *OpenAIClient
+}
+
+type AccountDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	FailOnInvalid  types.Bool   `tfsdk:"fail_on_invalid"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	KeyScope       types.String `tfsdk:"key_scope"`
+	Valid          types.Bool   `tfsdk:"valid"`
+	Error          types.String `tfsdk:"error"`
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source as a preflight check of the provider's credentials. It makes one lightweight authenticated request and fails with a clear error if the key is rejected, so resources that `depends_on` it do not fail later with less obvious errors. Uses the API key, or the admin key when no API key is configured.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"fail_on_invalid": schema.BoolAttribute{
+				Description: "Whether a rejected key fails the read. Set to `false` to report it through `valid` and `error` instead. Defaults to `true`. Network errors always fail.",
+				Optional:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization the API reports for the key, or the configured organization if the API does not report one.",
+				Computed:    true,
+			},
+			"key_scope": schema.StringAttribute{
+				Description: "The kind of key, from its prefix: `admin`, `project`, or null for keys whose scope cannot be told from the prefix.",
+				Computed:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the API accepted the key.",
+				Computed:    true,
+			},
+			"error": schema.StringAttribute{
+				Description: "The API's error message when the key was rejected.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = providerClient
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl := d.client.OpenAIClient
+	if cl.APIKey == "" && d.client.AdminAPIKey != "" {
+		adminClient, err := GetOpenAIClientWithAdminKey(d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+			return
+		}
+		cl = adminClient
+	}
+	if cl.APIKey == "" {
+		resp.Diagnostics.AddError("No OpenAI API Key Configured", "Set api_key or admin_key in the provider block, or OPENAI_API_KEY or OPENAI_ADMIN_KEY in the environment.")
+		return
+	}
+
+	data.KeyScope = types.StringNull()
+	if scope := client.KeyScope(cl.APIKey); scope != "" {
+		data.KeyScope = types.StringValue(scope)
+	}
+	orgID := cl.OrganizationID
+	data.Valid = types.BoolValue(true)
+	data.Error = types.StringNull()
+
+	info, err := cl.CheckAuth(ctx)
+	var apiErr *client.APIError
+	switch {
+	case err == nil:
+		orgID = info.OrganizationID
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden):
+		if data.FailOnInvalid.IsNull() || data.FailOnInvalid.ValueBool() {
+			resp.Diagnostics.AddError(
+				"OpenAI API Key Rejected",
+				fmt.Sprintf("The API rejected the configured key with status %d: %s. Check that the key is current, belongs to the configured organization and project, and has the scopes this configuration needs.",
+					apiErr.StatusCode, apiErrorMessage(apiErr)),
+			)
+			return
+		}
+		data.Valid = types.BoolValue(false)
+		data.Error = types.StringValue(apiErrorMessage(apiErr))
+	default:
+		resp.Diagnostics.AddError("Error checking OpenAI credentials", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("account")
+	data.OrganizationID = types.StringNull()
+	if orgID != "" {
+		data.ID = types.StringValue(orgID)
+		data.OrganizationID = types.StringValue(orgID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apiErrorMessage returns the API's error message, or the raw body when the
+// response carried no error object.
+func apiErrorMessage(err *client.APIError) string {
+	if err.Message != "" {
+		return err.Message
+	}
+	return err.Body
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+func TestAccountDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer sk-proj-good":
+			if r.URL.Path != "/v1/models" {
+				t.Errorf("project key checked against %s, want /v1/models", r.URL.Path)
+			}
+			w.Header().Set("OpenAI-Organization", "org-from-api")
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{}})
+		case "Bearer sk-admin-good":
+			if r.URL.Path != "/v1/organization/projects" {
+				t.Errorf("admin key checked against %s, want /v1/organization/projects", r.URL.Path)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{}})
+		default:
+			writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+				"error": map[string]interface{}{"message": "Incorrect API key provided", "type": "invalid_request_error", "code": "invalid_api_key"},
+			})
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	read := func(apiKey, adminKey string, failOnInvalid *bool) (*datasource.ReadResponse, AccountDataSourceModel) {
+		cl := client.NewClient(apiKey, "org-configured", server.URL+"/v1")
+		d := &AccountDataSource{client: &OpenAIClient{OpenAIClient: cl, AdminAPIKey: adminKey}}
+		schemaResp := &datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+		sch := schemaResp.Schema
+		objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		if failOnInvalid != nil {
+			vals["fail_on_invalid"] = tftypes.NewValue(tftypes.Bool, *failOnInvalid)
+		}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+		var data AccountDataSourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		return resp, data
+	}
+
+	resp, data := read("sk-proj-good", "", nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("valid key: %v", resp.Diagnostics)
+	}
+	if !data.Valid.ValueBool() || data.OrganizationID.ValueString() != "org-from-api" || data.KeyScope.ValueString() != client.KeyScopeProject {
+		t.Errorf("valid key: valid=%s organization_id=%s key_scope=%s, want true org-from-api project", data.Valid, data.OrganizationID, data.KeyScope)
+	}
+
+	resp, data = read("", "sk-admin-good", nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("admin key only: %v", resp.Diagnostics)
+	}
+	if !data.Valid.ValueBool() || data.OrganizationID.ValueString() != "org-configured" || data.KeyScope.ValueString() != client.KeyScopeAdmin {
+		t.Errorf("admin key only: valid=%s organization_id=%s key_scope=%s, want true org-configured admin", data.Valid, data.OrganizationID, data.KeyScope)
+	}
+
+	resp, _ = read("sk-proj-revoked", "", nil)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "Incorrect API key provided") {
+		t.Errorf("rejected key: diagnostics = %v, want an error quoting the API message", resp.Diagnostics)
+	}
+
+	report := false
+	resp, data = read("sk-proj-revoked", "", &report)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("rejected key with fail_on_invalid = false: %v", resp.Diagnostics)
+	}
+	if data.Valid.ValueBool() || data.Error.ValueString() != "Incorrect API key provided" {
+		t.Errorf("rejected key with fail_on_invalid = false: valid=%s error=%s, want false and the API message", data.Valid, data.Error)
+	}
+}
@@ -5,14 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ datasource.DataSource = &AdminAPIKeyDataSource{}
@@ -133,8 +132,7 @@ func (d *AdminAPIKeyDataSource) Read(ctx context.Context, req datasource.ReadReq
 	httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
+	httpResp, err := d.client.Do(httpRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error executing request", err.Error())
 		return
@@ -210,14 +208,14 @@ func (d *AdminAPIKeysDataSource) Metadata(ctx context.Context, req datasource.Me
 
 func (d *AdminAPIKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Use this data source to retrieve a list of all admin API keys.",
+		Description: "Use this data source to retrieve a list of all admin API keys, for example to find keys that have not been used recently. Key values are never returned.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of this resource.",
 				Computed:    true,
 			},
 			"limit": schema.Int64Attribute{
-				Description: "Maximum number of API keys to return. Default is 20.",
+				Description: "Maximum number of API keys to return in a single page. When neither `limit` nor `after` is set, every key is returned.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -247,11 +245,11 @@ func (d *AdminAPIKeysDataSource) Schema(ctx context.Context, req datasource.Sche
 							Computed:    true,
 						},
 						"expires_at": schema.Int64Attribute{
-							Description: "Timestamp when the admin API key expires (optional).",
+							Description: "The Unix timestamp when the admin API key expires, or null if it does not expire.",
 							Computed:    true,
 						},
 						"last_used_at": schema.StringAttribute{
-							Description: "Timestamp when the admin API key was last used.",
+							Description: "Timestamp when the admin API key was last used, or null if it has never been used.",
 							Computed:    true,
 						},
 						"scopes": schema.ListAttribute{
@@ -306,78 +304,39 @@ func (d *AdminAPIKeysDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	apiURL := d.client.OpenAIClient.APIURL
-	suffix := "/organization/admin_api_keys"
-
-	var reqURL string
-	if strings.Contains(apiURL, "/v1") {
-		reqURL = strings.TrimSuffix(apiURL, "/v1") + "/v1" + suffix
-	} else {
-		reqURL = strings.TrimSuffix(apiURL, "/") + "/v1" + suffix
-	}
-
-	parsedURL, _ := url.Parse(reqURL)
-	q := parsedURL.Query()
-	limit := int64(20)
-	if !data.Limit.IsNull() {
-		limit = data.Limit.ValueInt64()
-	}
-	q.Set("limit", strconv.FormatInt(limit, 10))
-	if !data.After.IsNull() {
-		q.Set("after", data.After.ValueString())
-	}
-	parsedURL.RawQuery = q.Encode()
-
-	httpRequest, err := http.NewRequest("GET", parsedURL.String(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
-	httpRequest.Header.Set("Content-Type", "application/json")
-
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
+	adminClient, err := GetOpenAIClientWithAdminKey(d.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error executing request", err.Error())
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
 		return
 	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s", httpResp.Status))
-		return
-	}
-
-	type AdminAPIKeyListResponse struct {
-		Data    []AdminAPIKeyResponseFramework `json:"data"` // Uses same struct but might miss LastUsedAt?
-		HasMore bool                           `json:"has_more"`
-		Object  string                         `json:"object"`
-	}
-	// Need a custom struct for LastUsedAt as it's not in AdminAPIKeyResponseFramework
-	type AdminAPIKeyWithLastUsed struct {
-		ID         string   `json:"id"`
-		Name       string   `json:"name"`
-		CreatedAt  int64    `json:"created_at"`
-		ExpiresAt  *int64   `json:"expires_at,omitempty"`
-		LastUsedAt *int64   `json:"last_used_at,omitempty"`
-		Object     string   `json:"object"`
-		Scopes     []string `json:"scopes,omitempty"`
-	}
-	type ListResp struct {
-		Data    []AdminAPIKeyWithLastUsed `json:"data"`
-		HasMore bool                      `json:"has_more"`
-		Object  string                    `json:"object"`
-	}
 
-	var listResp ListResp
-	if err := json.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
-		resp.Diagnostics.AddError("Error decoding response", err.Error())
-		return
+	// Without limit or after, follow the cursor and return every key;
+	// otherwise return the one page asked for.
+	var keys []client.AdminAPIKey
+	hasMore := false
+	if data.Limit.IsNull() && data.After.IsNull() {
+		keys, err = adminClient.ListAllAPIKeys()
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing admin API keys", err.Error())
+			return
+		}
+	} else {
+		limit := int64(20)
+		if !data.Limit.IsNull() {
+			limit = data.Limit.ValueInt64()
+		}
+		page, err := adminClient.ListAPIKeys(int(limit), data.After.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing admin API keys", err.Error())
+			return
+		}
+		keys = page.Data
+		hasMore = page.HasMore
+		data.Limit = types.Int64Value(limit)
 	}
 
 	var apiKeys []AdminAPIKeyResultModel
-	for _, k := range listResp.Data {
+	for _, k := range keys {
 		keyModel := AdminAPIKeyResultModel{
 			ID:        types.StringValue(k.ID),
 			Name:      types.StringValue(k.Name),
@@ -412,8 +371,7 @@ func (d *AdminAPIKeysDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	data.ID = types.StringValue(fmt.Sprintf("admin_api_keys_%d", time.Now().Unix()))
 	data.APIKeys = apiKeys
-	data.HasMore = types.BoolValue(listResp.HasMore)
-	data.Limit = types.Int64Value(limit)
+	data.HasMore = types.BoolValue(hasMore)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
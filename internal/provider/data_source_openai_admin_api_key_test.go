@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAdminAPIKeysDataSource_ReadPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/admin_api_keys" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-admin-key" {
+			t.Errorf("Authorization = %q, want the admin key", r.Header.Get("Authorization"))
+		}
+		if r.URL.Query().Get("after") == "" {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "list",
+				"data": []map[string]interface{}{{
+					"id": "key_a", "name": "ci", "created_at": 100, "last_used_at": 200,
+					"scopes": []string{"api.management.read"}, "value": "sk-admin-secret",
+				}},
+				"has_more": true,
+			})
+			return
+		}
+		if got := r.URL.Query().Get("after"); got != "key_a" {
+			t.Errorf("after = %q, want key_a", got)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object":   "list",
+			"data":     []map[string]interface{}{{"id": "key_b", "name": "stale", "created_at": 50, "expires_at": 900}},
+			"last_id":  "key_b",
+			"has_more": false,
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &AdminAPIKeysDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	var data AdminAPIKeysDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if len(data.APIKeys) != 2 {
+		t.Fatalf("got %d keys, want 2 across both pages", len(data.APIKeys))
+	}
+	a, b := data.APIKeys[0], data.APIKeys[1]
+	if a.ID.ValueString() != "key_a" || a.LastUsedAt.ValueString() != time.Unix(200, 0).Format(time.RFC3339) || !a.ExpiresAt.IsNull() || len(a.Scopes) != 1 {
+		t.Errorf("key_a = %+v, want last_used_at 200, null expires_at and one scope", a)
+	}
+	if b.ID.ValueString() != "key_b" || !b.LastUsedAt.IsNull() || b.ExpiresAt.ValueInt64() != 900 {
+		t.Errorf("key_b = %+v, want null last_used_at and expires_at 900", b)
+	}
+	if data.HasMore.ValueBool() || !data.Limit.IsNull() {
+		t.Errorf("has_more = %v, limit = %v, want false and null when every page is read", data.HasMore, data.Limit)
+	}
+}
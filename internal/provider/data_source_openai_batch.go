@@ -164,7 +164,7 @@ func (d *BatchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		httpReq.Header.Set("OpenAI-Project", data.ProjectID.ValueString())
 	}
 
-	httpResp, err := http.DefaultClient.Do(httpReq)
+	httpResp, err := d.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -357,7 +357,7 @@ func (d *BatchesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		httpReq.Header.Set("OpenAI-Project", projectID)
 	}
 
-	httpResp, err := http.DefaultClient.Do(httpReq)
+	httpResp, err := d.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -32,13 +36,44 @@ type ChatCompletionDataSource struct {
 }
 
 type ChatCompletionDataSourceModel struct {
-	CompletionID types.String `tfsdk:"completion_id"`
-	ID           types.String `tfsdk:"id"`
-	Created      types.Int64  `tfsdk:"created"`
-	Object       types.String `tfsdk:"object"`
-	Model        types.String `tfsdk:"model"`
-	Choices      types.List   `tfsdk:"choices"`
-	Usage        types.Map    `tfsdk:"usage"`
+	CompletionID      types.String                           `tfsdk:"completion_id"`
+	Messages          []ChatCompletionDataSourceMessageModel `tfsdk:"messages"`
+	Temperature       types.Float64                          `tfsdk:"temperature"`
+	TopP              types.Float64                          `tfsdk:"top_p"`
+	N                 types.Int64                            `tfsdk:"n"`
+	Stop              []types.String                         `tfsdk:"stop"`
+	MaxTokens         types.Int64                            `tfsdk:"max_tokens"`
+	PresencePenalty   types.Float64                          `tfsdk:"presence_penalty"`
+	FrequencyPenalty  types.Float64                          `tfsdk:"frequency_penalty"`
+	User              types.String                           `tfsdk:"user"`
+	SafetyIdentifier  types.String                           `tfsdk:"safety_identifier"`
+	PromptCacheKey    types.String                           `tfsdk:"prompt_cache_key"`
+	ID                types.String                           `tfsdk:"id"`
+	Created           types.Int64                            `tfsdk:"created"`
+	Object            types.String                           `tfsdk:"object"`
+	Model             types.String                           `tfsdk:"model"`
+	Choices           types.List                             `tfsdk:"choices"`
+	Content           types.String                           `tfsdk:"content"`
+	FinishReason      types.String                           `tfsdk:"finish_reason"`
+	Usage             types.Map                              `tfsdk:"usage"`
+	BestChoiceIndex   types.Int64                            `tfsdk:"best_choice_index"`
+	TruncateToContext types.Bool                             `tfsdk:"truncate_to_context"`
+	Functions         []FunctionModel                        `tfsdk:"functions"`     // Deprecated
+	FunctionCall      types.String                           `tfsdk:"function_call"` // Deprecated
+	Tools             []ToolModel                            `tfsdk:"tools"`
+	ToolChoice        types.String                           `tfsdk:"tool_choice"`
+	ResponseFormat    *ResponseFormatModel                   `tfsdk:"response_format"`
+	Seed              types.Int64                            `tfsdk:"seed"`
+	LogitBias         types.Map                              `tfsdk:"logit_bias"`
+	Logprobs          types.Bool                             `tfsdk:"logprobs"`
+}
+
+// ChatCompletionDataSourceMessageModel is an input message when the data
+// source generates a completion.
+type ChatCompletionDataSourceMessageModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+	Name    types.String `tfsdk:"name"`
 }
 
 func (d *ChatCompletionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,20 +82,133 @@ func (d *ChatCompletionDataSource) Metadata(ctx context.Context, req datasource.
 
 func (d *ChatCompletionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Use this data source to retrieve information about a specific chat completion by ID.",
+		Description: "Use this data source to retrieve a stored chat completion by ID, or to generate a new one from `model` and `messages` without managing a resource. " +
+			"A generated completion is not stored in state between runs: every plan and apply calls the model again, costs tokens and may return different content. " +
+			"To keep a result, copy it into a `terraform_data` resource with `ignore_changes`.",
 		Attributes: map[string]schema.Attribute{
 			"completion_id": schema.StringAttribute{
-				Description: "The ID of the chat completion to retrieve (format: chatcmpl-xxx)",
-				Required:    true,
+				Description: "The ID of the chat completion to retrieve (format: chatcmpl-xxx). Conflicts with `messages`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("messages")),
+				},
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "The conversation to generate a completion for. Requires `model`.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AlsoRequires(path.MatchRoot("model")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "The role of the message author. One of 'system', 'developer', 'user', 'assistant', 'tool', or 'function'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(chatMessageRoles...),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "The content of the message.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "An optional name for the participant.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"temperature": schema.Float64Attribute{
+				Description: "What sampling temperature to use, between 0 and 2. Only used when generating.",
+				Optional:    true,
+			},
+			"top_p": schema.Float64Attribute{
+				Description: "Nucleus sampling parameter. Only used when generating.",
+				Optional:    true,
+			},
+			"n": schema.Int64Attribute{
+				Description: "How many choices to generate. Only used when generating.",
+				Optional:    true,
+			},
+			"stop": schema.ListAttribute{
+				Description: "Up to 4 sequences where the API will stop generating further tokens. Only used when generating.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"max_tokens": schema.Int64Attribute{
+				Description: "The maximum number of tokens to generate. Only used when generating.",
+				Optional:    true,
+			},
+			"truncate_to_context": schema.BoolAttribute{
+				Description: "Drop the oldest non-system messages until the request fits the model's context window. Token counts are estimated (about four characters per token), and `max_tokens` (or 1024 if unset) is reserved for the completion. Only used when generating.",
+				Optional:    true,
+			},
+			// Tools and structured outputs mirror openai_chat_completion.
+			"functions":       dataSourceAttribute(chatFunctionsAttribute()),
+			"function_call":   dataSourceAttribute(chatFunctionCallAttribute()),
+			"tools":           dataSourceAttribute(chatToolsAttribute()),
+			"tool_choice":     dataSourceAttribute(chatToolChoiceAttribute()),
+			"response_format": dataSourceAttribute(responseFormatAttribute()),
+			"seed": schema.Int64Attribute{
+				Description: "If set, the model makes a best effort to sample deterministically. Only used when generating.",
+				Optional:    true,
+			},
+			"logit_bias": schema.MapAttribute{
+				Description: "Modify the likelihood of specified tokens appearing in the completion. Only used when generating.",
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"logprobs": schema.BoolAttribute{
+				Description: "Whether to return log probabilities of the output tokens. Required for `best_choice_index`. Only used when generating.",
+				Optional:    true,
+			},
+			"presence_penalty": schema.Float64Attribute{
+				Description: "Presence penalty parameter. Only used when generating.",
+				Optional:    true,
+			},
+			"frequency_penalty": schema.Float64Attribute{
+				Description: "Frequency penalty parameter. Only used when generating.",
+				Optional:    true,
+			},
+			"user": schema.StringAttribute{
+				Description:        "A unique identifier representing your end-user. Only used when generating. Deprecated: use `safety_identifier`.",
+				Optional:           true,
+				DeprecationMessage: "Use safety_identifier instead, and prompt_cache_key for prompt caching. user is still sent for now.",
+			},
+			"safety_identifier": schema.StringAttribute{
+				Description: "A stable identifier for the end-user, used by OpenAI to detect abuse. Replaces `user`. Only used when generating.",
+				Optional:    true,
+			},
+			"prompt_cache_key": schema.StringAttribute{
+				Description: "A key that groups requests sharing a long common prefix to improve prompt cache hit rates. Only used when generating.",
+				Optional:    true,
 			},
 			"id":      schema.StringAttribute{Computed: true},
 			"created": schema.Int64Attribute{Computed: true},
 			"object":  schema.StringAttribute{Computed: true},
-			"model":   schema.StringAttribute{Computed: true},
+			"model": schema.StringAttribute{
+				Description: "The model to generate with. Required with `messages`; when retrieving by `completion_id` it is the model that generated the completion.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the first choice's message.",
+				Computed:    true,
+			},
+			"finish_reason": schema.StringAttribute{
+				Description: "Why the model stopped generating the first choice, e.g. `stop` or `length`.",
+				Computed:    true,
+			},
 			"usage": schema.MapAttribute{
 				Computed:    true,
 				ElementType: types.Int64Type,
 			},
+			"best_choice_index": schema.Int64Attribute{
+				Description: "The index of the choice with the highest mean token log probability. Only set when the completion was created with logprobs enabled.",
+				Computed:    true,
+			},
 			"choices": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -131,19 +279,35 @@ func (d *ChatCompletionDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	completionID := data.CompletionID.ValueString()
-	url := fmt.Sprintf("/v1/chat/completions/%s", completionID)
-	respBody, err := d.client.DoRequest("GET", url, nil)
-	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			// Legacy behavior: warn and return ID
-			resp.Diagnostics.AddWarning("Chat completion not found", fmt.Sprintf("Chat completion with ID '%s' not found.", completionID))
-			data.ID = data.CompletionID
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	var respBody []byte
+	if data.CompletionID.IsNull() {
+		request := chatCompletionDataSourceRequest(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Parsed below exactly like a retrieved completion.
+		var err error
+		respBody, err = d.client.DoRequest(http.MethodPost, "chat/completions", request)
+		if err != nil {
+			resp.Diagnostics.AddError("Error generating chat completion", err.Error())
+			return
+		}
+	} else {
+		completionID := data.CompletionID.ValueString()
+		url := fmt.Sprintf("chat/completions/%s", completionID)
+		var err error
+		respBody, err = d.client.DoRequest("GET", url, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				// Legacy behavior: warn and return ID
+				resp.Diagnostics.AddWarning("Chat completion not found", fmt.Sprintf("Chat completion with ID '%s' not found.", completionID))
+				data.ID = data.CompletionID
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				return
+			}
+			resp.Diagnostics.AddError("Error retrieving chat completion", err.Error())
 			return
 		}
-		resp.Diagnostics.AddError("Error retrieving chat completion", err.Error())
-		return
 	}
 
 	var completion ChatCompletionResponse // From types_chat.go? Assuming yes or need define.
@@ -158,6 +322,11 @@ func (d *ChatCompletionDataSource) Read(ctx context.Context, req datasource.Read
 	data.Object = types.StringValue(completion.Object)
 	data.Model = types.StringValue(completion.Model)
 
+	data.BestChoiceIndex = types.Int64Null()
+	if best, ok := bestChoiceIndex(completion.Choices); ok {
+		data.BestChoiceIndex = types.Int64Value(int64(best))
+	}
+
 	// Usage
 	usage := map[string]int64{
 		"prompt_tokens":     int64(completion.Usage.PromptTokens),
@@ -335,9 +504,68 @@ func (d *ChatCompletionDataSource) Read(ctx context.Context, req datasource.Read
 
 	data.Choices, _ = types.ListValue(choicesElemType, choicesList)
 
+	if len(localComp.Choices) > 0 {
+		data.Content = types.StringValue(localComp.Choices[0].Message.Content)
+		data.FinishReason = types.StringValue(localComp.Choices[0].FinishReason)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// chatCompletionDataSourceRequest builds the request for a generated
+// completion from the data source's inputs, truncating the messages when
+// truncate_to_context is set. Tools, functions and response_format are
+// serialized as in openai_chat_completion.
+func chatCompletionDataSourceRequest(ctx context.Context, data *ChatCompletionDataSourceModel, diags *diag.Diagnostics) ChatCompletionRequest {
+	request := ChatCompletionRequest{
+		Model:            data.Model.ValueString(),
+		Functions:        chatFunctions(data.Functions),
+		FunctionCall:     chatFunctionCall(data.FunctionCall),
+		Tools:            chatTools(data.Tools),
+		Temperature:      data.Temperature.ValueFloat64(),
+		TopP:             data.TopP.ValueFloat64(),
+		N:                int(data.N.ValueInt64()),
+		Seed:             data.Seed.ValueInt64Pointer(),
+		MaxTokens:        int(data.MaxTokens.ValueInt64()),
+		PresencePenalty:  data.PresencePenalty.ValueFloat64(),
+		FrequencyPenalty: data.FrequencyPenalty.ValueFloat64(),
+		Logprobs:         data.Logprobs.ValueBool(),
+		User:             data.User.ValueString(),
+		SafetyIdentifier: data.SafetyIdentifier.ValueString(),
+		PromptCacheKey:   data.PromptCacheKey.ValueString(),
+	}
+	request.Messages = make([]ChatCompletionMessage, 0, len(data.Messages))
+	for _, m := range data.Messages {
+		request.Messages = append(request.Messages, ChatCompletionMessage{
+			Role:    m.Role.ValueString(),
+			Content: m.Content.ValueString(),
+			Name:    m.Name.ValueString(),
+		})
+	}
+	if data.TruncateToContext.ValueBool() {
+		request.Messages = truncateChatHistory(ctx, request.Messages, request.Model, request.MaxTokens, diags)
+	}
+	for _, stop := range data.Stop {
+		request.Stop = append(request.Stop, stop.ValueString())
+	}
+	if !data.LogitBias.IsNull() {
+		logitBias := make(map[string]float64)
+		diags.Append(data.LogitBias.ElementsAs(ctx, &logitBias, false)...)
+		request.LogitBias = logitBias
+	}
+	if !data.ToolChoice.IsNull() {
+		toolChoice, err := buildToolChoice(data.ToolChoice.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("tool_choice"), "Invalid tool_choice", err.Error())
+		}
+		request.ToolChoice = toolChoice
+	}
+	if data.ResponseFormat != nil {
+		request.ResponseFormat = data.ResponseFormat.chatResponseFormat()
+	}
+	return request
+}
+
 // --- Chat Completions (Plural) ---
 
 func NewChatCompletionsDataSource() datasource.DataSource {
@@ -449,7 +677,7 @@ func (d *ChatCompletionsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	url := "/v1/chat/completions"
+	url := "chat/completions"
 	params := []string{}
 
 	if !data.Limit.IsNull() {
@@ -731,7 +959,7 @@ func (d *ChatCompletionMessagesDataSource) Read(ctx context.Context, req datasou
 	}
 
 	completionID := data.CompletionID.ValueString()
-	url := fmt.Sprintf("/v1/chat/completions/%s/messages", completionID)
+	url := fmt.Sprintf("chat/completions/%s/messages", completionID)
 	params := []string{}
 	if !data.Limit.IsNull() {
 		params = append(params, fmt.Sprintf("limit=%d", data.Limit.ValueInt64()))
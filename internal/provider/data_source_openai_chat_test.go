@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readChatCompletionDataSource runs the chat completion data source's Read
+// against apiURL with the attributes set by configure; the rest are null.
+func readChatCompletionDataSource(t *testing.T, apiURL string, configure func(objType tftypes.Object, vals map[string]tftypes.Value)) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &ChatCompletionDataSource{client: newTestOpenAIClient(apiURL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	configure(objType, vals)
+	config := tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	return resp
+}
+
+func TestChatCompletionDataSource_Generates(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "chatcmpl-gen", "object": "chat.completion", "created": 1700000000, "model": "gpt-4o-mini-2024-07-18",
+			"choices": []map[string]interface{}{{
+				"index": 0, "finish_reason": "stop",
+				"message": map[string]interface{}{"role": "assistant", "content": "blue-falcon"},
+			}},
+			"usage": map[string]interface{}{"prompt_tokens": 9, "completion_tokens": 3, "total_tokens": 12},
+		})
+	}))
+	defer server.Close()
+
+	resp := readChatCompletionDataSource(t, server.URL, func(objType tftypes.Object, vals map[string]tftypes.Value) {
+		msgListType := objType.AttributeTypes["messages"].(tftypes.List)
+		msgType := msgListType.ElementType.(tftypes.Object)
+		vals["model"] = tftypes.NewValue(tftypes.String, "gpt-4o-mini")
+		vals["max_tokens"] = tftypes.NewValue(tftypes.Number, 16)
+		vals["safety_identifier"] = tftypes.NewValue(tftypes.String, "user-7f3a")
+		vals["prompt_cache_key"] = tftypes.NewValue(tftypes.String, "codenames")
+		vals["messages"] = tftypes.NewValue(msgListType, []tftypes.Value{
+			tftypes.NewValue(msgType, map[string]tftypes.Value{
+				"role":    tftypes.NewValue(tftypes.String, "user"),
+				"content": tftypes.NewValue(tftypes.String, "Name a project codename."),
+				"name":    tftypes.NewValue(tftypes.String, nil),
+			}),
+		})
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if sent["model"] != "gpt-4o-mini" || sent["max_tokens"] != float64(16) ||
+		sent["safety_identifier"] != "user-7f3a" || sent["prompt_cache_key"] != "codenames" {
+		t.Errorf("request = %v", sent)
+	}
+	if _, ok := sent["user"]; ok {
+		t.Errorf("user sent when unset: %v", sent["user"])
+	}
+
+	var got ChatCompletionDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "chatcmpl-gen" || !got.CompletionID.IsNull() {
+		t.Errorf("id = %q, completion_id = %v", got.ID.ValueString(), got.CompletionID)
+	}
+	if got.Content.ValueString() != "blue-falcon" || got.FinishReason.ValueString() != "stop" {
+		t.Errorf("content/finish_reason = %q/%q", got.Content.ValueString(), got.FinishReason.ValueString())
+	}
+	if total := got.Usage.Elements()["total_tokens"]; total == nil || total.String() != "12" {
+		t.Errorf("usage = %v", got.Usage)
+	}
+}
+
+func TestChatCompletionDataSource_RetrievesByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/chat/completions/chatcmpl-stored" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "chatcmpl-stored", "object": "chat.completion", "created": 1700000000, "model": "gpt-4o",
+			"choices": []map[string]interface{}{{
+				"index": 0, "finish_reason": "length",
+				"message": map[string]interface{}{"role": "assistant", "content": "Once upon"},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	resp := readChatCompletionDataSource(t, server.URL, func(objType tftypes.Object, vals map[string]tftypes.Value) {
+		vals["completion_id"] = tftypes.NewValue(tftypes.String, "chatcmpl-stored")
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got ChatCompletionDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Model.ValueString() != "gpt-4o" || got.Content.ValueString() != "Once upon" || got.FinishReason.ValueString() != "length" {
+		t.Errorf("state = %+v", got)
+	}
+}
+
+func TestChatCompletionDataSource_GeneratesWithToolChoice(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "chatcmpl-tool", "object": "chat.completion", "created": 1700000000, "model": "gpt-4o",
+			"choices": []map[string]interface{}{{
+				"index": 0, "finish_reason": "stop",
+				"message": map[string]interface{}{
+					"role": "assistant", "content": nil,
+					"tool_calls": []map[string]interface{}{{
+						"id": "call_1", "type": "function",
+						"function": map[string]interface{}{"name": "get_weather", "arguments": `{"city":"Paris"}`},
+					}},
+				},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	resp := readChatCompletionDataSource(t, server.URL, func(objType tftypes.Object, vals map[string]tftypes.Value) {
+		msgListType := objType.AttributeTypes["messages"].(tftypes.List)
+		toolListType := objType.AttributeTypes["tools"].(tftypes.List)
+		toolType := toolListType.ElementType.(tftypes.Object)
+		fnListType := toolType.AttributeTypes["function"].(tftypes.List)
+		vals["model"] = tftypes.NewValue(tftypes.String, "gpt-4o")
+		vals["messages"] = tftypes.NewValue(msgListType, []tftypes.Value{
+			tftypes.NewValue(msgListType.ElementType, map[string]tftypes.Value{
+				"role":    tftypes.NewValue(tftypes.String, "user"),
+				"content": tftypes.NewValue(tftypes.String, "Weather in Paris?"),
+				"name":    tftypes.NewValue(tftypes.String, nil),
+			}),
+		})
+		vals["tools"] = tftypes.NewValue(toolListType, []tftypes.Value{
+			tftypes.NewValue(toolType, map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "function"),
+				"function": tftypes.NewValue(fnListType, []tftypes.Value{
+					tftypes.NewValue(fnListType.ElementType, map[string]tftypes.Value{
+						"name":        tftypes.NewValue(tftypes.String, "get_weather"),
+						"description": tftypes.NewValue(tftypes.String, nil),
+						"parameters":  tftypes.NewValue(tftypes.String, `{"type":"object","properties":{"city":{"type":"string"}}}`),
+					}),
+				}),
+			}),
+		})
+		vals["tool_choice"] = tftypes.NewValue(tftypes.String, "get_weather")
+		vals["seed"] = tftypes.NewValue(tftypes.Number, 42)
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	choice, _ := sent["tool_choice"].(map[string]interface{})
+	fn, _ := choice["function"].(map[string]interface{})
+	if choice["type"] != "function" || fn["name"] != "get_weather" {
+		t.Errorf("tool_choice = %v, want the get_weather function forced", sent["tool_choice"])
+	}
+	if tools, _ := sent["tools"].([]interface{}); len(tools) != 1 {
+		t.Errorf("tools = %v, want one", sent["tools"])
+	}
+	if sent["seed"] != float64(42) {
+		t.Errorf("seed = %v, want 42", sent["seed"])
+	}
+
+	var got ChatCompletionDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if !strings.Contains(got.Choices.String(), `"get_weather"`) || !strings.Contains(got.Choices.String(), `"call_1"`) {
+		t.Errorf("choices = %v, want the get_weather tool call", got.Choices)
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EmbeddingSimilarityDataSource{}
+
+func NewEmbeddingSimilarityDataSource() datasource.DataSource {
+	return &EmbeddingSimilarityDataSource{}
+}
+
+type EmbeddingSimilarityDataSource struct {
+	client *OpenAIClient
+}
+
+type EmbeddingSimilarityDataSourceModel struct {
+	ID         types.String      `tfsdk:"id"`
+	Model      types.String      `tfsdk:"model"`
+	Dimensions types.Int64       `tfsdk:"dimensions"`
+	Inputs     []types.String    `tfsdk:"inputs"`
+	Vectors    [][]types.Float64 `tfsdk:"vectors"`
+	Similarity types.Float64     `tfsdk:"similarity"`
+}
+
+func (d *EmbeddingSimilarityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding_similarity"
+}
+
+func (d *EmbeddingSimilarityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to compute the cosine similarity of two texts, embedding both in a single request, or of two embedding vectors you already have.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "The embedding model to use, e.g. `text-embedding-3-small`. Required with `inputs`.",
+				Optional:    true,
+			},
+			"dimensions": schema.Int64Attribute{
+				Description: "The number of dimensions to embed the inputs with. Only supported by `text-embedding-3` and later models.",
+				Optional:    true,
+			},
+			"inputs": schema.ListAttribute{
+				Description: "The two texts to embed and compare. Conflicts with `vectors`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+					listvalidator.ExactlyOneOf(path.MatchRoot("vectors")),
+					listvalidator.AlsoRequires(path.MatchRoot("model")),
+				},
+			},
+			"vectors": schema.ListAttribute{
+				Description: "The two embedding vectors to compare, e.g. `[jsondecode(openai_embedding.a.embedding), jsondecode(openai_embedding.b.embedding)]`. Conflicts with `inputs`.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.Float64Type},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+				},
+			},
+			"similarity": schema.Float64Attribute{
+				Description: "The cosine similarity of the two embeddings, from -1 to 1. Values near 1 mean the texts are semantically close.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *EmbeddingSimilarityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *EmbeddingSimilarityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmbeddingSimilarityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var a, b []float64
+	if data.Vectors != nil {
+		a, b = float64Values(data.Vectors[0]), float64Values(data.Vectors[1])
+	} else {
+		vectors, err := d.embedPair(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating embeddings", err.Error())
+			return
+		}
+		a, b = vectors[0], vectors[1]
+	}
+
+	similarity, err := cosineSimilarity(a, b)
+	if err != nil {
+		resp.Diagnostics.AddError("Error computing cosine similarity", err.Error())
+		return
+	}
+
+	data.Similarity = types.Float64Value(similarity)
+	data.ID = types.StringValue("embedding_similarity")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// embedPair embeds both inputs in one request and returns their vectors in
+// input order.
+func (d *EmbeddingSimilarityDataSource) embedPair(data EmbeddingSimilarityDataSourceModel) ([2][]float64, error) {
+	var vectors [2][]float64
+
+	request := EmbeddingRequest{
+		Model: data.Model.ValueString(),
+		Input: []string{data.Inputs[0].ValueString(), data.Inputs[1].ValueString()},
+	}
+	if !data.Dimensions.IsNull() {
+		request.Dimensions = int(data.Dimensions.ValueInt64())
+	}
+	respBody, err := d.client.DoRequest("POST", "embeddings", request)
+	if err != nil {
+		return vectors, err
+	}
+
+	var embedResp EmbeddingResponse
+	if err := json.Unmarshal(respBody, &embedResp); err != nil {
+		return vectors, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(embedResp.Data) != 2 {
+		return vectors, fmt.Errorf("expected 2 embeddings, got %d", len(embedResp.Data))
+	}
+	for _, e := range embedResp.Data {
+		if e.Index < 0 || e.Index > 1 {
+			return vectors, fmt.Errorf("unexpected embedding index %d", e.Index)
+		}
+		if err := json.Unmarshal(e.Embedding, &vectors[e.Index]); err != nil {
+			return vectors, fmt.Errorf("error parsing embedding %d: %w", e.Index, err)
+		}
+	}
+	return vectors, nil
+}
+
+func float64Values(values []types.Float64) []float64 {
+	out := make([]float64, len(values))
+	for i, v := range values {
+		out[i] = v.ValueFloat64()
+	}
+	return out
+}
+
+// cosineSimilarity returns the cosine of the angle between a and b. The
+// vectors must have the same, non-zero length and neither may be all zeros.
+func cosineSimilarity(a, b []float64) (float64, error) {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0, fmt.Errorf("vectors must have the same non-zero number of dimensions, got %d and %d", len(a), len(b))
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0, fmt.Errorf("cosine similarity is undefined for a zero vector")
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
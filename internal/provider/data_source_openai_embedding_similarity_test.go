@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCosineSimilarity(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b []float64
+		want float64
+	}{
+		{"identical", []float64{0.3, -0.5, 0.8}, []float64{0.3, -0.5, 0.8}, 1},
+		{"scaled", []float64{1, 2}, []float64{2, 4}, 1},
+		{"orthogonal", []float64{1, 0}, []float64{0, 1}, 0},
+		{"opposite", []float64{1, -1}, []float64{-1, 1}, -1},
+	}
+	for _, tc := range cases {
+		got, err := cosineSimilarity(tc.a, tc.b)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s: similarity = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	for _, bad := range [][2][]float64{{{1, 0}, {1}}, {{}, {}}, {{0, 0}, {1, 0}}} {
+		if _, err := cosineSimilarity(bad[0], bad[1]); err == nil {
+			t.Errorf("cosineSimilarity(%v, %v) succeeded, want an error", bad[0], bad[1])
+		}
+	}
+}
+
+func TestEmbeddingSimilarityDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/embeddings" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Model string   `json:"model"`
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Input) != 2 {
+			t.Errorf("request body = %+v (%v), want two inputs", body, err)
+		}
+		// Returned out of order to check the vectors are matched by index.
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"model":  body.Model,
+			"data": []map[string]interface{}{
+				{"object": "embedding", "index": 1, "embedding": []float64{0, 1}},
+				{"object": "embedding", "index": 0, "embedding": []float64{1, 0}},
+			},
+			"usage": map[string]interface{}{"prompt_tokens": 4, "total_tokens": 4},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &EmbeddingSimilarityDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(set map[string]tftypes.Value) float64 {
+		t.Helper()
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range set {
+			vals[name] = v
+		}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read: %v", resp.Diagnostics)
+		}
+		var data EmbeddingSimilarityDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		return data.Similarity.ValueFloat64()
+	}
+
+	vector := func(values ...float64) tftypes.Value {
+		elems := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			elems[i] = tftypes.NewValue(tftypes.Number, v)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, elems)
+	}
+	vectors := func(a, b tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"vectors": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.Number}}, []tftypes.Value{a, b}),
+		}
+	}
+
+	if got := read(vectors(vector(0.2, 0.4, -0.1), vector(0.2, 0.4, -0.1))); math.Abs(got-1) > 1e-9 {
+		t.Errorf("identical vectors: similarity = %v, want 1", got)
+	}
+	if got := read(vectors(vector(3, 0), vector(0, 5))); math.Abs(got) > 1e-9 {
+		t.Errorf("orthogonal vectors: similarity = %v, want 0", got)
+	}
+
+	got := read(map[string]tftypes.Value{
+		"model": tftypes.NewValue(tftypes.String, "text-embedding-3-small"),
+		"inputs": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "cat"),
+			tftypes.NewValue(tftypes.String, "quarterly tax filing"),
+		}),
+	})
+	if math.Abs(got) > 1e-9 {
+		t.Errorf("embedded inputs: similarity = %v, want 0", got)
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FineTuningCheckpointsDataSource{}
+
+func NewFineTuningCheckpointsDataSource() datasource.DataSource {
+	return &FineTuningCheckpointsDataSource{}
+}
+
+type FineTuningCheckpointsDataSource struct {
+	client *OpenAIClient
+}
+
+type FineTuningCheckpointsDataSourceModel struct {
+	ID              types.String                `tfsdk:"id"`
+	FineTuningJobID types.String                `tfsdk:"fine_tuning_job_id"`
+	Checkpoints     []FineTuningCheckpointModel `tfsdk:"checkpoints"`
+}
+
+type FineTuningCheckpointModel struct {
+	ID                       types.String                      `tfsdk:"id"`
+	FineTunedModelCheckpoint types.String                      `tfsdk:"fine_tuned_model_checkpoint"`
+	StepNumber               types.Int64                       `tfsdk:"step_number"`
+	CreatedAt                types.Int64                       `tfsdk:"created_at"`
+	Metrics                  *FineTuningCheckpointMetricsModel `tfsdk:"metrics"`
+}
+
+type FineTuningCheckpointMetricsModel struct {
+	Step                       types.Float64 `tfsdk:"step"`
+	TrainLoss                  types.Float64 `tfsdk:"train_loss"`
+	TrainMeanTokenAccuracy     types.Float64 `tfsdk:"train_mean_token_accuracy"`
+	ValidLoss                  types.Float64 `tfsdk:"valid_loss"`
+	ValidMeanTokenAccuracy     types.Float64 `tfsdk:"valid_mean_token_accuracy"`
+	FullValidLoss              types.Float64 `tfsdk:"full_valid_loss"`
+	FullValidMeanTokenAccuracy types.Float64 `tfsdk:"full_valid_mean_token_accuracy"`
+}
+
+func (d *FineTuningCheckpointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fine_tuning_checkpoints"
+}
+
+func (d *FineTuningCheckpointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	metric := func(description string) schema.Float64Attribute {
+		return schema.Float64Attribute{Description: description, Computed: true}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the intermediate checkpoints of a fine-tuning job.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"fine_tuning_job_id": schema.StringAttribute{
+				Description: "The ID of the fine-tuning job to list checkpoints for.",
+				Required:    true,
+			},
+			"checkpoints": schema.ListNestedAttribute{
+				Description: "The job's checkpoints, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the checkpoint.",
+							Computed:    true,
+						},
+						"fine_tuned_model_checkpoint": schema.StringAttribute{
+							Description: "The name of the fine-tuned checkpoint model, usable wherever a model name is accepted.",
+							Computed:    true,
+						},
+						"step_number": schema.Int64Attribute{
+							Description: "The training step at which the checkpoint was created.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "The Unix timestamp (in seconds) for when the checkpoint was created.",
+							Computed:    true,
+						},
+						"metrics": schema.SingleNestedAttribute{
+							Description: "Training metrics recorded at the checkpoint. Metrics the API did not report are null.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"step":                           metric("The step the metrics were recorded at."),
+								"train_loss":                     metric("The training loss."),
+								"train_mean_token_accuracy":      metric("The mean token accuracy on the training batch."),
+								"valid_loss":                     metric("The validation loss."),
+								"valid_mean_token_accuracy":      metric("The mean token accuracy on the validation batch."),
+								"full_valid_loss":                metric("The loss over the full validation file."),
+								"full_valid_mean_token_accuracy": metric("The mean token accuracy over the full validation file."),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FineTuningCheckpointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected type", fmt.Sprintf("Expected *OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *FineTuningCheckpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FineTuningCheckpointsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkpoints, err := d.client.ListAllFineTuningCheckpoints(ctx, data.FineTuningJobID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing fine-tuning checkpoints", err.Error())
+		return
+	}
+
+	data.Checkpoints = make([]FineTuningCheckpointModel, 0, len(checkpoints))
+	for _, c := range checkpoints {
+		checkpoint := FineTuningCheckpointModel{
+			ID:                       types.StringValue(c.ID),
+			FineTunedModelCheckpoint: types.StringValue(c.FineTunedModelCheckpoint),
+			StepNumber:               types.Int64Value(c.StepNumber),
+			CreatedAt:                types.Int64Value(c.CreatedAt),
+		}
+		if m := c.Metrics; m != nil {
+			checkpoint.Metrics = &FineTuningCheckpointMetricsModel{
+				Step:                       types.Float64PointerValue(m.Step),
+				TrainLoss:                  types.Float64PointerValue(m.TrainLoss),
+				TrainMeanTokenAccuracy:     types.Float64PointerValue(m.TrainMeanTokenAccuracy),
+				ValidLoss:                  types.Float64PointerValue(m.ValidLoss),
+				ValidMeanTokenAccuracy:     types.Float64PointerValue(m.ValidMeanTokenAccuracy),
+				FullValidLoss:              types.Float64PointerValue(m.FullValidLoss),
+				FullValidMeanTokenAccuracy: types.Float64PointerValue(m.FullValidMeanTokenAccuracy),
+			}
+		}
+		data.Checkpoints = append(data.Checkpoints, checkpoint)
+	}
+
+	data.ID = data.FineTuningJobID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIFineTuningCheckpoints_Pagination lists a job whose
+// checkpoints span two pages and checks that metrics are mapped.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIFineTuningCheckpoints_Pagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockFineTuningCheckpointsHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_fine_tuning_checkpoints" "test" {
+  fine_tuning_job_id = "ftjob-1"
+}
+`, srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_checkpoints.test", "checkpoints.#", "2"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_checkpoints.test", "checkpoints.0.fine_tuned_model_checkpoint", "ft:gpt-4o-mini:org::abc:ckpt-step-200"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_checkpoints.test", "checkpoints.0.step_number", "200"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_checkpoints.test", "checkpoints.0.metrics.train_loss", "0.25"),
+					resource.TestCheckNoResourceAttr("data.openai_fine_tuning_checkpoints.test", "checkpoints.0.metrics.valid_loss"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_checkpoints.test", "checkpoints.1.id", "ftckpt_1"),
+				),
+			},
+		},
+	})
+}
+
+// mockFineTuningCheckpointsHandler serves two checkpoints, newest first,
+// across two pages.
+func mockFineTuningCheckpointsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/fine_tuning/jobs/ftjob-1/checkpoints" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	if r.URL.Query().Get("after") == "" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"object": "fine_tuning.job.checkpoint", "id": "ftckpt_2", "created_at": 1700000200, "fine_tuned_model_checkpoint": "ft:gpt-4o-mini:org::abc:ckpt-step-200", "fine_tuning_job_id": "ftjob-1", "step_number": 200, "metrics": map[string]interface{}{"step": 200, "train_loss": 0.25, "train_mean_token_accuracy": 0.9}},
+			},
+			"last_id":  "ftckpt_2",
+			"has_more": true,
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"object": "list",
+		"data": []map[string]interface{}{
+			{"object": "fine_tuning.job.checkpoint", "id": "ftckpt_1", "created_at": 1700000100, "fine_tuned_model_checkpoint": "ft:gpt-4o-mini:org::abc:ckpt-step-100", "fine_tuning_job_id": "ftjob-1", "step_number": 100, "metrics": map[string]interface{}{"step": 100, "train_loss": 0.5}},
+		},
+		"last_id":  "ftckpt_1",
+		"has_more": false,
+	})
+}
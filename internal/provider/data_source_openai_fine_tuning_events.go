@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FineTuningEventsDataSource{}
+
+// fineTuningEventsPageSize is the largest page the events endpoint returns.
+const fineTuningEventsPageSize = 100
+
+func NewFineTuningEventsDataSource() datasource.DataSource {
+	return &FineTuningEventsDataSource{}
+}
+
+type FineTuningEventsDataSource struct {
+	client *OpenAIClient
+}
+
+type FineTuningEventsDataSourceModel struct {
+	ID              types.String           `tfsdk:"id"`
+	FineTuningJobID types.String           `tfsdk:"fine_tuning_job_id"`
+	Limit           types.Int64            `tfsdk:"limit"`
+	Level           types.String           `tfsdk:"level"`
+	Events          []FineTuningEventModel `tfsdk:"events"`
+}
+
+type FineTuningEventModel struct {
+	ID        types.String `tfsdk:"id"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+	Level     types.String `tfsdk:"level"`
+	Message   types.String `tfsdk:"message"`
+	Data      types.String `tfsdk:"data"`
+}
+
+func (d *FineTuningEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fine_tuning_events"
+}
+
+func (d *FineTuningEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read the event log of a fine-tuning job, such as training progress, metrics and checkpoint messages.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"fine_tuning_job_id": schema.StringAttribute{
+				Description: "The ID of the fine-tuning job to read events for.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of events to return, newest first. All events are returned if unset.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"level": schema.StringAttribute{
+				Description: "Only return events with this level: `info`, `warn` or `error`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("info", "warn", "error")},
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "The job's events, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the event.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "The Unix timestamp (in seconds) for when the event was created.",
+							Computed:    true,
+						},
+						"level": schema.StringAttribute{
+							Description: "The level of the event: `info`, `warn` or `error`.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The event message.",
+							Computed:    true,
+						},
+						"data": schema.StringAttribute{
+							Description: "JSON-encoded event data, such as step metrics. Null for events without data.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FineTuningEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected type", fmt.Sprintf("Expected *OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *FineTuningEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FineTuningEventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID := data.FineTuningJobID.ValueString()
+	limit := int(data.Limit.ValueInt64())
+	level := data.Level.ValueString()
+
+	events := []FineTuningEventModel{}
+	after := ""
+	for {
+		page, err := d.client.ListFineTuningEvents(ctx, jobID, after, fineTuningEventsPageSize)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing fine-tuning events", err.Error())
+			return
+		}
+
+		for _, e := range page.Data {
+			// The API has no level filter, so it is applied here.
+			if level != "" && e.Level != level {
+				continue
+			}
+			event := FineTuningEventModel{
+				ID:        types.StringValue(e.ID),
+				CreatedAt: types.Int64Value(e.CreatedAt),
+				Level:     types.StringValue(e.Level),
+				Message:   types.StringValue(e.Message),
+				Data:      types.StringNull(),
+			}
+			if len(e.Data) > 0 && string(e.Data) != "null" {
+				event.Data = types.StringValue(string(e.Data))
+			}
+			events = append(events, event)
+			if limit > 0 && len(events) == limit {
+				break
+			}
+		}
+
+		if !page.HasMore || len(page.Data) == 0 || (limit > 0 && len(events) >= limit) {
+			break
+		}
+		after = page.Data[len(page.Data)-1].ID
+	}
+
+	data.ID = data.FineTuningJobID
+	data.Events = events
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
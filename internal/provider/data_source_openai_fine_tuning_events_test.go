@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIFineTuningEvents_PaginationAndFilters reads a job
+// whose events span two pages and checks the level filter and limit.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIFineTuningEvents_PaginationAndFilters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockFineTuningEventsHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpenAIFineTuningEventsConfig(srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_events.all", "events.#", "3"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_events.all", "events.0.data", `{"step":20,"train_loss":0.42}`),
+					resource.TestCheckNoResourceAttr("data.openai_fine_tuning_events.all", "events.1.data"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_events.errors", "events.#", "1"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_events.errors", "events.0.id", "ftevent-1"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_events.latest", "events.#", "1"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_events.latest", "events.0.id", "ftevent-3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpenAIFineTuningEventsConfig(apiURL string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_fine_tuning_events" "all" {
+  fine_tuning_job_id = "ftjob-1"
+}
+
+data "openai_fine_tuning_events" "errors" {
+  fine_tuning_job_id = "ftjob-1"
+  level              = "error"
+}
+
+data "openai_fine_tuning_events" "latest" {
+  fine_tuning_job_id = "ftjob-1"
+  limit              = 1
+}
+`, apiURL)
+}
+
+// mockFineTuningEventsHandler serves three events, newest first, across
+// two pages.
+func mockFineTuningEventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/fine_tuning/jobs/ftjob-1/events" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	if r.URL.Query().Get("after") == "" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"object": "fine_tuning.job.event", "id": "ftevent-3", "created_at": 1700000300, "level": "info", "message": "Step 20/100: training loss=0.42", "type": "metrics", "data": map[string]interface{}{"step": 20, "train_loss": 0.42}},
+				{"object": "fine_tuning.job.event", "id": "ftevent-2", "created_at": 1700000200, "level": "warn", "message": "Retrying step", "type": "message"},
+			},
+			"has_more": true,
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"object": "list",
+		"data": []map[string]interface{}{
+			{"object": "fine_tuning.job.event", "id": "ftevent-1", "created_at": 1700000100, "level": "error", "message": "Validation file has invalid lines", "type": "message"},
+		},
+		"has_more": false,
+	})
+}
@@ -10,9 +10,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -193,7 +195,7 @@ func (d *FineTuningJobDataSource) Read(ctx context.Context, req datasource.ReadR
 		httpReq.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
 	}
 
-	httpResp, err := http.DefaultClient.Do(httpReq)
+	httpResp, err := d.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -286,6 +288,9 @@ func (d *FineTuningJobDataSource) Read(ctx context.Context, req datasource.ReadR
 
 // --- List Data Source ---
 
+// fineTuningJobsPageSize is the page size used when listing jobs.
+const fineTuningJobsPageSize = 100
+
 func NewFineTuningJobsDataSource() datasource.DataSource {
 	return &FineTuningJobsDataSource{}
 }
@@ -310,12 +315,27 @@ func (d *FineTuningJobsDataSource) Schema(ctx context.Context, req datasource.Sc
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to retrieve a list of fine-tuning jobs.",
 		Attributes: map[string]schema.Attribute{
-			"after":    schema.StringAttribute{Optional: true},
-			"limit":    schema.Int64Attribute{Optional: true},
-			"metadata": schema.MapAttribute{Optional: true, ElementType: types.StringType},
-			"has_more": schema.BoolAttribute{Computed: true},
+			"after": schema.StringAttribute{
+				Description: "Only return jobs created before the job with this ID, i.e. start listing after it.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of jobs to return, newest first. All jobs are returned if unset.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Only return jobs whose metadata contains all of these key-value pairs.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"has_more": schema.BoolAttribute{
+				Description: "Whether more jobs exist beyond `limit`.",
+				Computed:    true,
+			},
 			"jobs": schema.ListNestedAttribute{
-				Computed: true,
+				Description: "The fine-tuning jobs, newest first.",
+				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id":               schema.StringAttribute{Computed: true},
@@ -356,49 +376,43 @@ func (d *FineTuningJobsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	queryParams := url.Values{}
-	if !data.After.IsNull() {
-		queryParams.Set("after", data.After.ValueString())
-	}
-	if !data.Limit.IsNull() {
-		queryParams.Set("limit", strconv.Itoa(int(data.Limit.ValueInt64())))
-	}
-
-	apiURL := fmt.Sprintf("%s/fine_tuning/jobs?%s", d.client.OpenAIClient.APIURL, queryParams.Encode())
-	httpReq, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-
-	httpReq.Header.Set("Authorization", "Bearer "+d.client.OpenAIClient.APIKey)
-	if d.client.OpenAIClient.OrganizationID != "" {
-		httpReq.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
+	limit := int(data.Limit.ValueInt64())
+	metadata := map[string]string{}
+	if !data.Metadata.IsNull() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	httpResp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
-	}
-	defer httpResp.Body.Close()
+	jobs := []FineTuningJobResponse{}
+	hasMore := false
+	after := data.After.ValueString()
+	for {
+		page, err := d.listJobsPage(ctx, after, metadata)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing fine-tuning jobs", err.Error())
+			return
+		}
 
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s, Body: %s", httpResp.Status, string(bodyBytes)))
-		return
-	}
+		if limit > 0 && len(jobs)+len(page.Data) > limit {
+			jobs = append(jobs, page.Data[:limit-len(jobs)]...)
+			hasMore = true
+			break
+		}
+		jobs = append(jobs, page.Data...)
 
-	var listResp struct {
-		Data    []FineTuningJobResponse `json:"data"`
-		HasMore bool                    `json:"has_more"`
-	}
-	if err := json.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
-		resp.Diagnostics.AddError("Error decoding response", err.Error())
-		return
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		if limit > 0 && len(jobs) == limit {
+			hasMore = true
+			break
+		}
+		after = page.Data[len(page.Data)-1].ID
 	}
 
-	data.HasMore = types.BoolValue(listResp.HasMore)
+	data.HasMore = types.BoolValue(hasMore)
 
 	// Map Jobs
 
@@ -425,7 +439,7 @@ func (d *FineTuningJobsDataSource) Read(ctx context.Context, req datasource.Read
 		},
 	}
 
-	for _, job := range listResp.Data {
+	for _, job := range jobs {
 		resultFiles, _ := types.ListValueFrom(ctx, types.StringType, job.ResultFiles)
 
 		attrs := map[string]attr.Value{
@@ -454,3 +468,44 @@ func (d *FineTuningJobsDataSource) Read(ctx context.Context, req datasource.Read
 	data.Jobs, _ = types.ListValue(objType, listValues)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// listJobsPage fetches one page of fine-tuning jobs, starting after the given
+// job ID and filtered by metadata.
+func (d *FineTuningJobsDataSource) listJobsPage(ctx context.Context, after string, metadata map[string]string) (*FineTuningJobListResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Set("limit", strconv.Itoa(fineTuningJobsPageSize))
+	if after != "" {
+		queryParams.Set("after", after)
+	}
+	for k, v := range metadata {
+		queryParams.Set(fmt.Sprintf("metadata[%s]", k), v)
+	}
+
+	apiURL := fmt.Sprintf("%s/fine_tuning/jobs?%s", d.client.OpenAIClient.APIURL, queryParams.Encode())
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	httpReq.Header.Set("Authorization", "Bearer "+d.client.OpenAIClient.APIKey)
+	if d.client.OpenAIClient.OrganizationID != "" {
+		httpReq.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
+	}
+
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(httpResp.Body)
+		return nil, fmt.Errorf("status: %s, body: %s", httpResp.Status, string(bodyBytes))
+	}
+
+	var page FineTuningJobListResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+	return &page, nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIFineTuningJobs_Pagination lists jobs spanning two
+// pages and checks that limit caps the result and reports has_more.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIFineTuningJobs_Pagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockFineTuningJobsHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_fine_tuning_jobs" "all" {}
+
+data "openai_fine_tuning_jobs" "latest" {
+  limit = 1
+}
+`, srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.all", "jobs.#", "3"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.all", "has_more", "false"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.all", "jobs.0.fine_tuned_model", "ft:gpt-4o-mini:org::c"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.all", "jobs.0.finished_at", "1700000300"),
+					resource.TestCheckNoResourceAttr("data.openai_fine_tuning_jobs.all", "jobs.1.finished_at"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.all", "jobs.2.id", "ftjob-a"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.latest", "jobs.#", "1"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.latest", "jobs.0.id", "ftjob-c"),
+					resource.TestCheckResourceAttr("data.openai_fine_tuning_jobs.latest", "has_more", "true"),
+				),
+			},
+		},
+	})
+}
+
+// mockFineTuningJobsHandler serves three jobs, newest first: two on the first
+// page and one on the second.
+func mockFineTuningJobsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/fine_tuning/jobs" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	job := func(id, status string, createdAt int, finishedAt interface{}, model string) map[string]interface{} {
+		return map[string]interface{}{
+			"object":           "fine_tuning.job",
+			"id":               id,
+			"model":            "gpt-4o-mini-2024-07-18",
+			"status":           status,
+			"created_at":       createdAt,
+			"finished_at":      finishedAt,
+			"fine_tuned_model": model,
+			"training_file":    "file-train",
+			"result_files":     []string{},
+		}
+	}
+
+	if r.URL.Query().Get("after") == "" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				job("ftjob-c", "succeeded", 1700000200, 1700000300, "ft:gpt-4o-mini:org::c"),
+				job("ftjob-b", "running", 1700000100, nil, ""),
+			},
+			"has_more": true,
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"object": "list",
+		"data": []map[string]interface{}{
+			job("ftjob-a", "failed", 1700000000, 1700000050, ""),
+		},
+		"has_more": false,
+	})
+}
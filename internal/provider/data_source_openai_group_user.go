@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -129,7 +128,6 @@ func (d *GroupUserDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	var foundUser *GroupUserResponseFramework
 	cursor := ""
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	for foundUser == nil {
 		parsedURL, err := url.Parse(reqURL)
@@ -156,7 +154,7 @@ func (d *GroupUserDataSource) Read(ctx context.Context, req datasource.ReadReque
 			httpRequest.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
 		}
 
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
@@ -348,7 +346,6 @@ func (d *GroupUsersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	userIDs := make([]string, 0)
 
 	cursor := ""
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 	for {
 		parsedURL, err := url.Parse(reqURL)
 		if err != nil {
@@ -373,7 +370,7 @@ func (d *GroupUsersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			httpRequest.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
 		}
 
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
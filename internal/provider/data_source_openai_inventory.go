@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	inventoryTypeProject     = "project"
+	inventoryTypeVectorStore = "vector_store"
+	inventoryTypeFile        = "file"
+)
+
+// inventoryTypes lists the object types the inventory can enumerate, in the
+// order they appear in the result.
+var inventoryTypes = []string{
+	inventoryTypeProject,
+	inventoryTypeVectorStore,
+	inventoryTypeFile,
+}
+
+var _ datasource.DataSource = &InventoryDataSource{}
+
+func NewInventoryDataSource() datasource.DataSource {
+	return &InventoryDataSource{}
+}
+
+// InventoryDataSource lists the IDs and names of every object the provider
+// can manage in the organization, for auditing.
+type InventoryDataSource struct {
+	client *OpenAIClient
+}
+
+type InventoryDataSourceModel struct {
+	ID    types.String         `tfsdk:"id"`
+	Types types.Set            `tfsdk:"types"`
+	Items []InventoryItemModel `tfsdk:"items"`
+}
+
+type InventoryItemModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// inventoryItem is a single object found while building the inventory.
+type inventoryItem struct {
+	Type string
+	ID   string
+	Name string
+}
+
+func (d *InventoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+func (d *InventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the IDs and names of the projects, vector stores and files in the organization, for auditing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"types": schema.SetAttribute{
+				Description: "The object types to list: project, vector_store or file. Defaults to all of them.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(inventoryTypes...)),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The objects found, grouped by type and sorted by ID within each type.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The object type: project, vector_store or file.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "The ID of the object.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the object. For files this is the filename.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected type", fmt.Sprintf("Expected *OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InventoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selected := inventoryTypes
+	if !data.Types.IsNull() && !data.Types.IsUnknown() {
+		var configured []string
+		resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		selected = configured
+	}
+
+	items, err := d.collect(ctx, selected)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building inventory", err.Error())
+		return
+	}
+
+	data.Items = make([]InventoryItemModel, 0, len(items))
+	for _, item := range items {
+		data.Items = append(data.Items, InventoryItemModel{
+			Type: types.StringValue(item.Type),
+			ID:   types.StringValue(item.ID),
+			Name: types.StringValue(item.Name),
+		})
+	}
+	data.ID = types.StringValue("inventory")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// collect lists the selected object types concurrently and returns the items
+// in inventoryTypes order, sorted by ID within each type. It fails if any
+// listing fails, so a partial inventory is never reported as complete.
+func (d *InventoryDataSource) collect(ctx context.Context, selected []string) ([]inventoryItem, error) {
+	listers := map[string]func(context.Context) ([]inventoryItem, error){
+		inventoryTypeProject:     d.listProjects,
+		inventoryTypeVectorStore: d.listVectorStores,
+		inventoryTypeFile:        d.listFiles,
+	}
+
+	want := make(map[string]bool, len(selected))
+	for _, t := range selected {
+		want[t] = true
+	}
+
+	results := make(map[string][]inventoryItem, len(selected))
+	errs := make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, t := range inventoryTypes {
+		if !want[t] {
+			continue
+		}
+		wg.Add(1)
+		go func(t string) {
+			defer wg.Done()
+			items, err := listers[t](ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[t] = err
+				return
+			}
+			results[t] = items
+		}(t)
+	}
+	wg.Wait()
+
+	var all []inventoryItem
+	for _, t := range inventoryTypes {
+		if err := errs[t]; err != nil {
+			return nil, fmt.Errorf("listing %ss: %w", t, err)
+		}
+		items := results[t]
+		sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+		all = append(all, items...)
+	}
+
+	return all, nil
+}
+
+// listProjects lists every project, archived ones included, using the admin
+// key.
+func (d *InventoryDataSource) listProjects(ctx context.Context) ([]inventoryItem, error) {
+	adminClient, err := GetOpenAIClientWithAdminKey(d.client)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := adminClient.ListAllProjects(true)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]inventoryItem, 0, len(projects))
+	for _, p := range projects {
+		items = append(items, inventoryItem{Type: inventoryTypeProject, ID: p.ID, Name: p.Name})
+	}
+	return items, nil
+}
+
+func (d *InventoryDataSource) listVectorStores(ctx context.Context) ([]inventoryItem, error) {
+	var items []inventoryItem
+	cursor := ""
+
+	for {
+		queryParams := url.Values{}
+		queryParams.Set("limit", "100")
+		if cursor != "" {
+			queryParams.Set("after", cursor)
+		}
+
+		respBody, err := d.client.DoRequestContext(ctx, http.MethodGet, "vector_stores?"+queryParams.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page ListVectorStoresResponse
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse vector stores response: %w", err)
+		}
+
+		for _, vs := range page.Data {
+			items = append(items, inventoryItem{Type: inventoryTypeVectorStore, ID: vs.ID, Name: vs.Name})
+		}
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		cursor = page.LastID
+		if cursor == "" {
+			cursor = page.Data[len(page.Data)-1].ID
+		}
+	}
+
+	return items, nil
+}
+
+func (d *InventoryDataSource) listFiles(ctx context.Context) ([]inventoryItem, error) {
+	var items []inventoryItem
+	cursor := ""
+
+	for {
+		queryParams := url.Values{}
+		queryParams.Set("limit", "100")
+		if cursor != "" {
+			queryParams.Set("after", cursor)
+		}
+
+		respBody, err := d.client.DoRequestContext(ctx, http.MethodGet, "files?"+queryParams.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page ListFilesResponse
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse files response: %w", err)
+		}
+
+		for _, f := range page.Data {
+			items = append(items, inventoryItem{Type: inventoryTypeFile, ID: f.ID, Name: f.Filename})
+		}
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		cursor = page.LastID
+		if cursor == "" {
+			cursor = page.Data[len(page.Data)-1].ID
+		}
+	}
+
+	return items, nil
+}
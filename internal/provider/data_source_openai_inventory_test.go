@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mockInventoryServer serves two pages of files, one page of projects and no
+// vector stores.
+func mockInventoryServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/organization/projects":
+			if got := r.Header.Get("Authorization"); got != "Bearer test-admin-key" {
+				t.Errorf("projects listed with %q, want the admin key", got)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "list",
+				"data": []map[string]interface{}{
+					{"id": "proj_b", "name": "Beta", "status": "active"},
+					{"id": "proj_a", "name": "Alpha", "status": "archived"},
+				},
+				"has_more": false,
+			})
+		case "/v1/vector_stores":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{}, "has_more": false})
+		case "/v1/files":
+			if r.URL.Query().Get("after") == "" {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"object":   "list",
+					"data":     []map[string]interface{}{{"id": "file-2", "filename": "two.jsonl"}},
+					"last_id":  "file-2",
+					"has_more": true,
+				})
+				return
+			}
+			if got := r.URL.Query().Get("after"); got != "file-2" {
+				t.Errorf("files second page after = %q, want file-2", got)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object":   "list",
+				"data":     []map[string]interface{}{{"id": "file-1", "filename": "one.jsonl"}},
+				"last_id":  "file-1",
+				"has_more": false,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestInventory_AggregatesTypes(t *testing.T) {
+	server := mockInventoryServer(t)
+	defer server.Close()
+
+	d := &InventoryDataSource{client: newTestOpenAIClient(server.URL)}
+	items, err := d.collect(context.Background(), inventoryTypes)
+	if err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	want := []inventoryItem{
+		{Type: "project", ID: "proj_a", Name: "Alpha"},
+		{Type: "project", ID: "proj_b", Name: "Beta"},
+		{Type: "file", ID: "file-1", Name: "one.jsonl"},
+		{Type: "file", ID: "file-2", Name: "two.jsonl"},
+	}
+	if !reflect.DeepEqual(items, want) {
+		t.Fatalf("items = %+v, want %+v", items, want)
+	}
+}
+
+func TestInventory_SelectedTypesOnly(t *testing.T) {
+	server := mockInventoryServer(t)
+	defer server.Close()
+
+	d := &InventoryDataSource{client: newTestOpenAIClient(server.URL)}
+	items, err := d.collect(context.Background(), []string{"file"})
+	if err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+	for _, item := range items {
+		if item.Type != "file" {
+			t.Fatalf("unexpected %s item %s", item.Type, item.ID)
+		}
+	}
+	if len(items) != 2 {
+		t.Fatalf("got %d items, want 2", len(items))
+	}
+}
+
+func TestInventory_FailsOnListingError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/vector_stores" {
+			w.Header().Set("Retry-After", "0") // skip the client's backoff
+			writeJSON(w, http.StatusInternalServerError, map[string]interface{}{
+				"error": map[string]interface{}{"message": "boom", "type": "server_error"},
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{}, "has_more": false})
+	}))
+	defer server.Close()
+
+	d := &InventoryDataSource{client: newTestOpenAIClient(server.URL)}
+	_, err := d.collect(context.Background(), []string{"file", "vector_store"})
+	if err == nil || !strings.Contains(err.Error(), "vector_store") {
+		t.Fatalf("err = %v, want a vector_store listing error", err)
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
@@ -153,8 +155,7 @@ func (d *InviteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
+	httpResp, err := d.client.Do(httpRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error executing request", err.Error())
 		return
@@ -224,6 +225,7 @@ type InvitesDataSource struct {
 }
 
 type InvitesDataSourceModel struct {
+	Status  types.String        `tfsdk:"status"`
 	Invites []InviteResultModel `tfsdk:"invites"`
 	ID      types.String        `tfsdk:"id"`
 }
@@ -244,14 +246,21 @@ func (d *InvitesDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (d *InvitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Use this data source to retrieve a list of all pending invitations in an OpenAI organization.",
+		Description: "Use this data source to retrieve a list of invitations in an OpenAI organization.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of this resource.",
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: "Only return invitations with this status: `pending`, `accepted` or `expired`. Returns all invitations if not set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "accepted", "expired"),
+				},
+			},
 			"invites": schema.ListNestedAttribute{
-				Description: "List of pending invitations.",
+				Description: "List of invitations.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -349,7 +358,8 @@ func (d *InvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		reqURL = strings.TrimSuffix(apiURL, "/") + "/v1" + suffix
 	}
 
-	var allInvites []InviteResultModel
+	timeout := inviteListTimeout(d.client)
+	allInvites := []InviteResultModel{}
 	cursor := ""
 
 	for {
@@ -371,7 +381,7 @@ func (d *InvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			LastID  string `json:"last_id"`
 		}
 
-		opErr := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		opErr := retry.RetryContext(ctx, 2*timeout, func() *retry.RetryError {
 			parsedURL, _ := url.Parse(reqURL)
 			q := parsedURL.Query()
 			q.Set("limit", "100")
@@ -387,8 +397,7 @@ func (d *InvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 			httpRequest.Header.Set("Content-Type", "application/json")
 
-			httpClient := &http.Client{Timeout: 30 * time.Second}
-			httpResp, err := httpClient.Do(httpRequest)
+			httpResp, err := d.client.Do(httpRequest)
 			if err != nil {
 				return retry.RetryableError(err)
 			}
@@ -439,6 +448,9 @@ func (d *InvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		}
 
 		for _, inv := range listResp.Data {
+			if !data.Status.IsNull() && inv.Status != data.Status.ValueString() {
+				continue
+			}
 			model := InviteResultModel{
 				ID:        types.StringValue(inv.ID),
 				Email:     types.StringValue(inv.Email),
@@ -473,3 +485,13 @@ func (d *InvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// inviteListTimeout returns the per-request timeout for listing invites.
+// Listing can be slow for organizations with many invites, so it follows the
+// provider's timeout setting and falls back to two minutes.
+func inviteListTimeout(c *OpenAIClient) time.Duration {
+	if c != nil && c.OpenAIClient != nil && c.OpenAIClient.Timeout > 0 {
+		return c.OpenAIClient.Timeout
+	}
+	return 2 * time.Minute
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIInvites_StatusFilter pages through a mock invite
+// listing and checks that the status filter returns only matching invites.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIInvites_StatusFilter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockInvitesListHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_invites" "all" {}
+
+data "openai_invites" "pending" {
+  status = "pending"
+}
+`, srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_invites.all", "invites.#", "3"),
+					resource.TestCheckResourceAttr("data.openai_invites.pending", "invites.#", "2"),
+					resource.TestCheckResourceAttr("data.openai_invites.pending", "invites.0.email", "a@example.com"),
+					resource.TestCheckResourceAttr("data.openai_invites.pending", "invites.1.email", "c@example.com"),
+					resource.TestCheckResourceAttr("data.openai_invites.pending", "invites.1.projects.0.id", "proj_1"),
+				),
+			},
+		},
+	})
+}
+
+// mockInvitesListHandler serves the invite listing across two pages.
+func mockInvitesListHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/organization/invites" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	if r.URL.Query().Get("after") == "" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"object": "organization.invite", "id": "invite_1", "email": "a@example.com", "role": "reader", "status": "pending", "created_at": 1700000000, "expires_at": 1700600000},
+				{"object": "organization.invite", "id": "invite_2", "email": "b@example.com", "role": "owner", "status": "accepted", "created_at": 1700000001, "expires_at": 1700600001},
+			},
+			"has_more": true,
+			"last_id":  "invite_2",
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"object": "list",
+		"data": []map[string]interface{}{
+			{"object": "organization.invite", "id": "invite_3", "email": "c@example.com", "role": "reader", "status": "pending", "created_at": 1700000002, "expires_at": 1700600002,
+				"projects": []map[string]interface{}{{"id": "proj_1", "role": "member"}}},
+		},
+		"has_more": false,
+		"last_id":  "invite_3",
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 // Ensure implementation satisfies interfaces.
@@ -118,13 +117,7 @@ func (d *ModelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Since OpenAiClient is available in d.client, we can check ProjectAPIKey.
 	apiClient := d.client.OpenAIClient
 	if d.client.ProjectAPIKey != "" {
-		config := client.ClientConfig{
-			APIKey:         d.client.ProjectAPIKey,
-			OrganizationID: d.client.OpenAIClient.OrganizationID,
-			APIURL:         d.client.OpenAIClient.APIURL,
-			Timeout:        d.client.OpenAIClient.Timeout,
-		}
-		apiClient = client.NewClientWithConfig(config)
+		apiClient = d.client.withAPIKey(d.client.ProjectAPIKey, true)
 	}
 
 	modelID := data.ModelID.ValueString()
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ModelSnapshotDataSource{}
+
+func NewModelSnapshotDataSource() datasource.DataSource {
+	return &ModelSnapshotDataSource{}
+}
+
+// ModelSnapshotDataSource resolves a model alias such as gpt-4o to the dated
+// snapshot it currently points at, so configurations can pin the snapshot.
+type ModelSnapshotDataSource struct {
+	client *OpenAIClient
+}
+
+type ModelSnapshotDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Alias     types.String `tfsdk:"alias"`
+	Refresh   types.Bool   `tfsdk:"refresh"`
+	Snapshot  types.String `tfsdk:"snapshot"`
+	Created   types.Int64  `tfsdk:"created"`
+	Snapshots []string     `tfsdk:"snapshots"`
+}
+
+// snapshotSuffix matches the date suffixes OpenAI gives snapshots: a full
+// date (gpt-4o-2024-08-06) or, for older models, month and day
+// (gpt-4-0613).
+const snapshotSuffix = `-(\d{4}-\d{2}-\d{2}|\d{4})$`
+
+func (d *ModelSnapshotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_snapshot"
+}
+
+func (d *ModelSnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a model alias, such as `gpt-4o`, to its newest dated snapshot, such as `gpt-4o-2024-08-06`, from the models available to the API key. Use it to pin the snapshot an alias resolves to today.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The resolved snapshot ID.",
+				Computed:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "The model alias to resolve, e.g. `gpt-4o`.",
+				Required:            true,
+			},
+			"refresh": schema.BoolAttribute{
+				MarkdownDescription: "Fetch the models list from the API even if a cached listing is available.",
+				Optional:            true,
+			},
+			"snapshot": schema.StringAttribute{
+				MarkdownDescription: "The newest dated snapshot of the alias.",
+				Computed:            true,
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp (in seconds) of when the snapshot was created.",
+				Computed:            true,
+			},
+			"snapshots": schema.ListAttribute{
+				MarkdownDescription: "All dated snapshots of the alias, newest first.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ModelSnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelSnapshotDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient, err := GetOpenAIClientWithProjectKey(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	models, err := listModelsCached(apiClient, data.Refresh.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing models", err.Error())
+		return
+	}
+
+	alias := data.Alias.ValueString()
+	snapshots := modelSnapshots(alias, models)
+	if len(snapshots) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alias"),
+			"No snapshot found for model alias",
+			fmt.Sprintf("None of the %d models available to this API key is a dated snapshot of %q.", len(models), alias),
+		)
+		return
+	}
+
+	data.ID = snapshots[0].ID
+	data.Snapshot = snapshots[0].ID
+	data.Created = snapshots[0].Created
+	data.Snapshots = make([]string, 0, len(snapshots))
+	for _, m := range snapshots {
+		data.Snapshots = append(data.Snapshots, m.ID.ValueString())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// modelSnapshots returns the dated snapshots of alias among models, newest
+// first by creation time. Snapshots of longer aliases sharing the prefix,
+// such as gpt-4o-mini-2024-07-18 for gpt-4o, are not matched.
+func modelSnapshots(alias string, models []ModelResponseModel) []ModelResponseModel {
+	re := regexp.MustCompile("^" + regexp.QuoteMeta(alias) + snapshotSuffix)
+	var snapshots []ModelResponseModel
+	for _, m := range models {
+		if re.MatchString(m.ID.ValueString()) {
+			snapshots = append(snapshots, m)
+		}
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if ci, cj := snapshots[i].Created.ValueInt64(), snapshots[j].Created.ValueInt64(); ci != cj {
+			return ci > cj
+		}
+		return snapshots[i].ID.ValueString() > snapshots[j].ID.ValueString()
+	})
+	return snapshots
+}
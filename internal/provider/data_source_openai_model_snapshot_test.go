@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestModelSnapshotDataSource_Read(t *testing.T) {
+	resetModelsCacheForTest()
+	t.Cleanup(resetModelsCacheForTest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/models" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"id": "gpt-4o", "object": "model", "created": 1715367049, "owned_by": "system"},
+				{"id": "gpt-4o-2024-05-13", "object": "model", "created": 1715368132, "owned_by": "system"},
+				{"id": "gpt-4o-2024-08-06", "object": "model", "created": 1722814719, "owned_by": "system"},
+				{"id": "gpt-4o-mini-2024-07-18", "object": "model", "created": 1721172717, "owned_by": "system"},
+				{"id": "gpt-4-0613", "object": "model", "created": 1686588896, "owned_by": "openai"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &ModelSnapshotDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(alias string) (*datasource.ReadResponse, ModelSnapshotDataSourceModel) {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		vals["alias"] = tftypes.NewValue(tftypes.String, alias)
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+		var data ModelSnapshotDataSourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		return resp, data
+	}
+
+	resp, data := read("gpt-4o")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if data.Snapshot.ValueString() != "gpt-4o-2024-08-06" || data.Created.ValueInt64() != 1722814719 {
+		t.Errorf("snapshot = %s (created %d), want gpt-4o-2024-08-06", data.Snapshot, data.Created.ValueInt64())
+	}
+	if len(data.Snapshots) != 2 || data.Snapshots[1] != "gpt-4o-2024-05-13" {
+		t.Errorf("snapshots = %v, want gpt-4o-2024-08-06 then gpt-4o-2024-05-13", data.Snapshots)
+	}
+
+	if _, data := read("gpt-4"); data.Snapshot.ValueString() != "gpt-4-0613" {
+		t.Errorf("gpt-4 snapshot = %s, want gpt-4-0613", data.Snapshot)
+	}
+
+	if resp, _ := read("o1"); !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an alias with no snapshots")
+	}
+}
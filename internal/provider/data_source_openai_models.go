@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,8 +31,9 @@ func (d *ModelsDataSource) Metadata(ctx context.Context, req datasource.Metadata
 }
 
 type ModelsDataSourceModel struct {
-	ID     types.String         `tfsdk:"id"`
-	Models []ModelResponseModel `tfsdk:"models"`
+	ID      types.String         `tfsdk:"id"`
+	Refresh types.Bool           `tfsdk:"refresh"`
+	Models  []ModelResponseModel `tfsdk:"models"`
 }
 
 type ModelResponseModel struct {
@@ -38,6 +43,35 @@ type ModelResponseModel struct {
 	Object  types.String `tfsdk:"object"`
 }
 
+// modelsCacheTTL bounds how long a cached model listing is reused. It is
+// long enough to cover the reads of a single plan or apply and short enough
+// that a long-lived provider process still picks up newly available models.
+const modelsCacheTTL = 5 * time.Minute
+
+// Per-process cache of `GET /v1/models` responses.
+//
+// Many modules declare their own `data "openai_models"`; without the cache
+// every one of them issues an identical list call. Entries are keyed by API
+// URL, organization and a fingerprint of the API key, because fine-tuned
+// models are only visible to the project that owns them. The mutex is held
+// across the fetch so concurrent reads coalesce into a single request.
+var (
+	modelsCacheMu sync.Mutex
+	modelsCache   = map[string]modelsCacheEntry{}
+)
+
+type modelsCacheEntry struct {
+	models    []ModelResponseModel
+	fetchedAt time.Time
+}
+
+// resetModelsCacheForTest clears the package-level models cache. Used only by tests.
+func resetModelsCacheForTest() {
+	modelsCacheMu.Lock()
+	defer modelsCacheMu.Unlock()
+	modelsCache = map[string]modelsCacheEntry{}
+}
+
 func (d *ModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Models data source allows you to list all available models.",
@@ -46,6 +80,10 @@ func (d *ModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Description: "The ID of this resource.",
 				Computed:    true,
 			},
+			"refresh": schema.BoolAttribute{
+				Description: "Bypass the provider's short-lived model list cache and always query the API. By default, reads within the same provider process reuse one `/v1/models` response for up to five minutes.",
+				Optional:    true,
+			},
 			"models": schema.ListNestedAttribute{
 				Description: "List of models.",
 				Computed:    true,
@@ -95,21 +133,43 @@ func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	apiClient := d.client.OpenAIClient
 	if d.client.ProjectAPIKey != "" {
-		config := client.ClientConfig{
-			APIKey:         d.client.ProjectAPIKey,
-			OrganizationID: d.client.OpenAIClient.OrganizationID,
-			APIURL:         d.client.OpenAIClient.APIURL,
-			Timeout:        d.client.OpenAIClient.Timeout,
-		}
-		apiClient = client.NewClientWithConfig(config)
+		apiClient = d.client.withAPIKey(d.client.ProjectAPIKey, true)
 	}
 
-	respBody, err := apiClient.DoRequest(http.MethodGet, "models", nil)
+	models, err := listModelsCached(apiClient, data.Refresh.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError("Error listing models", err.Error())
 		return
 	}
 
+	data.Models = models
+	data.ID = types.StringValue("models")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func modelsCacheKey(c *client.OpenAIClient) string {
+	sum := sha256.Sum256([]byte(c.APIKey))
+	return c.APIURL + "|" + c.OrganizationID + "|" + c.ProjectID + "|" + hex.EncodeToString(sum[:8])
+}
+
+// listModelsCached returns the model listing for apiClient, reusing a cached
+// response younger than modelsCacheTTL unless refresh is set.
+func listModelsCached(apiClient *client.OpenAIClient, refresh bool) ([]ModelResponseModel, error) {
+	key := modelsCacheKey(apiClient)
+
+	modelsCacheMu.Lock()
+	defer modelsCacheMu.Unlock()
+
+	if entry, ok := modelsCache[key]; ok && !refresh && time.Since(entry.fetchedAt) < modelsCacheTTL {
+		return entry.models, nil
+	}
+
+	respBody, err := apiClient.DoRequest(http.MethodGet, "models", nil)
+	if err != nil {
+		return nil, err
+	}
+
 	var listResp struct {
 		Data []struct {
 			ID      string `json:"id"`
@@ -119,8 +179,7 @@ func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		} `json:"data"`
 	}
 	if err := json.Unmarshal(respBody, &listResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing models response", err.Error())
-		return
+		return nil, fmt.Errorf("error parsing models response: %w", err)
 	}
 
 	models := []ModelResponseModel{}
@@ -133,8 +192,6 @@ func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		})
 	}
 
-	data.Models = models
-	data.ID = types.StringValue("models")
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	modelsCache[key] = modelsCacheEntry{models: models, fetchedAt: time.Now()}
+	return models, nil
 }
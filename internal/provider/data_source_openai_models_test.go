@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestListModelsCached_ReusesResponse(t *testing.T) {
+	resetModelsCacheForTest()
+	t.Cleanup(resetModelsCacheForTest)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/models" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		atomic.AddInt32(&calls, 1)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"id": "gpt-4o", "object": "model", "created": 1715367049, "owned_by": "system"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL).OpenAIClient
+
+	for i := 0; i < 2; i++ {
+		models, err := listModelsCached(c, false)
+		if err != nil {
+			t.Fatalf("read %d: %v", i, err)
+		}
+		if len(models) != 1 || models[0].ID.ValueString() != "gpt-4o" {
+			t.Fatalf("read %d: unexpected models %v", i, models)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 upstream request for two reads, got %d", got)
+	}
+
+	if _, err := listModelsCached(c, true); err != nil {
+		t.Fatalf("refresh read: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected refresh to bypass the cache, got %d upstream requests", got)
+	}
+}
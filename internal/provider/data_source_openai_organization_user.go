@@ -8,8 +8,10 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -139,8 +141,7 @@ func (d *OrganizationUserDataSource) Read(ctx context.Context, req datasource.Re
 		httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 		httpRequest.Header.Set("Content-Type", "application/json")
 
-		httpClient := &http.Client{}
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
@@ -190,8 +191,7 @@ func (d *OrganizationUserDataSource) Read(ctx context.Context, req datasource.Re
 			httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 			httpRequest.Header.Set("Content-Type", "application/json")
 
-			httpClient := &http.Client{}
-			httpResp, err := httpClient.Do(httpRequest)
+			httpResp, err := d.client.Do(httpRequest)
 			if err != nil {
 				resp.Diagnostics.AddError("Error executing request", err.Error())
 				return
@@ -278,6 +278,7 @@ type OrganizationUsersDataSource struct {
 type OrganizationUsersDataSourceModel struct {
 	UserID types.String                  `tfsdk:"user_id"`
 	Emails types.List                    `tfsdk:"emails"` // Changed from []types.String to types.List
+	Role   types.String                  `tfsdk:"role"`
 	Users  []OrganizationUserResultModel `tfsdk:"users"`
 	ID     types.String                  `tfsdk:"id"`
 }
@@ -312,8 +313,15 @@ func (d *OrganizationUsersDataSource) Schema(ctx context.Context, req datasource
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"role": schema.StringAttribute{
+				Description: "Only return users with this organization role (`owner` or `reader`).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationRoles...),
+				},
+			},
 			"users": schema.ListNestedAttribute{
-				Description: "List of users in the organization.",
+				Description: "List of users in the organization. All pages are fetched.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -401,8 +409,7 @@ func (d *OrganizationUsersDataSource) Read(ctx context.Context, req datasource.R
 
 		httpRequest, _ := http.NewRequest("GET", reqURL, nil)
 		httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
-		httpClient := &http.Client{}
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
@@ -431,83 +438,40 @@ func (d *OrganizationUsersDataSource) Read(ctx context.Context, req datasource.R
 
 		data.ID = types.StringValue(fmt.Sprintf("organization-user-%s", userID))
 	} else {
-		// List all users
-		suffix := "/organization/users"
-		var reqURL string
-		if strings.Contains(apiURL, "/v1") {
-			reqURL = strings.TrimSuffix(apiURL, "/v1") + "/v1" + suffix
-		} else {
-			reqURL = strings.TrimSuffix(apiURL, "/") + "/v1" + suffix
-		}
-
-		targetEmails := make(map[string]bool)
+		// List all users, following the cursor across pages. The API filters
+		// by email; it has no role filter, so that one is applied here.
+		var emails []string
 		if !data.Emails.IsNull() {
-			var emails []string
-			// Convert List to []string
-			data.Emails.ElementsAs(ctx, &emails, false)
-			for _, e := range emails {
-				targetEmails[strings.ToLower(e)] = true
-			}
-		}
-
-		cursor := ""
-		for {
-			parsedURL, _ := url.Parse(reqURL)
-			q := parsedURL.Query()
-			q.Set("limit", "100")
-			if cursor != "" {
-				q.Set("after", cursor)
-			}
-			parsedURL.RawQuery = q.Encode()
-
-			httpRequest, _ := http.NewRequest("GET", parsedURL.String(), nil)
-			httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
-			httpClient := &http.Client{}
-			httpResp, err := httpClient.Do(httpRequest)
-			if err != nil {
-				resp.Diagnostics.AddError("Error executing request", err.Error())
-				return
-			}
-			defer httpResp.Body.Close()
-
-			if httpResp.StatusCode != 200 {
-				resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s", httpResp.Status))
-				return
-			}
-
-			type OrgUserListResponse struct {
-				Object  string                              `json:"object"`
-				Data    []OrganizationUserResponseFramework `json:"data"`
-				FirstID string                              `json:"first_id"`
-				LastID  string                              `json:"last_id"`
-				HasMore bool                                `json:"has_more"`
-			}
-			var listResp OrgUserListResponse
-			if err := json.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
-				resp.Diagnostics.AddError("Error decoding response", err.Error())
+			resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+			if resp.Diagnostics.HasError() {
 				return
 			}
+		}
 
-			for _, u := range listResp.Data {
-				if len(targetEmails) > 0 {
-					if !targetEmails[strings.ToLower(u.Email)] {
-						continue
-					}
-				}
-				allUsers = append(allUsers, OrganizationUserResultModel{
-					ID:      types.StringValue(u.ID),
-					Object:  types.StringValue(u.Object),
-					Email:   types.StringValue(u.Email),
-					Name:    types.StringValue(u.Name),
-					Role:    types.StringValue(u.Role),
-					AddedAt: types.Int64Value(u.AddedAt),
-				})
-			}
+		adminClient, err := GetOpenAIClientWithAdminKey(d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+			return
+		}
+		users, err := adminClient.ListAllUsers(emails)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing organization users", err.Error())
+			return
+		}
 
-			if !listResp.HasMore || listResp.LastID == "" {
-				break
+		role := data.Role.ValueString()
+		for _, u := range users {
+			if role != "" && u.Role != role {
+				continue
 			}
-			cursor = listResp.LastID
+			allUsers = append(allUsers, OrganizationUserResultModel{
+				ID:      types.StringValue(u.ID),
+				Object:  types.StringValue(u.Object),
+				Email:   types.StringValue(u.Email),
+				Name:    types.StringValue(u.Name),
+				Role:    types.StringValue(u.Role),
+				AddedAt: types.Int64Value(u.AddedAt),
+			})
 		}
 
 		data.ID = types.StringValue(fmt.Sprintf("organization-users-all-%d", len(allUsers)))
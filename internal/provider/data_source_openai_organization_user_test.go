@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrganizationUsersDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/users" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-admin-key" {
+			t.Errorf("Authorization = %q, want the admin key", r.Header.Get("Authorization"))
+		}
+		if got := strings.Join(r.URL.Query()["emails"], ","); got != "a@example.com,b@example.com" {
+			t.Errorf("emails = %q, want them forwarded to the API", got)
+		}
+		if r.URL.Query().Get("after") == "" {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object":   "list",
+				"data":     []map[string]interface{}{{"id": "user_a", "email": "a@example.com", "role": "owner", "added_at": 1}},
+				"last_id":  "user_a",
+				"has_more": true,
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object":   "list",
+			"data":     []map[string]interface{}{{"id": "user_b", "email": "b@example.com", "role": "reader", "added_at": 2}},
+			"last_id":  "user_b",
+			"has_more": false,
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &OrganizationUsersDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(role string) OrganizationUsersDataSourceModel {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		vals["emails"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a@example.com"),
+			tftypes.NewValue(tftypes.String, "b@example.com"),
+		})
+		if role != "" {
+			vals["role"] = tftypes.NewValue(tftypes.String, role)
+		}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+		var data OrganizationUsersDataSourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read: %v", resp.Diagnostics)
+		}
+		return data
+	}
+
+	if data := read(""); len(data.Users) != 2 || data.Users[0].ID.ValueString() != "user_a" || data.Users[1].ID.ValueString() != "user_b" {
+		t.Errorf("users = %v, want both pages", data.Users)
+	}
+	if data := read("reader"); len(data.Users) != 1 || data.Users[0].ID.ValueString() != "user_b" {
+		t.Errorf("role=reader users = %v, want only user_b", data.Users)
+	}
+}
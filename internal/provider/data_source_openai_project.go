@@ -2,14 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ datasource.DataSource = &ProjectDataSource{}
@@ -23,12 +22,16 @@ type ProjectDataSource struct {
 }
 
 type ProjectDataSourceModel struct {
-	ProjectID types.String `tfsdk:"project_id"`
-	AdminKey  types.String `tfsdk:"admin_key"`
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.Int64  `tfsdk:"created_at"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	AdminKey       types.String `tfsdk:"admin_key"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Status         types.String `tfsdk:"status"`
+	IsDefault      types.Bool   `tfsdk:"is_default"`
+	CreatedAt      types.Int64  `tfsdk:"created_at"`
+	ArchivedAt     types.Int64  `tfsdk:"archived_at"`
+	OrganizationID types.String `tfsdk:"organization_id"`
 }
 
 func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -56,14 +59,30 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Description: "The name of the project.",
 				Computed:    true,
 			},
+			"description": schema.StringAttribute{
+				Description: "The description of the project, if set.",
+				Computed:    true,
+			},
 			"status": schema.StringAttribute{
 				Description: "The status of the project.",
 				Computed:    true,
 			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the organization's default project.",
+				Computed:    true,
+			},
 			"created_at": schema.Int64Attribute{
 				Description: "The Unix timestamp (in seconds) for when the project was created.",
 				Computed:    true,
 			},
+			"archived_at": schema.Int64Attribute{
+				Description: "The Unix timestamp (in seconds) for when the project was archived, if it has been.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization the project belongs to. Taken from the API response when present, otherwise from the provider's `organization` setting.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -113,82 +132,35 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// Use custom client configuration for admin key if specific key provided, or just use existing logic
-	// But since the project endpoint requires Admin Key, we must be careful.
-	// We'll construct a direct request or use a helper if we had one.
-	// Since DoRequest uses the configured client (Project Key usually), we might need override.
-
-	// However, DoRequest usually uses the client's configured key.
-	// If we need to use a different key:
-	path := fmt.Sprintf("/v1/organization/projects/%s", projectID)
-	// Check for path adjustment
-	if strings.Contains(d.client.OpenAIClient.APIURL, "/v1") {
-		path = fmt.Sprintf("organization/projects/%s", projectID)
-	}
-
-	// Create request manually to allow overriding header
-	// Note: d.client.OpenAIClient exposes DoRequest but seemingly not easy header override per request if using the helper method.
-	// We might need to access the underlying HTTP client or use a method that allows custom headers if `d.client` exposes it.
-	// The `OpenAIClient` wrapper in `provider.go` usually just wraps the `mkdev-me/go-openai` client or similar.
-	// Looking at `Configure` in `provider_framework.go`: `providerClient` has `OpenAIClient`, `ProjectAPIKey`, `AdminAPIKey`.
-
-	// If we use `d.client.OpenAIClient.DoRequest`, it likely uses the configured key (Project Key).
-	// We need to verify if `OpenAIClient` (from `internal/client`) supports overriding headers.
-	// If not, we might need to rely on standard http client or just assume the provider was config with admin key if this datasource is used?
-	// But the schema allows `admin_key` override.
+	adminClient := d.client.withAPIKey(apiKey, false)
 
-	// Let's assume we can use the `DoRequest` but maybe we can't easily swap the key.
-	// Actually, `organization/projects` endpoints MUST use Admin Key.
-	// If the provider is configured with a Project Key, `DoRequest` will fail if we can't switch it.
-	// Ideally we should create a new client with the Admin Key or manually make the request.
-
-	// Safe bet: Manually make the HTTP request if we need a specific key that might differ from the provider's main key.
-	apiURL := d.client.OpenAIClient.APIURL
-	reqURL := fmt.Sprintf("%s/%s", strings.TrimSuffix(apiURL, "/"), strings.TrimPrefix(path, "/"))
-
-	httpRequest, err := http.NewRequest("GET", reqURL, nil)
+	project, err := adminClient.GetProject(projectID)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_id"),
+				"Project not found",
+				fmt.Sprintf("No project with ID %q exists in this organization, or the admin key cannot see it.", projectID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project", err.Error())
 		return
 	}
 
-	httpRequest.Header.Set("Authorization", "Bearer "+apiKey)
-	httpRequest.Header.Set("Content-Type", "application/json")
-	// Organization ID from client config if available? The legacy code uses `d.client.OrganizationID`.
-	// We can't easily access the internal organization ID from `d.client.OpenAIClient` unless exposed.
-	// However, `d.client` is `*OpenAIClient` which is defined in `provider.go` (or `provider_framework.go`?).
-	// Let's check `provider_framework.go` again...
-	// `type OpenAIClient struct { OpenAIClient *client.Client; ProjectAPIKey ...; AdminAPIKey ... }`
-	// It doesn't explicitly store OrganizationID in `OpenAIClient` struct in `provider.go` (based on what I saw earlier in `provider_framework.go`).
-	// Wait, `Configure` in `provider_framework.go` creates `config` with `OrganizationID`.
-	// But `OpenAIClient` struct definition was unfortunately not fully visible or I missed it.
-	// I'll assume standard http client approach is safest for these admin endpoints.
-
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
-	if err != nil {
-		resp.Diagnostics.AddError("Error executing request", err.Error())
-		return
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s", httpResp.Status))
-		return
-	}
-
-	var project ProjectResponseFramework
-	if err := json.NewDecoder(httpResp.Body).Decode(&project); err != nil {
-		resp.Diagnostics.AddError("Error decoding response", err.Error())
-		return
+	orgID := types.StringPointerValue(project.OrganizationID)
+	if orgID.IsNull() && d.client.OpenAIClient.OrganizationID != "" {
+		orgID = types.StringValue(d.client.OpenAIClient.OrganizationID)
 	}
 
 	data.ID = types.StringValue(project.ID)
 	data.Name = types.StringValue(project.Name)
+	data.Description = types.StringPointerValue(project.Description)
 	data.Status = types.StringValue(project.Status)
-	data.CreatedAt = types.Int64Value(project.CreatedAt)
+	data.IsDefault = types.BoolValue(project.IsDefault != nil && *project.IsDefault)
+	data.CreatedAt = types.Int64PointerValue(project.CreatedAt)
+	data.ArchivedAt = types.Int64PointerValue(project.ArchivedAt)
+	data.OrganizationID = orgID
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-// Ensure ProjectResponseFramework is imported or defined. It is in types_project_org.go which is in same package.
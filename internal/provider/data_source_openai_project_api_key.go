@@ -150,8 +150,7 @@ func (d *ProjectAPIKeyDataSource) Read(ctx context.Context, req datasource.ReadR
 	httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
+	httpResp, err := d.client.Do(httpRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error executing request", err.Error())
 		return
@@ -325,8 +324,7 @@ func (d *ProjectAPIKeysDataSource) Read(ctx context.Context, req datasource.Read
 	httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
+	httpResp, err := d.client.Do(httpRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error executing request", err.Error())
 		return
@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -154,8 +153,7 @@ func (d *ProjectGroupDataSource) Read(ctx context.Context, req datasource.ReadRe
 			httpRequest.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
 		}
 
-		httpClient := &http.Client{Timeout: 30 * time.Second}
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
@@ -369,8 +367,7 @@ func (d *ProjectGroupsDataSource) Read(ctx context.Context, req datasource.ReadR
 			httpRequest.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
 		}
 
-		httpClient := &http.Client{Timeout: 30 * time.Second}
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
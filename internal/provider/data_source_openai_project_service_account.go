@@ -136,8 +136,7 @@ func (d *ProjectServiceAccountDataSource) Read(ctx context.Context, req datasour
 	httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
-	httpResp, err := httpClient.Do(httpRequest)
+	httpResp, err := d.client.Do(httpRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error executing request", err.Error())
 		return
@@ -181,6 +180,7 @@ type ProjectServiceAccountsDataSource struct {
 
 type ProjectServiceAccountsDataSourceModel struct {
 	ProjectID       types.String                       `tfsdk:"project_id"`
+	APIKey          types.String                       `tfsdk:"api_key"`
 	ServiceAccounts []ProjectServiceAccountResultModel `tfsdk:"service_accounts"`
 	ID              types.String                       `tfsdk:"id"`
 }
@@ -217,6 +217,11 @@ func (d *ProjectServiceAccountsDataSource) Schema(ctx context.Context, req datas
 				Description: "The ID of the project from which to retrieve service accounts.",
 				Required:    true,
 			},
+			"api_key": schema.StringAttribute{
+				Description: "Admin API key for authentication. If not provided, the provider's default Admin API key will be used.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"service_accounts": schema.ListNestedAttribute{
 				Description: "List of service accounts in the project.",
 				Computed:    true,
@@ -278,10 +283,13 @@ func (d *ProjectServiceAccountsDataSource) Read(ctx context.Context, req datasou
 
 	projectID := data.ProjectID.ValueString()
 	adminKey := d.client.AdminAPIKey
+	if !data.APIKey.IsNull() {
+		adminKey = data.APIKey.ValueString()
+	}
 	if adminKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing Admin API Key",
-			"The provider must be configured with an Admin API Key (admin_key) to list project service accounts.",
+			"An Admin API Key is required to list project service accounts. Set api_key or configure the provider with admin_key.",
 		)
 		return
 	}
@@ -290,7 +298,7 @@ func (d *ProjectServiceAccountsDataSource) Read(ctx context.Context, req datasou
 	// /v1/organization/projects/{project_id}/service_accounts
 	suffix := fmt.Sprintf("/organization/projects/%s/service_accounts", projectID)
 
-	var allAccounts []ProjectServiceAccountResultModel
+	allAccounts := []ProjectServiceAccountResultModel{}
 	cursor := ""
 
 	for {
@@ -319,21 +327,22 @@ func (d *ProjectServiceAccountsDataSource) Read(ctx context.Context, req datasou
 		httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 		httpRequest.Header.Set("Content-Type", "application/json")
 
-		httpClient := &http.Client{}
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
 		}
-		defer httpResp.Body.Close()
 
 		if httpResp.StatusCode != 200 {
+			httpResp.Body.Close()
 			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s", httpResp.Status))
 			return
 		}
 
 		var listResp ProjectServiceAccountsListResponse
-		if err := json.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
+		err = json.NewDecoder(httpResp.Body).Decode(&listResp)
+		httpResp.Body.Close()
+		if err != nil {
 			resp.Diagnostics.AddError("Error decoding response", err.Error())
 			return
 		}
@@ -353,7 +362,7 @@ func (d *ProjectServiceAccountsDataSource) Read(ctx context.Context, req datasou
 			allAccounts = append(allAccounts, saModel)
 		}
 
-		if !listResp.HasMore {
+		if !listResp.HasMore || listResp.LastID == "" {
 			break
 		}
 		cursor = listResp.LastID
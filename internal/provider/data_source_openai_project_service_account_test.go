@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIProjectServiceAccounts_Empty lists a project without
+// service accounts and checks that the result is an empty list, not an error.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIProjectServiceAccounts_Empty(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/projects/proj_empty/service_accounts" {
+			http.Error(w, "not found: "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer override-admin-key" {
+			writeJSON(w, http.StatusUnauthorized, map[string]interface{}{"error": map[string]interface{}{"message": "wrong key"}})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{}, "has_more": false})
+	}))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_project_service_accounts" "test" {
+  project_id = "proj_empty"
+  api_key    = "override-admin-key"
+}
+`, srv.URL),
+				Check: resource.TestCheckResourceAttr("data.openai_project_service_accounts.test", "service_accounts.#", "0"),
+			},
+		},
+	})
+}
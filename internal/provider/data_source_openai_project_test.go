@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIProject_basic reads an existing project and then
+// checks that an unknown ID produces a diagnostic rather than a crash.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIProject_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/organization/projects/proj_abc":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object":      "organization.project",
+				"id":          "proj_abc",
+				"name":        "Production",
+				"description": "Customer-facing workloads",
+				"status":      "archived",
+				"is_default":  false,
+				"created_at":  1700000000,
+				"archived_at": 1700000500,
+			})
+		default:
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{
+				"error": map[string]interface{}{"message": "Project not found", "type": "invalid_request_error"},
+			})
+		}
+	}))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpenAIProjectConfig(srv.URL, "proj_abc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_project.test", "name", "Production"),
+					resource.TestCheckResourceAttr("data.openai_project.test", "description", "Customer-facing workloads"),
+					resource.TestCheckResourceAttr("data.openai_project.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("data.openai_project.test", "archived_at", "1700000500"),
+					resource.TestCheckResourceAttr("data.openai_project.test", "organization_id", "org-acc-test"),
+				),
+			},
+			{
+				Config:      testAccDataSourceOpenAIProjectConfig(srv.URL, "proj_missing"),
+				ExpectError: regexp.MustCompile(`Project not found`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpenAIProjectConfig(apiURL, projectID string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url      = "%s/v1"
+  admin_key    = "acc-test-admin-key"
+  api_key      = "acc-test-api-key"
+  organization = "org-acc-test"
+}
+
+data "openai_project" "test" {
+  project_id = %q
+}
+`, apiURL, projectID)
+}
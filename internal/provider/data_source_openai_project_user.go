@@ -8,9 +8,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ datasource.DataSource = &ProjectUserDataSource{}
@@ -45,12 +49,17 @@ func (d *ProjectUserDataSource) Schema(ctx context.Context, req datasource.Schem
 				Required:    true,
 			},
 			"user_id": schema.StringAttribute{
-				Description: "The ID of the user to retrieve.",
+				Description: "The ID of the user to retrieve. Exactly one of `user_id` or `email` must be set.",
 				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+				},
 			},
 			"email": schema.StringAttribute{
-				Description: "The email address of the user to retrieve.",
+				Description: "The email address of the user to retrieve, matched case-insensitively.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the resource (composite of project_id:user_id).",
@@ -100,14 +109,6 @@ func (d *ProjectUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 	userID := data.UserID.ValueString()
 	email := data.Email.ValueString()
 
-	if userID == "" && email == "" {
-		resp.Diagnostics.AddError(
-			"Missing User Identifier",
-			"Either user_id or email must be provided.",
-		)
-		return
-	}
-
 	// We need Admin Key
 	adminKey := d.client.AdminAPIKey
 	if adminKey == "" {
@@ -118,99 +119,35 @@ func (d *ProjectUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	// Helper to fetch all users and find logic
-	// Since we don't have a direct "Get Project User by Email" API, we likely need to list and filter.
-	// Even for ID, checking existence via list is common if Get endpoint doesn't exist or we want to be safe.
-	// Actually typical API is GET /v1/organization/projects/{project_id}/users/{user_id}
-	// Let's check if that endpoint exists.
-	// Legacy code uses `ListProjectUsers` for both ID and Email lookup.
-	// This implies there might NOT be a direct GET endpoint or legacy implementation preferred listing.
-	// Checking `dataSourceFindProjectUser` in legacy: it loops `ListProjectUsers`.
-	// So we must replicate that.
-
-	apiURL := d.client.OpenAIClient.APIURL
-	// /v1/organization/projects/{project_id}/users
-	suffix := fmt.Sprintf("/organization/projects/%s/users", projectID)
-
-	var reqURL string
-	if strings.Contains(apiURL, "/v1") {
-		reqURL = strings.TrimSuffix(apiURL, "/v1") + "/v1" + suffix
-	} else {
-		reqURL = strings.TrimSuffix(apiURL, "/") + "/v1" + suffix
+	adminClient, err := GetOpenAIClientWithAdminKey(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
 	}
 
-	var foundUser *ProjectUserResponseFramework
-	cursor := ""
-
-	// Loop until found or done
-	for foundUser == nil {
-		parsedURL, _ := url.Parse(reqURL)
-		q := parsedURL.Query()
-		q.Set("limit", "100")
-		if cursor != "" {
-			q.Set("after", cursor)
-		}
-		parsedURL.RawQuery = q.Encode()
-
-		httpRequest, err := http.NewRequest("GET", parsedURL.String(), nil)
+	// The API has no lookup by email, so both paths list the project's users.
+	var foundUser *client.ProjectUser
+	if email != "" {
+		user, found, err := adminClient.FindProjectUserByEmail(projectID, email)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating request", err.Error())
+			resp.Diagnostics.AddError("Error reading project user", err.Error())
 			return
 		}
-
-		httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
-		httpRequest.Header.Set("Content-Type", "application/json")
-
-		httpClient := &http.Client{}
-		httpResp, err := httpClient.Do(httpRequest)
-		if err != nil {
-			resp.Diagnostics.AddError("Error executing request", err.Error())
-			return
-		}
-		defer httpResp.Body.Close()
-
-		if httpResp.StatusCode != 200 {
-			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s", httpResp.Status))
-			return
-		}
-
-		// We need a list response struct for Project Users
-		// I'll define it here locally or check if I defined it earlier.
-		// ProjectUserResponseFramework is in types_project_org.go.
-		type ProjectUserListResponse struct {
-			Object  string                         `json:"object"`
-			Data    []ProjectUserResponseFramework `json:"data"`
-			FirstID string                         `json:"first_id"`
-			LastID  string                         `json:"last_id"`
-			HasMore bool                           `json:"has_more"`
+		if found {
+			foundUser = user
 		}
-
-		var listResp ProjectUserListResponse
-		if err := json.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
-			resp.Diagnostics.AddError("Error decoding response", err.Error())
+	} else {
+		users, err := adminClient.ListAllProjectUsers(projectID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading project user", err.Error())
 			return
 		}
-
-		for i := range listResp.Data {
-			user := listResp.Data[i]
-			if userID != "" && user.ID == userID {
-				foundUser = &user
-				break
-			}
-			if email != "" && strings.EqualFold(user.Email, email) {
-				foundUser = &user
+		for i := range users {
+			if users[i].ID == userID {
+				foundUser = &users[i]
 				break
 			}
 		}
-
-		if foundUser != nil {
-			break
-		}
-
-		if !listResp.HasMore || listResp.LastID == "" {
-			break
-		}
-		cursor = listResp.LastID
 	}
 
 	if foundUser == nil {
@@ -219,8 +156,8 @@ func (d *ProjectUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 			identifier = email
 		}
 		resp.Diagnostics.AddError(
-			"User Not Found",
-			fmt.Sprintf("User %s not found in project %s", identifier, projectID),
+			"Project User Not Found",
+			fmt.Sprintf("No user %s was found in project %s.", identifier, projectID),
 		)
 		return
 	}
@@ -395,8 +332,7 @@ func (d *ProjectUsersDataSource) Read(ctx context.Context, req datasource.ReadRe
 		httpRequest.Header.Set("Authorization", "Bearer "+adminKey)
 		httpRequest.Header.Set("Content-Type", "application/json")
 
-		httpClient := &http.Client{}
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
@@ -432,9 +368,9 @@ func (d *ProjectUsersDataSource) Read(ctx context.Context, req datasource.ReadRe
 			allUsers = append(allUsers, userModel)
 			userIDs = append(userIDs, u.ID)
 
-			if u.Role == "owner" {
+			if u.Role == roleOwner {
 				ownerIDs = append(ownerIDs, u.ID)
-			} else if u.Role == "member" {
+			} else if u.Role == roleMember {
 				memberIDs = append(memberIDs, u.ID)
 			}
 		}
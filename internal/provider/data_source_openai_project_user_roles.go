@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -144,7 +143,6 @@ func (d *ProjectUserRolesDataSource) Read(ctx context.Context, req datasource.Re
 	roleIDs := make([]string, 0)
 
 	cursor := ""
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 	for {
 		parsedURL, err := url.Parse(reqURL)
 		if err != nil {
@@ -169,7 +167,7 @@ func (d *ProjectUserRolesDataSource) Read(ctx context.Context, req datasource.Re
 			httpRequest.Header.Set("OpenAI-Organization", d.client.OpenAIClient.OrganizationID)
 		}
 
-		httpResp, err := httpClient.Do(httpRequest)
+		httpResp, err := d.client.Do(httpRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error executing request", err.Error())
 			return
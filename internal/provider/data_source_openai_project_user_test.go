@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectUserDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/projects/proj_1/users" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-admin-key" {
+			t.Errorf("Authorization = %q, want the admin key", r.Header.Get("Authorization"))
+		}
+		if r.URL.Query().Get("after") == "" {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object":   "list",
+				"data":     []map[string]interface{}{{"id": "user_a", "email": "a@example.com", "role": "owner", "added_at": 1}},
+				"last_id":  "user_a",
+				"has_more": true,
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object":   "list",
+			"data":     []map[string]interface{}{{"id": "user_b", "email": "Bob@Example.com", "role": "member", "added_at": 2}},
+			"last_id":  "user_b",
+			"has_more": false,
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &ProjectUserDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(attr, value string) (*datasource.ReadResponse, ProjectUserDataSourceModel) {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		vals["project_id"] = tftypes.NewValue(tftypes.String, "proj_1")
+		vals[attr] = tftypes.NewValue(tftypes.String, value)
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+		var data ProjectUserDataSourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		return resp, data
+	}
+
+	resp, data := read("email", "bob@example.com")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read by email: %v", resp.Diagnostics)
+	}
+	if data.UserID.ValueString() != "user_b" || data.Role.ValueString() != "member" || data.ID.ValueString() != "proj_1:user_b" {
+		t.Errorf("by email: user_id=%s role=%s id=%s, want user_b member proj_1:user_b", data.UserID, data.Role, data.ID)
+	}
+
+	if _, data := read("user_id", "user_a"); data.Email.ValueString() != "a@example.com" || data.AddedAt.ValueInt64() != 1 {
+		t.Errorf("by user_id: email=%s added_at=%d, want a@example.com 1", data.Email, data.AddedAt.ValueInt64())
+	}
+
+	resp, _ = read("email", "nobody@example.com")
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "nobody@example.com") {
+		t.Errorf("unknown email diagnostics = %v, want a not-found error naming the email", resp.Diagnostics)
+	}
+}
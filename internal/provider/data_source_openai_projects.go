@@ -2,15 +2,12 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"net/url"
-	"strconv"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -26,23 +23,19 @@ type ProjectsDataSource struct {
 
 type ProjectsDataSourceModel struct {
 	AdminKey types.String         `tfsdk:"admin_key"`
+	Status   types.String         `tfsdk:"status"`
 	Projects []ProjectResultModel `tfsdk:"projects"`
 	ID       types.String         `tfsdk:"id"` // Dummy ID
 }
 
 type ProjectResultModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.Int64  `tfsdk:"created_at"`
-}
-
-type ProjectsListResponseFramework struct {
-	Object  string                     `json:"object"`
-	Data    []ProjectResponseFramework `json:"data"`
-	FirstID string                     `json:"first_id"`
-	LastID  string                     `json:"last_id"`
-	HasMore bool                       `json:"has_more"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	ArchivedAt  types.Int64  `tfsdk:"archived_at"`
 }
 
 func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -62,6 +55,13 @@ func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"status": schema.StringAttribute{
+				Description: "Which projects to return: `active`, `archived` or `all`. Defaults to `active`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "archived", "all"),
+				},
+			},
 			"projects": schema.ListNestedAttribute{
 				Description: "List of available projects.",
 				Computed:    true,
@@ -75,14 +75,26 @@ func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 							Description: "The name of the project.",
 							Computed:    true,
 						},
+						"description": schema.StringAttribute{
+							Description: "The description of the project, if set.",
+							Computed:    true,
+						},
 						"status": schema.StringAttribute{
 							Description: "The status of the project.",
 							Computed:    true,
 						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether this is the organization's default project.",
+							Computed:    true,
+						},
 						"created_at": schema.Int64Attribute{
 							Description: "The Unix timestamp (in seconds) for when the project was created.",
 							Computed:    true,
 						},
+						"archived_at": schema.Int64Attribute{
+							Description: "The Unix timestamp (in seconds) for when the project was archived, if it has been.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -132,81 +144,36 @@ func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	var allProjects []ProjectResultModel
-	cursor := ""
-	limit := 100
-
-	for {
-		// Construct the API URL
-		apiURL := d.client.OpenAIClient.APIURL
-
-		// Ensure leading slash for construction if needed, but strings.TrimPrefix helps
-		// Actually best to form: base + path
-		var fullURL string
-		if strings.Contains(apiURL, "/v1") {
-			fullURL = fmt.Sprintf("%s/organization/projects", strings.TrimSuffix(apiURL, "/"))
-		} else {
-			fullURL = fmt.Sprintf("%s/v1/organization/projects", strings.TrimSuffix(apiURL, "/"))
-		}
+	adminClient := d.client.withAPIKey(apiKey, false)
 
-		// Add query params
-		parsedURL, err := url.Parse(fullURL)
-		if err != nil {
-			resp.Diagnostics.AddError("Error parsing URL", err.Error())
-			return
-		}
-		q := parsedURL.Query()
-		q.Set("limit", strconv.Itoa(limit))
-		if cursor != "" {
-			q.Set("after", cursor)
-		}
-		parsedURL.RawQuery = q.Encode()
-
-		httpRequest, err := http.NewRequest("GET", parsedURL.String(), nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Error creating request", err.Error())
-			return
-		}
-
-		httpRequest.Header.Set("Authorization", "Bearer "+apiKey)
-		httpRequest.Header.Set("Content-Type", "application/json")
-
-		httpClient := &http.Client{}
-		httpResp, err := httpClient.Do(httpRequest)
-		if err != nil {
-			resp.Diagnostics.AddError("Error executing request", err.Error())
-			return
-		}
-		defer httpResp.Body.Close()
-
-		if httpResp.StatusCode != 200 {
-			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Status: %s", httpResp.Status))
-			return
-		}
-
-		var listResp ProjectsListResponseFramework
-		if err := json.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
-			resp.Diagnostics.AddError("Error decoding response", err.Error())
-			return
-		}
+	status := "active"
+	if !data.Status.IsNull() {
+		status = data.Status.ValueString()
+	}
 
-		for _, p := range listResp.Data {
-			projectModel := ProjectResultModel{
-				ID:        types.StringValue(p.ID),
-				Name:      types.StringValue(p.Name),
-				Status:    types.StringValue(p.Status),
-				CreatedAt: types.Int64Value(p.CreatedAt),
-			}
-			allProjects = append(allProjects, projectModel)
-		}
+	// The API only distinguishes "active" from "active plus archived", so
+	// archived-only listings are filtered client-side.
+	projects, err := adminClient.ListAllProjects(status != "active")
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing projects", err.Error())
+		return
+	}
 
-		if !listResp.HasMore {
-			break
+	allProjects := make([]ProjectResultModel, 0, len(projects))
+	for _, p := range projects {
+		if status != "all" && p.Status != "" && p.Status != status {
+			continue
 		}
-		if listResp.LastID == "" {
-			break
+		projectModel := ProjectResultModel{
+			ID:          types.StringValue(p.ID),
+			Name:        types.StringValue(p.Name),
+			Description: types.StringPointerValue(p.Description),
+			Status:      types.StringValue(p.Status),
+			IsDefault:   types.BoolValue(p.IsDefault != nil && *p.IsDefault),
+			CreatedAt:   types.Int64PointerValue(p.CreatedAt),
+			ArchivedAt:  types.Int64PointerValue(p.ArchivedAt),
 		}
-		cursor = listResp.LastID
+		allProjects = append(allProjects, projectModel)
 	}
 
 	data.ID = types.StringValue("projects")
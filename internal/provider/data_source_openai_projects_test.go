@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIProjects_StatusFilter pages through a mock project
+// listing and checks that each status filter returns the expected subset.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIProjects_StatusFilter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockProjectsListHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpenAIProjectsConfig(srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_projects.active", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.openai_projects.active", "projects.0.is_default", "true"),
+					resource.TestCheckResourceAttr("data.openai_projects.active", "projects.1.description", "Second project"),
+					resource.TestCheckResourceAttr("data.openai_projects.archived", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.openai_projects.archived", "projects.0.id", "proj_3"),
+					resource.TestCheckResourceAttr("data.openai_projects.archived", "projects.0.archived_at", "1700000100"),
+					resource.TestCheckResourceAttr("data.openai_projects.all", "projects.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpenAIProjectsConfig(apiURL string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_projects" "active" {}
+
+data "openai_projects" "archived" {
+  status = "archived"
+}
+
+data "openai_projects" "all" {
+  status = "all"
+}
+`, apiURL)
+}
+
+// mockProjectsListHandler serves the project listing across two pages.
+// Archived projects are only returned when include_archived=true.
+func mockProjectsListHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/organization/projects" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	if r.URL.Query().Get("after") == "" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"object": "organization.project", "id": "proj_1", "name": "Default project", "status": "active", "is_default": true, "created_at": 1700000000},
+			},
+			"has_more": true,
+		})
+		return
+	}
+
+	data := []map[string]interface{}{
+		{"object": "organization.project", "id": "proj_2", "name": "second", "description": "Second project", "status": "active", "created_at": 1700000001},
+	}
+	if r.URL.Query().Get("include_archived") == "true" {
+		data = append(data, map[string]interface{}{
+			"object": "organization.project", "id": "proj_3", "name": "old", "status": "archived", "created_at": 1700000002, "archived_at": 1700000100,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": data, "has_more": false})
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RateLimitDataSource{}
+
+func NewRateLimitDataSource() datasource.DataSource {
+	return &RateLimitDataSource{}
+}
+
+type RateLimitDataSource struct {
+	providerClient *OpenAIClient
+}
+
+type RateLimitDataSourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	ProjectID                   types.String `tfsdk:"project_id"`
+	Model                       types.String `tfsdk:"model"`
+	APIKey                      types.String `tfsdk:"api_key"`
+	RateLimitID                 types.String `tfsdk:"rate_limit_id"`
+	MatchedModel                types.String `tfsdk:"matched_model"`
+	MaxRequestsPerMinute        types.Int64  `tfsdk:"max_requests_per_minute"`
+	MaxTokensPerMinute          types.Int64  `tfsdk:"max_tokens_per_minute"`
+	MaxImagesPerMinute          types.Int64  `tfsdk:"max_images_per_minute"`
+	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
+	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
+}
+
+func (d *RateLimitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit"
+}
+
+func (d *RateLimitDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read one rate limit of an OpenAI project. The limit is matched exactly as the `openai_rate_limit` resource and its import match it, so both always resolve the same limit. Requires an admin API key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source, `project_id:rate_limit_id`.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project the rate limit belongs to.",
+				Required:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "The model name (e.g. `gpt-4o-mini`) or rate limit ID (e.g. `rl-gpt-4o-mini`) to look up. Model names must match exactly, so `gpt-4o` never resolves to `gpt-4o-mini`.",
+				Required:    true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: rateLimitAPIKeyDescription,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"rate_limit_id": schema.StringAttribute{
+				Description: "The API ID of the matched rate limit.",
+				Computed:    true,
+			},
+			"matched_model": schema.StringAttribute{
+				Description: "The model of the matched rate limit.",
+				Computed:    true,
+			},
+			"max_requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of requests per minute.",
+				Computed:    true,
+			},
+			"max_tokens_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of tokens per minute.",
+				Computed:    true,
+			},
+			"max_images_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of images per minute.",
+				Computed:    true,
+			},
+			"batch_1_day_max_input_tokens": schema.Int64Attribute{
+				Description: "Maximum number of input tokens per day for batch processing.",
+				Computed:    true,
+			},
+			"max_audio_megabytes_per_1_minute": schema.Int64Attribute{
+				Description: "Maximum audio megabytes per minute.",
+				Computed:    true,
+			},
+			"max_requests_per_1_day": schema.Int64Attribute{
+				Description: "Maximum number of requests per day.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *RateLimitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.providerClient = providerClient
+}
+
+func (d *RateLimitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RateLimitDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := rateLimitClient(d.providerClient, data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	// GetRateLimit is what the resource's Read and ImportState use, so the
+	// data source cannot match a different limit for the same name.
+	projectID := data.ProjectID.ValueString()
+	rl, err := cl.GetRateLimit(projectID, data.Model.ValueString())
+	if err != nil {
+		addRateLimitError(&resp.Diagnostics, "Error reading rate limit", err)
+		return
+	}
+
+	data.ID = types.StringValue(projectID + ":" + rl.ID)
+	data.RateLimitID = types.StringValue(rl.ID)
+	data.MatchedModel = types.StringValue(rl.Model)
+	data.MaxRequestsPerMinute = types.Int64Value(int64(rl.MaxRequestsPer1Minute))
+	data.MaxTokensPerMinute = types.Int64Value(int64(rl.MaxTokensPer1Minute))
+	data.MaxImagesPerMinute = int64FromIntPtr(rl.MaxImagesPer1Minute)
+	data.Batch1DayMaxInputTokens = int64FromIntPtr(rl.Batch1DayMaxInputTokens)
+	data.MaxAudioMegabytesPer1Minute = int64FromIntPtr(rl.MaxAudioMegabytesPer1Minute)
+	data.MaxRequestsPer1Day = int64FromIntPtr(rl.MaxRequestsPer1Day)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ datasource.DataSource = &RateLimitDefaultsDataSource{}
+
+func NewRateLimitDefaultsDataSource() datasource.DataSource {
+	return &RateLimitDefaultsDataSource{}
+}
+
+// RateLimitDefaultsDataSource exposes the provider's built-in default rate
+// limits. The data is static, so it needs no client.
+type RateLimitDefaultsDataSource struct{}
+
+type RateLimitDefaultsDataSourceModel struct {
+	ID                          types.String            `tfsdk:"id"`
+	Model                       types.String            `tfsdk:"model"`
+	MatchedModel                types.String            `tfsdk:"matched_model"`
+	MaxRequestsPerMinute        types.Int64             `tfsdk:"max_requests_per_minute"`
+	MaxTokensPerMinute          types.Int64             `tfsdk:"max_tokens_per_minute"`
+	MaxImagesPerMinute          types.Int64             `tfsdk:"max_images_per_minute"`
+	Batch1DayMaxInputTokens     types.Int64             `tfsdk:"batch_1_day_max_input_tokens"`
+	MaxAudioMegabytesPer1Minute types.Int64             `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64             `tfsdk:"max_requests_per_1_day"`
+	Models                      []RateLimitDefaultModel `tfsdk:"models"`
+}
+
+type RateLimitDefaultModel struct {
+	Model                       types.String `tfsdk:"model"`
+	MaxRequestsPerMinute        types.Int64  `tfsdk:"max_requests_per_minute"`
+	MaxTokensPerMinute          types.Int64  `tfsdk:"max_tokens_per_minute"`
+	MaxImagesPerMinute          types.Int64  `tfsdk:"max_images_per_minute"`
+	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
+	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
+}
+
+func (d *RateLimitDefaultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit_defaults"
+}
+
+func (d *RateLimitDefaultsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	limitAttributes := func(prefix string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"max_requests_per_minute": schema.Int64Attribute{
+				Description: prefix + "maximum number of requests per minute.",
+				Computed:    true,
+			},
+			"max_tokens_per_minute": schema.Int64Attribute{
+				Description: prefix + "maximum number of tokens per minute.",
+				Computed:    true,
+			},
+			"max_images_per_minute": schema.Int64Attribute{
+				Description: prefix + "maximum number of images per minute. Null if it does not apply.",
+				Computed:    true,
+			},
+			"batch_1_day_max_input_tokens": schema.Int64Attribute{
+				Description: prefix + "maximum number of input tokens per day for batch processing. Null if it does not apply.",
+				Computed:    true,
+			},
+			"max_audio_megabytes_per_1_minute": schema.Int64Attribute{
+				Description: prefix + "maximum audio megabytes per minute. Null if it does not apply.",
+				Computed:    true,
+			},
+			"max_requests_per_1_day": schema.Int64Attribute{
+				Description: prefix + "maximum number of requests per day. Null if it does not apply.",
+				Computed:    true,
+			},
+		}
+	}
+
+	attributes := limitAttributes("The default ")
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of this resource.",
+		Computed:    true,
+	}
+	attributes["model"] = schema.StringAttribute{
+		Description: "The model to return the default limits for. Models without their own entry get the `default` entry with a warning. When unset, every entry is listed in `models` instead.",
+		Optional:    true,
+	}
+	attributes["matched_model"] = schema.StringAttribute{
+		Description: "The entry the limits were taken from: `model` itself, or `default`.",
+		Computed:    true,
+	}
+
+	modelAttributes := limitAttributes("The default ")
+	modelAttributes["model"] = schema.StringAttribute{
+		Description: "The model of the entry, or `default` for the fallback entry.",
+		Computed:    true,
+	}
+	attributes["models"] = schema.ListNestedAttribute{
+		Description:  "Every default entry, sorted by model, when `model` is unset.",
+		Computed:     true,
+		NestedObject: schema.NestedAttributeObject{Attributes: modelAttributes},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read the default rate limits the provider assumes for each model, for example to choose `openai_rate_limit` overrides. The values are built into the provider, so no API call is made and no key is needed.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *RateLimitDefaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RateLimitDefaultsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.MatchedModel = types.StringNull()
+	data.MaxRequestsPerMinute = types.Int64Null()
+	data.MaxTokensPerMinute = types.Int64Null()
+	data.MaxImagesPerMinute = types.Int64Null()
+	data.Batch1DayMaxInputTokens = types.Int64Null()
+	data.MaxAudioMegabytesPer1Minute = types.Int64Null()
+	data.MaxRequestsPer1Day = types.Int64Null()
+
+	if data.Model.IsNull() {
+		for _, rl := range client.DefaultRateLimits() {
+			data.Models = append(data.Models, RateLimitDefaultModel{
+				Model:                       types.StringValue(rl.Model),
+				MaxRequestsPerMinute:        types.Int64Value(int64(rl.MaxRequestsPer1Minute)),
+				MaxTokensPerMinute:          types.Int64Value(int64(rl.MaxTokensPer1Minute)),
+				MaxImagesPerMinute:          int64FromIntPtr(rl.MaxImagesPer1Minute),
+				Batch1DayMaxInputTokens:     int64FromIntPtr(rl.Batch1DayMaxInputTokens),
+				MaxAudioMegabytesPer1Minute: int64FromIntPtr(rl.MaxAudioMegabytesPer1Minute),
+				MaxRequestsPer1Day:          int64FromIntPtr(rl.MaxRequestsPer1Day),
+			})
+		}
+		data.ID = types.StringValue("rate_limit_defaults")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	model := data.Model.ValueString()
+	rl, known := client.DefaultRateLimit(model)
+	data.MatchedModel = types.StringValue(model)
+	if !known {
+		data.MatchedModel = types.StringValue("default")
+		resp.Diagnostics.AddAttributeWarning(path.Root("model"), "Unknown model",
+			fmt.Sprintf("The provider has no default rate limits for %q, so the `default` entry is returned. Check the model name, or read the project's actual limits with the openai_rate_limit data source.", model))
+	}
+	data.MaxRequestsPerMinute = types.Int64Value(int64(rl.MaxRequestsPer1Minute))
+	data.MaxTokensPerMinute = types.Int64Value(int64(rl.MaxTokensPer1Minute))
+	data.MaxImagesPerMinute = int64FromIntPtr(rl.MaxImagesPer1Minute)
+	data.Batch1DayMaxInputTokens = int64FromIntPtr(rl.Batch1DayMaxInputTokens)
+	data.MaxAudioMegabytesPer1Minute = int64FromIntPtr(rl.MaxAudioMegabytesPer1Minute)
+	data.MaxRequestsPer1Day = int64FromIntPtr(rl.MaxRequestsPer1Day)
+	data.ID = types.StringValue(model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func readRateLimitDefaults(t *testing.T, model interface{}) (RateLimitDefaultsDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &RateLimitDefaultsDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["model"] = tftypes.NewValue(tftypes.String, model)
+	config := tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	var data RateLimitDefaultsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	return data, resp
+}
+
+func TestRateLimitDefaultsDataSource_KnownModel(t *testing.T) {
+	data, resp := readRateLimitDefaults(t, "gpt-4o-mini")
+
+	if resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("unexpected warnings: %v", resp.Diagnostics)
+	}
+	if data.MatchedModel.ValueString() != "gpt-4o-mini" || data.MaxRequestsPerMinute.ValueInt64() != 10000 ||
+		data.Batch1DayMaxInputTokens.ValueInt64() != 1000000000 || !data.MaxRequestsPer1Day.IsNull() {
+		t.Errorf("unexpected limits: %+v", data)
+	}
+	if data.Models != nil {
+		t.Errorf("models should be null for a single model, got %d entries", len(data.Models))
+	}
+}
+
+func TestRateLimitDefaultsDataSource_UnknownModelWarns(t *testing.T) {
+	data, resp := readRateLimitDefaults(t, "gpt-unknown")
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("warnings = %d, want 1", resp.Diagnostics.WarningsCount())
+	}
+	if data.MatchedModel.ValueString() != "default" || data.MaxRequestsPerMinute.ValueInt64() != 3000 {
+		t.Errorf("unknown model should get the default entry, got matched_model=%s rpm=%s", data.MatchedModel, data.MaxRequestsPerMinute)
+	}
+}
+
+func TestRateLimitDefaultsDataSource_ListsAllEntries(t *testing.T) {
+	data, _ := readRateLimitDefaults(t, nil)
+
+	if !data.MatchedModel.IsNull() || !data.MaxRequestsPerMinute.IsNull() {
+		t.Errorf("single model attributes should be null in list mode, got matched_model=%s", data.MatchedModel)
+	}
+	seen := map[string]bool{}
+	for i, m := range data.Models {
+		if i > 0 && data.Models[i-1].Model.ValueString() >= m.Model.ValueString() {
+			t.Errorf("models not sorted at %s", m.Model)
+		}
+		seen[m.Model.ValueString()] = true
+	}
+	for _, want := range []string{"default", "gpt-4o-mini", "dall-e-3"} {
+		if !seen[want] {
+			t.Errorf("%s missing from models", want)
+		}
+	}
+}
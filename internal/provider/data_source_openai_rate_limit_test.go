@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestRateLimitDataSource_MatchesResource asserts that the data source and
+// the resource's import resolve the same limit for compound model names
+// that share a prefix with other models in the project.
+func TestRateLimitDataSource_MatchesResource(t *testing.T) {
+	srv := newMockRateLimitServer(t)
+	defer srv.Close()
+	for id, model := range map[string]string{
+		"rl-gpt-4o-mini":            "gpt-4o-mini",
+		"rl-gpt-4o-mini-2024-07-18": "gpt-4o-mini-2024-07-18",
+	} {
+		srv.limits[id] = map[string]interface{}{
+			"object":                    "project.rate_limit",
+			"id":                        id,
+			"model":                     model,
+			"max_requests_per_1_minute": len(model),
+			"max_tokens_per_1_minute":   1000,
+		}
+	}
+
+	ctx := context.Background()
+	providerClient := newTestOpenAIClient(srv.URL)
+
+	d := &RateLimitDataSource{providerClient: providerClient}
+	dsSchemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, dsSchemaResp)
+	dsSchema := dsSchemaResp.Schema
+	dsType := dsSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	readDataSource := func(model string) RateLimitDataSourceModel {
+		t.Helper()
+		vals := map[string]tftypes.Value{}
+		for name, typ := range dsType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		vals["project_id"] = tftypes.NewValue(tftypes.String, "proj_abc12345")
+		vals["model"] = tftypes.NewValue(tftypes.String, model)
+		config := tfsdk.Config{Schema: dsSchema, Raw: tftypes.NewValue(dsType, vals)}
+
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: dsSchema, Raw: tftypes.NewValue(dsType, nil)}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("data source read %s: %v", model, resp.Diagnostics)
+		}
+		var data RateLimitDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		return data
+	}
+
+	r := &RateLimitResource{providerClient: providerClient}
+	rSchemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, rSchemaResp)
+	rSchema := rSchemaResp.Schema
+	rType := rSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	importResource := func(model string) RateLimitResourceModel {
+		t.Helper()
+		resp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: rSchema, Raw: tftypes.NewValue(rType, nil)}}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: "proj_abc12345:" + model}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("import %s: %v", model, resp.Diagnostics)
+		}
+		var data RateLimitResourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		return data
+	}
+
+	for model, want := range map[string]string{
+		"gpt-4o":                    "gpt-4o",
+		"gpt-4o-mini":               "gpt-4o-mini",
+		"rl-gpt-4o-mini":            "gpt-4o-mini",
+		"rl-gpt-4o-mini-abc12345":   "gpt-4o-mini",
+		"gpt-4o-mini-2024-07-18":    "gpt-4o-mini-2024-07-18",
+		"rl-gpt-4o-mini-2024-07-18": "gpt-4o-mini-2024-07-18",
+	} {
+		ds := readDataSource(model)
+		res := importResource(model)
+		if ds.MatchedModel.ValueString() != want || res.Model.ValueString() != want {
+			t.Errorf("%s: data source matched %s, resource matched %s, want %s", model, ds.MatchedModel, res.Model, want)
+		}
+		if ds.MaxRequestsPerMinute.ValueInt64() != res.MaxRequestsPerMinute.ValueInt64() {
+			t.Errorf("%s: data source rpm %d, resource rpm %d", model, ds.MaxRequestsPerMinute.ValueInt64(), res.MaxRequestsPerMinute.ValueInt64())
+		}
+	}
+}
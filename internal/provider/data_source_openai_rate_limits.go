@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ datasource.DataSource = &RateLimitsDataSource{}
+
+func NewRateLimitsDataSource() datasource.DataSource {
+	return &RateLimitsDataSource{}
+}
+
+type RateLimitsDataSource struct {
+	providerClient *OpenAIClient
+}
+
+type RateLimitsDataSourceModel struct {
+	ID              types.String           `tfsdk:"id"`
+	ProjectID       types.String           `tfsdk:"project_id"`
+	IncludeDefaults types.Bool             `tfsdk:"include_defaults"`
+	RateLimits      []RateLimitResultModel `tfsdk:"rate_limits"`
+	APIKey          types.String           `tfsdk:"api_key"`
+}
+
+type RateLimitResultModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Model                       types.String `tfsdk:"model"`
+	IsDefault                   types.Bool   `tfsdk:"is_default"`
+	MaxRequestsPerMinute        types.Int64  `tfsdk:"max_requests_per_minute"`
+	MaxTokensPerMinute          types.Int64  `tfsdk:"max_tokens_per_minute"`
+	MaxImagesPerMinute          types.Int64  `tfsdk:"max_images_per_minute"`
+	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
+	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
+}
+
+func (d *RateLimitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limits"
+}
+
+func (d *RateLimitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the rate limits of an OpenAI project. Requires an admin API key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project to list rate limits for.",
+				Required:    true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: rateLimitAPIKeyDescription,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"include_defaults": schema.BoolAttribute{
+				Description: "Also return the default limits for every known model the project has no explicit limit for, so the output shows effective limits for all models. Defaults to false.",
+				Optional:    true,
+			},
+			"rate_limits": schema.ListNestedAttribute{
+				Description: "The rate limits, sorted by model when `include_defaults` is set.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the rate limit. Empty for default entries.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "The model the rate limit applies to.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether this entry was filled in from the provider's default limits rather than returned by the API.",
+							Computed:    true,
+						},
+						"max_requests_per_minute": schema.Int64Attribute{
+							Description: "Maximum number of requests per minute.",
+							Computed:    true,
+						},
+						"max_tokens_per_minute": schema.Int64Attribute{
+							Description: "Maximum number of tokens per minute.",
+							Computed:    true,
+						},
+						"max_images_per_minute": schema.Int64Attribute{
+							Description: "Maximum number of images per minute.",
+							Computed:    true,
+						},
+						"batch_1_day_max_input_tokens": schema.Int64Attribute{
+							Description: "Maximum number of input tokens per day for batch processing.",
+							Computed:    true,
+						},
+						"max_audio_megabytes_per_1_minute": schema.Int64Attribute{
+							Description: "Maximum audio megabytes per minute.",
+							Computed:    true,
+						},
+						"max_requests_per_1_day": schema.Int64Attribute{
+							Description: "Maximum number of requests per day.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RateLimitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.providerClient = providerClient
+}
+
+func (d *RateLimitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RateLimitsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := rateLimitClient(d.providerClient, data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	rateLimits, err := cl.ListAllRateLimits(projectID)
+	if err != nil {
+		addRateLimitError(&resp.Diagnostics, "Error listing rate limits", err)
+		return
+	}
+
+	data.RateLimits = rateLimitResultModels(rateLimits, data.IncludeDefaults.ValueBool())
+	data.ID = types.StringValue(projectID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// rateLimitResultModels converts API rate limits to data source models,
+// merging in the default limits for unlisted models when includeDefaults is set.
+func rateLimitResultModels(rateLimits []client.RateLimit, includeDefaults bool) []RateLimitResultModel {
+	if includeDefaults {
+		rateLimits = client.MergeDefaultRateLimits(rateLimits)
+	}
+
+	results := make([]RateLimitResultModel, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		results = append(results, RateLimitResultModel{
+			ID:                          types.StringValue(rl.ID),
+			Model:                       types.StringValue(rl.Model),
+			IsDefault:                   types.BoolValue(rl.ID == ""),
+			MaxRequestsPerMinute:        types.Int64Value(int64(rl.MaxRequestsPer1Minute)),
+			MaxTokensPerMinute:          types.Int64Value(int64(rl.MaxTokensPer1Minute)),
+			MaxImagesPerMinute:          int64FromIntPtr(rl.MaxImagesPer1Minute),
+			Batch1DayMaxInputTokens:     int64FromIntPtr(rl.Batch1DayMaxInputTokens),
+			MaxAudioMegabytesPer1Minute: int64FromIntPtr(rl.MaxAudioMegabytesPer1Minute),
+			MaxRequestsPer1Day:          int64FromIntPtr(rl.MaxRequestsPer1Day),
+		})
+	}
+	return results
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+func TestRateLimitResultModels_IncludeDefaults(t *testing.T) {
+	images := 10
+	explicit := []client.RateLimit{{
+		ID:                    "rl-gpt-4o",
+		Model:                 "gpt-4o",
+		MaxRequestsPer1Minute: 500,
+		MaxTokensPer1Minute:   30000,
+		MaxImagesPer1Minute:   &images,
+	}}
+
+	if got := rateLimitResultModels(explicit, false); len(got) != 1 {
+		t.Fatalf("without include_defaults: expected 1 rate limit, got %d", len(got))
+	}
+
+	got := rateLimitResultModels(explicit, true)
+	if want := len(client.MergeDefaultRateLimits(nil)); len(got) != want {
+		t.Fatalf("expected %d rate limits (one per known model), got %d", want, len(got))
+	}
+
+	byModel := make(map[string]RateLimitResultModel, len(got))
+	for i, rl := range got {
+		model := rl.Model.ValueString()
+		if _, dup := byModel[model]; dup {
+			t.Errorf("model %q listed twice", model)
+		}
+		if i > 0 && got[i-1].Model.ValueString() > model {
+			t.Errorf("results not sorted by model: %q before %q", got[i-1].Model.ValueString(), model)
+		}
+		byModel[model] = rl
+	}
+
+	if _, ok := byModel["default"]; ok {
+		t.Error("the fallback \"default\" entry must not be listed as a model")
+	}
+
+	gpt4o := byModel["gpt-4o"]
+	if gpt4o.IsDefault.ValueBool() || gpt4o.ID.ValueString() != "rl-gpt-4o" {
+		t.Errorf("gpt-4o should keep its explicit limit, got id=%s is_default=%s", gpt4o.ID, gpt4o.IsDefault)
+	}
+	if gpt4o.MaxRequestsPerMinute.ValueInt64() != 500 || gpt4o.MaxTokensPerMinute.ValueInt64() != 30000 {
+		t.Errorf("gpt-4o explicit values were overwritten: %s rpm, %s tpm", gpt4o.MaxRequestsPerMinute, gpt4o.MaxTokensPerMinute)
+	}
+
+	mini, ok := byModel["gpt-4o-mini"]
+	if !ok {
+		t.Fatal("expected a default entry for gpt-4o-mini")
+	}
+	if !mini.IsDefault.ValueBool() || mini.ID.ValueString() != "" {
+		t.Errorf("gpt-4o-mini should be a default entry, got id=%s is_default=%s", mini.ID, mini.IsDefault)
+	}
+	if mini.MaxRequestsPerMinute.ValueInt64() <= 0 || mini.MaxTokensPerMinute.ValueInt64() <= 0 {
+		t.Errorf("gpt-4o-mini default limits should be positive, got %s rpm, %s tpm", mini.MaxRequestsPerMinute, mini.MaxTokensPerMinute)
+	}
+}
+
+// readRateLimits runs the rate limits data source's Read for proj_1 with the
+// given provider keys and api_key override (null when empty), returning the
+// response and the Authorization headers the server saw.
+func readRateLimits(t *testing.T, projectKey, adminKey, override string) (*datasource.ReadResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var auths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object":   "list",
+			"data":     []map[string]interface{}{{"id": "rl-gpt-4o", "model": "gpt-4o", "max_requests_per_1_minute": 500, "max_tokens_per_1_minute": 30000}},
+			"has_more": false,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	providerClient := &OpenAIClient{
+		OpenAIClient: client.NewClient(projectKey, "", server.URL+"/v1"),
+		AdminAPIKey:  adminKey,
+	}
+	d := &RateLimitsDataSource{providerClient: providerClient}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["project_id"] = tftypes.NewValue(tftypes.String, "proj_1")
+	if override != "" {
+		vals["api_key"] = tftypes.NewValue(tftypes.String, override)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	return resp, auths
+}
+
+func TestRateLimitsDataSource_UsesProviderAdminKey(t *testing.T) {
+	resp, auths := readRateLimits(t, "sk-proj-project", "sk-admin-provider", "")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if len(auths) != 1 || auths[0] != "Bearer sk-admin-provider" {
+		t.Errorf("Authorization = %v, want the provider admin key", auths)
+	}
+}
+
+func TestRateLimitsDataSource_APIKeyOverride(t *testing.T) {
+	resp, auths := readRateLimits(t, "sk-proj-project", "sk-admin-provider", "sk-admin-override")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if len(auths) != 1 || auths[0] != "Bearer sk-admin-override" {
+		t.Errorf("Authorization = %v, want the api_key override", auths)
+	}
+}
+
+func TestRateLimitsDataSource_ProjectKeyScopeMismatch(t *testing.T) {
+	// With no admin key the provider's project key is used, which can never
+	// manage rate limits, so the read fails without calling the API.
+	resp, auths := readRateLimits(t, "sk-proj-project", "", "")
+	if len(auths) != 0 {
+		t.Errorf("server received %d requests, want none", len(auths))
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a scope error")
+	}
+	d := resp.Diagnostics.Errors()[0]
+	if d.Summary() != "Wrong API key scope for rate limits" || !strings.Contains(d.Detail(), "requires an admin API key") {
+		t.Errorf("diagnostic = %q: %q", d.Summary(), d.Detail())
+	}
+	if withPath, ok := d.(interface{ Path() path.Path }); !ok || !withPath.Path().Equal(path.Root("api_key")) {
+		t.Errorf("diagnostic is not attached to api_key")
+	}
+
+	// An override with the wrong scope is rejected the same way.
+	resp, auths = readRateLimits(t, "sk-proj-project", "sk-admin-provider", "sk-svcacct-override")
+	if len(auths) != 0 || !resp.Diagnostics.HasError() {
+		t.Errorf("service account override: %d requests, diagnostics %v; want a scope error and no requests", len(auths), resp.Diagnostics)
+	}
+}
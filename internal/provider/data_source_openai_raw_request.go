@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RawRequestDataSource{}
+
+func NewRawRequestDataSource() datasource.DataSource {
+	return &RawRequestDataSource{}
+}
+
+type RawRequestDataSource struct {
+	providerClient *OpenAIClient
+}
+
+type RawRequestDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Method           types.String `tfsdk:"method"`
+	Path             types.String `tfsdk:"path"`
+	Body             types.String `tfsdk:"body"`
+	ContentType      types.String `tfsdk:"content_type"`
+	UseAdminAuth     types.Bool   `tfsdk:"use_admin_auth"`
+	AllowSideEffects types.Bool   `tfsdk:"allow_side_effects"`
+	ResponseBody     types.String `tfsdk:"response_body"`
+}
+
+func (d *RawRequestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_raw_request"
+}
+
+func (d *RawRequestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Escape hatch that sends an authenticated request to an OpenAI endpoint the provider does not model yet and returns the raw response. " +
+			"Prefer a first-class resource or data source where one exists: responses are not validated or typed, and the request is sent on every plan and refresh. " +
+			"`POST` requests may create objects or incur cost each time, so they must be enabled with `allow_side_effects`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, `<method> <path>`.",
+				Computed:            true,
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method: `GET` or `POST`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodGet, http.MethodPost)},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The endpoint path relative to the provider's API URL, such as `models/gpt-4o` or `organization/projects?limit=5`. A leading `/v1/` is dropped. Absolute URLs are rejected so the API key is never sent to another host.",
+				Required:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The request body, sent unmodified. Must be valid JSON when `content_type` is JSON, for example from `jsonencode()`. Only allowed with `POST`.",
+				Optional:            true,
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "The `Content-Type` of `body`. Defaults to `application/json`.",
+				Optional:            true,
+			},
+			"use_admin_auth": schema.BoolAttribute{
+				MarkdownDescription: "Send the request with the provider's admin key, for `organization/...` endpoints. Defaults to false.",
+				Optional:            true,
+			},
+			"allow_side_effects": schema.BoolAttribute{
+				MarkdownDescription: "Must be true to send a `POST`. Data sources are read on every plan, so the request is repeated each time.",
+				Optional:            true,
+			},
+			"response_body": schema.StringAttribute{
+				MarkdownDescription: "The raw response body, usually JSON to decode with `jsondecode()`. Truncated to the provider's `max_response_bytes`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RawRequestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.providerClient = providerClient
+}
+
+func (d *RawRequestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RawRequestDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method := data.Method.ValueString()
+	reqPath := data.Path.ValueString()
+	contentType := "application/json"
+	if v := data.ContentType.ValueString(); v != "" {
+		contentType = v
+	}
+
+	if u, err := url.Parse(reqPath); err != nil || u.IsAbs() || u.Host != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid path",
+			fmt.Sprintf("%q must be a path relative to the provider's API URL, such as \"models/gpt-4o\".", reqPath))
+		return
+	}
+	if method == http.MethodPost && !data.AllowSideEffects.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("allow_side_effects"), "POST requests are not allowed",
+			"A POST is sent on every plan and refresh and may create objects or incur cost. Set allow_side_effects = true to send it anyway.")
+		return
+	}
+
+	var body []byte
+	if !data.Body.IsNull() {
+		if method != http.MethodPost {
+			resp.Diagnostics.AddAttributeError(path.Root("body"), "Body not allowed", fmt.Sprintf("A body can only be sent with POST, not %s.", method))
+			return
+		}
+		body = []byte(data.Body.ValueString())
+		if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "application/json" && !json.Valid(body) {
+			resp.Diagnostics.AddAttributeError(path.Root("body"), "Invalid JSON body", "body must be valid JSON when content_type is application/json. Build it with jsonencode().")
+			return
+		}
+	}
+
+	cl := d.providerClient.OpenAIClient
+	if data.UseAdminAuth.ValueBool() {
+		var err error
+		cl, err = GetOpenAIClientWithAdminKey(d.providerClient)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+			return
+		}
+	}
+
+	respBody, err := cl.DoRawRequestContext(ctx, method, reqPath, contentType, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error sending raw request", fmt.Sprintf("%s %s: %s", method, reqPath, err))
+		return
+	}
+
+	data.ID = types.StringValue(method + " " + reqPath)
+	data.ResponseBody = stateString(cl, path.Root("response_body"), string(respBody), &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func readRawRequest(t *testing.T, apiURL string, set map[string]tftypes.Value) (RawRequestDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &RawRequestDataSource{providerClient: newTestOpenAIClient(apiURL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, v := range set {
+		vals[name] = v
+	}
+	config := tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	var data RawRequestDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	}
+	return data, resp
+}
+
+func TestRawRequestDataSource_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/models/gpt-4o" || r.URL.RawQuery != "verbose=true" {
+			t.Errorf("unexpected request %s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-api-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "gpt-4o", "object": "model"})
+	}))
+	defer server.Close()
+
+	data, resp := readRawRequest(t, server.URL, map[string]tftypes.Value{
+		"method": tftypes.NewValue(tftypes.String, "GET"),
+		"path":   tftypes.NewValue(tftypes.String, "/v1/models/gpt-4o?verbose=true"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	if got := strings.TrimSpace(data.ResponseBody.ValueString()); got != `{"id":"gpt-4o","object":"model"}` {
+		t.Errorf("response_body = %s", got)
+	}
+}
+
+func TestRawRequestDataSource_PostSendsBodyUnmodified(t *testing.T) {
+	body := `{"input":"hello","model":"omni-moderation-latest"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/moderations" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json; charset=utf-8" {
+			t.Errorf("Content-Type = %q", got)
+		}
+		if got, _ := io.ReadAll(r.Body); string(got) != body {
+			t.Errorf("body = %s, want %s", got, body)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "modr-1"})
+	}))
+	defer server.Close()
+
+	set := map[string]tftypes.Value{
+		"method":       tftypes.NewValue(tftypes.String, "POST"),
+		"path":         tftypes.NewValue(tftypes.String, "moderations"),
+		"body":         tftypes.NewValue(tftypes.String, body),
+		"content_type": tftypes.NewValue(tftypes.String, "application/json; charset=utf-8"),
+	}
+
+	// Without allow_side_effects the POST is refused before it is sent.
+	if _, resp := readRawRequest(t, server.URL, set); !resp.Diagnostics.HasError() {
+		t.Fatal("expected POST without allow_side_effects to fail")
+	}
+
+	set["allow_side_effects"] = tftypes.NewValue(tftypes.Bool, true)
+	data, resp := readRawRequest(t, server.URL, set)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	if !strings.Contains(data.ResponseBody.ValueString(), "modr-1") {
+		t.Errorf("response_body = %s", data.ResponseBody)
+	}
+	if data.ID.ValueString() != "POST moderations" {
+		t.Errorf("id = %s", data.ID)
+	}
+}
+
+func TestRawRequestDataSource_RejectsAbsoluteURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("no request should be sent, got %s %s", r.Method, r.URL)
+	}))
+	defer server.Close()
+
+	for _, p := range []string{server.URL + "/v1/models", "//example.com/v1/models"} {
+		if _, resp := readRawRequest(t, server.URL, map[string]tftypes.Value{
+			"method": tftypes.NewValue(tftypes.String, "GET"),
+			"path":   tftypes.NewValue(tftypes.String, p),
+		}); !resp.Diagnostics.HasError() {
+			t.Errorf("path %q should be rejected", p)
+		}
+	}
+}
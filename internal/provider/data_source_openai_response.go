@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ResponseDataSource{}
+
+func NewResponseDataSource() datasource.DataSource {
+	return &ResponseDataSource{}
+}
+
+// ResponseDataSource reads a stored model response by ID, for referencing a
+// response generated elsewhere without managing it.
+type ResponseDataSource struct {
+	client *OpenAIClient
+}
+
+type ResponseDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ResponseID types.String `tfsdk:"response_id"`
+	Model      types.String `tfsdk:"model"`
+	Status     types.String `tfsdk:"status"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+	Output     types.List   `tfsdk:"output"`
+	Content    types.String `tfsdk:"content"`
+	Usage      types.Object `tfsdk:"usage"`
+}
+
+// responseDataSourceOutputAttrTypes describes an item of the data source's
+// output attribute.
+var responseDataSourceOutputAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"role":    types.StringType,
+	"content": types.StringType,
+}
+
+func (d *ResponseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_response"
+}
+
+func (d *ResponseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a stored model response by ID. Only responses created with `store = true`, the default, can be retrieved.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the response.",
+				Computed:            true,
+			},
+			"response_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the response to retrieve, e.g. `resp_abc123`.",
+				Required:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model that generated the response.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the response: `completed`, `failed`, `in_progress`, `cancelled`, `queued` or `incomplete`.",
+				Computed:            true,
+			},
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp (in seconds) of when the response was created.",
+				Computed:            true,
+			},
+			"output": schema.ListNestedAttribute{
+				MarkdownDescription: "The output items generated by the model.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the output item, e.g. `message`.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of a message output item, usually `assistant`. Empty for other item types.",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The text content of the output item.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The text of all output items, concatenated.",
+				Computed:            true,
+			},
+			"usage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Token usage of the response.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"input_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Tokens in the input.",
+						Computed:            true,
+					},
+					"output_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Tokens in the output.",
+						Computed:            true,
+					},
+					"total_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Total tokens used.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ResponseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ResponseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ResponseDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respData, err := d.client.RetrieveResponse(data.ResponseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving response", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(respData.ID)
+	data.Model = types.StringValue(respData.Model)
+	data.Status = types.StringValue(respData.Status)
+	data.CreatedAt = types.Int64Value(respData.CreatedAt)
+
+	outputs := make([]attr.Value, 0, len(respData.Output))
+	var content string
+	for _, item := range respData.Output {
+		text := responseOutputText(item)
+		content += text
+		obj, diags := types.ObjectValue(responseDataSourceOutputAttrTypes, map[string]attr.Value{
+			"type":    types.StringValue(item.Type),
+			"role":    types.StringValue(item.Role),
+			"content": types.StringValue(text),
+		})
+		resp.Diagnostics.Append(diags...)
+		outputs = append(outputs, obj)
+	}
+	output, diags := types.ListValue(types.ObjectType{AttrTypes: responseDataSourceOutputAttrTypes}, outputs)
+	resp.Diagnostics.Append(diags...)
+	data.Output = output
+	data.Content = types.StringValue(content)
+
+	usage, diags := responseUsageValue(respData.Usage)
+	resp.Diagnostics.Append(diags...)
+	data.Usage = usage
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
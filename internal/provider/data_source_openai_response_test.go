@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResponseDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/responses/resp_abc" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":         "resp_abc",
+			"object":     "response",
+			"created_at": 1700000000,
+			"model":      "gpt-4o-2024-08-06",
+			"status":     "completed",
+			"output": []interface{}{
+				map[string]interface{}{
+					"type": "message",
+					"role": "assistant",
+					"content": []interface{}{
+						map[string]interface{}{"type": "output_text", "text": "Hello"},
+						map[string]interface{}{"type": "output_text", "text": ", world"},
+					},
+				},
+			},
+			"usage": map[string]interface{}{"input_tokens": 5, "output_tokens": 3, "total_tokens": 8},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &ResponseDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["response_id"] = tftypes.NewValue(tftypes.String, "resp_abc")
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	var data ResponseDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.ID.ValueString() != "resp_abc" || data.Model.ValueString() != "gpt-4o-2024-08-06" || data.Status.ValueString() != "completed" {
+		t.Errorf("id/model/status = %s/%s/%s", data.ID, data.Model, data.Status)
+	}
+	if data.CreatedAt.ValueInt64() != 1700000000 {
+		t.Errorf("created_at = %d, want 1700000000", data.CreatedAt.ValueInt64())
+	}
+	if data.Content.ValueString() != "Hello, world" {
+		t.Errorf("content = %q, want %q", data.Content.ValueString(), "Hello, world")
+	}
+
+	var output []struct {
+		Type    string `tfsdk:"type"`
+		Role    string `tfsdk:"role"`
+		Content string `tfsdk:"content"`
+	}
+	resp.Diagnostics.Append(data.Output.ElementsAs(ctx, &output, false)...)
+	if len(output) != 1 || output[0].Type != "message" || output[0].Role != "assistant" || output[0].Content != "Hello, world" {
+		t.Errorf("output = %+v", output)
+	}
+
+	if got := data.Usage.Attributes()["total_tokens"].String(); got != "8" {
+		t.Errorf("usage.total_tokens = %s, want 8", got)
+	}
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ datasource.DataSource = &UsageDataSource{}
+
+func NewUsageDataSource() datasource.DataSource {
+	return &UsageDataSource{}
+}
+
+type UsageDataSource struct {
+	client *client.OpenAIClient
+}
+
+type UsageDataSourceModel struct {
+	ID                    types.String       `tfsdk:"id"`
+	StartTime             types.Int64        `tfsdk:"start_time"`
+	EndTime               types.Int64        `tfsdk:"end_time"`
+	BucketWidth           types.String       `tfsdk:"bucket_width"`
+	ProjectIDs            types.List         `tfsdk:"project_ids"`
+	Models                types.List         `tfsdk:"models"`
+	Buckets               []UsageBucketModel `tfsdk:"buckets"`
+	TotalInputTokens      types.Int64        `tfsdk:"total_input_tokens"`
+	TotalOutputTokens     types.Int64        `tfsdk:"total_output_tokens"`
+	TotalNumModelRequests types.Int64        `tfsdk:"total_num_model_requests"`
+	TotalCost             types.Float64      `tfsdk:"total_cost"`
+	Currency              types.String       `tfsdk:"currency"`
+}
+
+type UsageBucketModel struct {
+	StartTime         types.Int64   `tfsdk:"start_time"`
+	EndTime           types.Int64   `tfsdk:"end_time"`
+	InputTokens       types.Int64   `tfsdk:"input_tokens"`
+	OutputTokens      types.Int64   `tfsdk:"output_tokens"`
+	InputCachedTokens types.Int64   `tfsdk:"input_cached_tokens"`
+	NumModelRequests  types.Int64   `tfsdk:"num_model_requests"`
+	Cost              types.Float64 `tfsdk:"cost"`
+}
+
+func (d *UsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *UsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read the organization's completions usage and costs, aggregated per time bucket. Costs are only reported for daily buckets without a `models` filter, since the costs endpoint supports neither. Requires an admin API key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"start_time": schema.Int64Attribute{
+				Description: "Start of the report as a Unix timestamp in seconds, inclusive.",
+				Required:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"end_time": schema.Int64Attribute{
+				Description: "End of the report as a Unix timestamp in seconds, exclusive. Defaults to now.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"bucket_width": schema.StringAttribute{
+				Description: "Width of each bucket: `1m`, `1h` or `1d`. Defaults to `1d`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("1m", "1h", "1d")},
+			},
+			"project_ids": schema.ListAttribute{
+				Description: "Only report usage of these projects.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"models": schema.ListAttribute{
+				Description: "Only report usage of these models. Costs are not reported when set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"buckets": schema.ListNestedAttribute{
+				Description: "The usage per time bucket, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.Int64Attribute{
+							Description: "Start of the bucket as a Unix timestamp, inclusive.",
+							Computed:    true,
+						},
+						"end_time": schema.Int64Attribute{
+							Description: "End of the bucket as a Unix timestamp, exclusive.",
+							Computed:    true,
+						},
+						"input_tokens": schema.Int64Attribute{
+							Description: "Input tokens used, including cached tokens.",
+							Computed:    true,
+						},
+						"output_tokens": schema.Int64Attribute{
+							Description: "Output tokens used.",
+							Computed:    true,
+						},
+						"input_cached_tokens": schema.Int64Attribute{
+							Description: "Input tokens served from the prompt cache.",
+							Computed:    true,
+						},
+						"num_model_requests": schema.Int64Attribute{
+							Description: "Number of model requests.",
+							Computed:    true,
+						},
+						"cost": schema.Float64Attribute{
+							Description: "Cost of the bucket in `currency`. Null when costs are not reported.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"total_input_tokens": schema.Int64Attribute{
+				Description: "Input tokens used across all buckets.",
+				Computed:    true,
+			},
+			"total_output_tokens": schema.Int64Attribute{
+				Description: "Output tokens used across all buckets.",
+				Computed:    true,
+			},
+			"total_num_model_requests": schema.Int64Attribute{
+				Description: "Model requests across all buckets.",
+				Computed:    true,
+			},
+			"total_cost": schema.Float64Attribute{
+				Description: "Cost across all buckets in `currency`. Null when costs are not reported.",
+				Computed:    true,
+			},
+			"currency": schema.StringAttribute{
+				Description: "Currency of the costs, such as `usd`. Null when costs are not reported.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *UsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+
+	// Organization usage requires Admin API Key
+	cl, err := GetOpenAIClientWithAdminKey(providerClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
+	}
+	d.client = cl
+}
+
+func (d *UsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := client.UsageQuery{
+		StartTime:   data.StartTime.ValueInt64(),
+		EndTime:     data.EndTime.ValueInt64(),
+		BucketWidth: "1d",
+	}
+	if v := data.BucketWidth.ValueString(); v != "" {
+		q.BucketWidth = v
+	}
+	if !data.ProjectIDs.IsNull() {
+		resp.Diagnostics.Append(data.ProjectIDs.ElementsAs(ctx, &q.ProjectIDs, false)...)
+	}
+	if !data.Models.IsNull() {
+		resp.Diagnostics.Append(data.Models.ElementsAs(ctx, &q.Models, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if q.EndTime > 0 && q.EndTime <= q.StartTime {
+		resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid end_time", "end_time must be after start_time.")
+		return
+	}
+
+	usage, err := d.client.ListCompletionsUsage(ctx, q)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization usage", err.Error())
+		return
+	}
+
+	// Costs come in daily buckets for every model, so they only line up with
+	// the usage buckets when neither is narrowed further.
+	withCosts := q.BucketWidth == "1d" && len(q.Models) == 0
+	costs := map[int64]float64{}
+	currency := ""
+	if withCosts {
+		costBuckets, err := d.client.ListCosts(ctx, q)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading organization costs", err.Error())
+			return
+		}
+		for _, b := range costBuckets {
+			for _, r := range b.Results {
+				if r.Amount == nil {
+					continue
+				}
+				costs[b.StartTime] += r.Amount.Value
+				currency = r.Amount.Currency
+			}
+		}
+	}
+
+	var totalInput, totalOutput, totalRequests int64
+	var totalCost float64
+	data.Buckets = make([]UsageBucketModel, 0, len(usage))
+	for _, b := range usage {
+		bucket := UsageBucketModel{
+			StartTime: types.Int64Value(b.StartTime),
+			EndTime:   types.Int64Value(b.EndTime),
+			Cost:      types.Float64Null(),
+		}
+		var input, output, cached, requests int64
+		for _, r := range b.Results {
+			input += r.InputTokens
+			output += r.OutputTokens
+			cached += r.InputCachedTokens
+			requests += r.NumModelRequests
+		}
+		bucket.InputTokens = types.Int64Value(input)
+		bucket.OutputTokens = types.Int64Value(output)
+		bucket.InputCachedTokens = types.Int64Value(cached)
+		bucket.NumModelRequests = types.Int64Value(requests)
+		if withCosts {
+			bucket.Cost = types.Float64Value(costs[b.StartTime])
+			totalCost += costs[b.StartTime]
+		}
+		totalInput += input
+		totalOutput += output
+		totalRequests += requests
+		data.Buckets = append(data.Buckets, bucket)
+	}
+
+	data.TotalInputTokens = types.Int64Value(totalInput)
+	data.TotalOutputTokens = types.Int64Value(totalOutput)
+	data.TotalNumModelRequests = types.Int64Value(totalRequests)
+	data.TotalCost = types.Float64Null()
+	data.Currency = types.StringNull()
+	if withCosts {
+		data.TotalCost = types.Float64Value(totalCost)
+		if currency != "" {
+			data.Currency = types.StringValue(currency)
+		}
+	}
+	data.ID = types.StringValue(fmt.Sprintf("usage-%d-%d-%s", q.StartTime, q.EndTime, q.BucketWidth))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+func readUsageDataSource(t *testing.T, apiURL string, set map[string]tftypes.Value) UsageDataSourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &UsageDataSource{client: client.NewClient("sk-admin-test", "", apiURL+"/v1")}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["start_time"] = tftypes.NewValue(tftypes.Number, 1700000000)
+	for name, v := range set {
+		vals[name] = v
+	}
+	config := tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	var data UsageDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	return data
+}
+
+func mockUsageServer(t *testing.T, costsCalls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/organization/usage/completions":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "page",
+				"data": []map[string]interface{}{
+					{"start_time": 1700000000, "end_time": 1700086400, "results": []map[string]interface{}{
+						{"input_tokens": 100, "output_tokens": 20, "input_cached_tokens": 40, "num_model_requests": 3},
+						{"input_tokens": 50, "output_tokens": 5, "num_model_requests": 1},
+					}},
+					{"start_time": 1700086400, "end_time": 1700172800, "results": []map[string]interface{}{}},
+				},
+				"has_more": false,
+			})
+		case "/v1/organization/costs":
+			*costsCalls++
+			if _, ok := r.URL.Query()["models"]; ok {
+				t.Error("models sent to the costs endpoint")
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "page",
+				"data": []map[string]interface{}{
+					{"start_time": 1700000000, "end_time": 1700086400, "results": []map[string]interface{}{
+						{"amount": map[string]interface{}{"value": 0.25, "currency": "usd"}, "line_item": "completions"},
+						{"amount": map[string]interface{}{"value": 0.5, "currency": "usd"}, "line_item": "embeddings"},
+					}},
+				},
+				"has_more": false,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestUsageDataSource_AggregatesUsageAndCosts(t *testing.T) {
+	costsCalls := 0
+	server := mockUsageServer(t, &costsCalls)
+	defer server.Close()
+
+	data := readUsageDataSource(t, server.URL, nil)
+
+	if len(data.Buckets) != 2 {
+		t.Fatalf("buckets = %d, want 2", len(data.Buckets))
+	}
+	first := data.Buckets[0]
+	if first.InputTokens.ValueInt64() != 150 || first.OutputTokens.ValueInt64() != 25 ||
+		first.InputCachedTokens.ValueInt64() != 40 || first.NumModelRequests.ValueInt64() != 4 {
+		t.Errorf("first bucket = %+v", first)
+	}
+	if first.Cost.ValueFloat64() != 0.75 || data.Buckets[1].Cost.ValueFloat64() != 0 {
+		t.Errorf("costs = %s, %s; want 0.75, 0", first.Cost, data.Buckets[1].Cost)
+	}
+	if data.TotalInputTokens.ValueInt64() != 150 || data.TotalNumModelRequests.ValueInt64() != 4 ||
+		data.TotalCost.ValueFloat64() != 0.75 || data.Currency.ValueString() != "usd" {
+		t.Errorf("totals: input=%s requests=%s cost=%s currency=%s", data.TotalInputTokens, data.TotalNumModelRequests, data.TotalCost, data.Currency)
+	}
+}
+
+func TestUsageDataSource_ModelFilterSkipsCosts(t *testing.T) {
+	costsCalls := 0
+	server := mockUsageServer(t, &costsCalls)
+	defer server.Close()
+
+	data := readUsageDataSource(t, server.URL, map[string]tftypes.Value{
+		"models": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "gpt-4o"),
+		}),
+	})
+
+	if costsCalls != 0 {
+		t.Errorf("costs endpoint called %d times with a models filter", costsCalls)
+	}
+	if !data.TotalCost.IsNull() || !data.Currency.IsNull() || !data.Buckets[0].Cost.IsNull() {
+		t.Errorf("costs should be null with a models filter, got total=%s currency=%s", data.TotalCost, data.Currency)
+	}
+	if data.TotalInputTokens.ValueInt64() != 150 {
+		t.Errorf("total_input_tokens = %s, want 150", data.TotalInputTokens)
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIVectorStoreFiles_Pagination lists a store whose
+// files span two pages and checks that every file is returned, with
+// attributes, and that the status filter is passed through.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIVectorStoreFiles_Pagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockVectorStoreFilesListHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpenAIVectorStoreFilesConfig(srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.all", "files.#", "3"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.all", "has_more", "false"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.all", "files.0.file_id", "file-1"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.all", "files.0.attributes.team", "docs"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.all", "files.0.attributes.version", "2"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.all", "files.2.status", "failed"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.failed", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_files.failed", "files.0.id", "file-3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpenAIVectorStoreFilesConfig(apiURL string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_vector_store_files" "all" {
+  vector_store_id = "vs_1"
+}
+
+data "openai_vector_store_files" "failed" {
+  vector_store_id = "vs_1"
+  filter          = "failed"
+}
+`, apiURL)
+}
+
+// mockVectorStoreFilesListHandler serves three files across two pages and
+// applies the status filter server-side, as the API does.
+func mockVectorStoreFilesListHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/vector_stores/vs_1/files" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	files := []map[string]interface{}{
+		{"id": "file-1", "object": "vector_store.file", "status": "completed", "created_at": 1700000000, "attributes": map[string]interface{}{"team": "docs", "version": 2}},
+		{"id": "file-2", "object": "vector_store.file", "status": "completed", "created_at": 1700000001},
+		{"id": "file-3", "object": "vector_store.file", "status": "failed", "created_at": 1700000002},
+	}
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		matched := []map[string]interface{}{}
+		for _, f := range files {
+			if f["status"] == filter {
+				matched = append(matched, f)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": matched, "last_id": "", "has_more": false})
+		return
+	}
+
+	if r.URL.Query().Get("after") == "" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": files[:2], "last_id": "file-2", "has_more": true})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": files[2:], "last_id": "file-3", "has_more": false})
+}
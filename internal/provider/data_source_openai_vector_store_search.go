@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ datasource.DataSource = &VectorStoreSearchDataSource{}
+
+func NewVectorStoreSearchDataSource() datasource.DataSource {
+	return &VectorStoreSearchDataSource{}
+}
+
+type VectorStoreSearchDataSource struct {
+	client *OpenAIClient
+}
+
+type VectorStoreSearchDataSourceModel struct {
+	ID             types.String                   `tfsdk:"id"`
+	VectorStoreID  types.String                   `tfsdk:"vector_store_id"`
+	Query          types.String                   `tfsdk:"query"`
+	MaxNumResults  types.Int64                    `tfsdk:"max_num_results"`
+	Filters        types.String                   `tfsdk:"filters"`
+	RankingOptions *VectorStoreSearchRankingModel `tfsdk:"ranking_options"`
+	Results        []VectorStoreSearchResultModel `tfsdk:"results"`
+}
+
+type VectorStoreSearchRankingModel struct {
+	Ranker         types.String  `tfsdk:"ranker"`
+	ScoreThreshold types.Float64 `tfsdk:"score_threshold"`
+}
+
+type VectorStoreSearchResultModel struct {
+	FileID   types.String  `tfsdk:"file_id"`
+	Filename types.String  `tfsdk:"filename"`
+	Score    types.Float64 `tfsdk:"score"`
+	Content  types.String  `tfsdk:"content"`
+}
+
+func (d *VectorStoreSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vector_store_search"
+}
+
+func (d *VectorStoreSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to run a semantic search over the files in an OpenAI vector store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+			},
+			"vector_store_id": schema.StringAttribute{
+				Description: "The ID of the vector store to search.",
+				Required:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "The query to search for.",
+				Required:    true,
+			},
+			"max_num_results": schema.Int64Attribute{
+				Description: "The maximum number of results to return, between 1 and 50. The API defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"filters": schema.StringAttribute{
+				Description: "A JSON-encoded comparison or compound filter applied to file attributes, e.g. `jsonencode({type = \"eq\", key = \"team\", value = \"docs\"})`.",
+				Optional:    true,
+			},
+			"ranking_options": schema.SingleNestedAttribute{
+				Description: "Options for ranking the results.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"ranker": schema.StringAttribute{
+						Description: "The ranker to use, e.g. `auto`.",
+						Optional:    true,
+					},
+					"score_threshold": schema.Float64Attribute{
+						Description: "Only return results scoring at least this value, between 0 and 1.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The matching chunks, best match first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_id": schema.StringAttribute{
+							Description: "The ID of the file the chunk belongs to.",
+							Computed:    true,
+						},
+						"filename": schema.StringAttribute{
+							Description: "The name of the file the chunk belongs to.",
+							Computed:    true,
+						},
+						"score": schema.Float64Attribute{
+							Description: "The similarity score of the chunk.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "The chunk's text content, with multiple parts joined by newlines.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VectorStoreSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*OpenAIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *OpenAIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VectorStoreSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VectorStoreSearchDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &client.VectorStoreSearchParams{
+		VectorStoreID: data.VectorStoreID.ValueString(),
+		Query:         data.Query.ValueString(),
+	}
+	if !data.MaxNumResults.IsNull() {
+		params.MaxNumResults = int(data.MaxNumResults.ValueInt64())
+	}
+	if !data.Filters.IsNull() {
+		if err := json.Unmarshal([]byte(data.Filters.ValueString()), &params.Filters); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filters"), "Invalid filters", fmt.Sprintf("filters must be a JSON object: %s", err))
+			return
+		}
+	}
+	if data.RankingOptions != nil {
+		params.RankingOptions = &client.VectorStoreSearchRankingOptions{
+			Ranker:         data.RankingOptions.Ranker.ValueString(),
+			ScoreThreshold: data.RankingOptions.ScoreThreshold.ValueFloat64Pointer(),
+		}
+	}
+
+	searchResp, err := d.client.SearchVectorStore(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching vector store", err.Error())
+		return
+	}
+
+	// The API applies score_threshold too; filtering here as well keeps the
+	// results consistent with the configured threshold whatever the ranker.
+	var threshold *float64
+	if params.RankingOptions != nil {
+		threshold = params.RankingOptions.ScoreThreshold
+	}
+
+	results := make([]VectorStoreSearchResultModel, 0, len(searchResp.Data))
+	for _, r := range searchResp.Data {
+		if threshold != nil && r.Score < *threshold {
+			continue
+		}
+		texts := make([]string, 0, len(r.Content))
+		for _, c := range r.Content {
+			texts = append(texts, c.Text)
+		}
+		results = append(results, VectorStoreSearchResultModel{
+			FileID:   types.StringValue(r.FileID),
+			Filename: types.StringValue(r.Filename),
+			Score:    types.Float64Value(r.Score),
+			Content:  types.StringValue(strings.Join(texts, "\n")),
+		})
+	}
+
+	data.ID = data.VectorStoreID
+	data.Results = results
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
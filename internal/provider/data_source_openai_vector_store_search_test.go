@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceOpenAIVectorStoreSearch_Results searches a mock store and
+// checks that filters are forwarded and multi-part content is joined.
+//
+// Set TF_ACC=1 to run.
+func TestAccDataSourceOpenAIVectorStoreSearch_Results(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(mockVectorStoreSearchHandler))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpenAIVectorStoreSearchConfig(srv.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_vector_store_search.refunds", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_search.refunds", "results.0.file_id", "file-1"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_search.refunds", "results.0.filename", "policies.md"),
+					resource.TestCheckResourceAttr("data.openai_vector_store_search.refunds", "results.0.content", "Refunds within 30 days.\nExchanges within 60 days."),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpenAIVectorStoreSearchConfig(apiURL string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+data "openai_vector_store_search" "refunds" {
+  vector_store_id = "vs_1"
+  query           = "refund policy"
+  max_num_results = 3
+  filters         = jsonencode({ type = "eq", key = "team", value = "support" })
+}
+`, apiURL)
+}
+
+// mockVectorStoreSearchHandler answers searches that carry the team filter
+// with a single two-part chunk.
+func mockVectorStoreSearchHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/v1/vector_stores/vs_1/search" {
+		http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+
+	var body struct {
+		Query   string                 `json:"query"`
+		Filters map[string]interface{} `json:"filters"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Filters["value"] != "support" {
+		http.Error(w, "missing filters", http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"object":       "vector_store.search_results.page",
+		"search_query": []string{body.Query},
+		"data": []map[string]interface{}{
+			{
+				"file_id":  "file-1",
+				"filename": "policies.md",
+				"score":    0.92,
+				"content": []map[string]interface{}{
+					{"type": "text", "text": "Refunds within 30 days."},
+					{"type": "text", "text": "Exchanges within 60 days."},
+				},
+			},
+		},
+		"has_more": false,
+	})
+}
+
+func TestVectorStoreSearch_MaxNumResultsAndScoreThreshold(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "vector_store.search_results.page",
+			"data": []map[string]interface{}{
+				{"file_id": "file-1", "filename": "a.md", "score": 0.9, "content": []map[string]interface{}{{"type": "text", "text": "close"}}},
+				{"file_id": "file-2", "filename": "b.md", "score": 0.4, "content": []map[string]interface{}{{"type": "text", "text": "far"}}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &VectorStoreSearchDataSource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	rankingType := objType.AttributeTypes["ranking_options"].(tftypes.Object)
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["vector_store_id"] = tftypes.NewValue(tftypes.String, "vs_1")
+	vals["query"] = tftypes.NewValue(tftypes.String, "refunds")
+	vals["max_num_results"] = tftypes.NewValue(tftypes.Number, 5)
+	vals["ranking_options"] = tftypes.NewValue(rankingType, map[string]tftypes.Value{
+		"ranker":          tftypes.NewValue(tftypes.String, nil),
+		"score_threshold": tftypes.NewValue(tftypes.Number, 0.5),
+	})
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	if sent["max_num_results"] != float64(5) {
+		t.Errorf("max_num_results sent = %v, want 5", sent["max_num_results"])
+	}
+	if ranking, _ := sent["ranking_options"].(map[string]interface{}); ranking["score_threshold"] != 0.5 {
+		t.Errorf("ranking_options sent = %v, want score_threshold 0.5", sent["ranking_options"])
+	}
+
+	var data VectorStoreSearchDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if len(data.Results) != 1 || data.Results[0].FileID.ValueString() != "file-1" {
+		t.Errorf("results = %v, want only file-1 above the threshold", data.Results)
+	}
+}
+
+func TestVectorStoreSearch_ValidatesBounds(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	(&VectorStoreSearchDataSource{}).Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	maxNum := schemaResp.Schema.Attributes["max_num_results"].(schema.Int64Attribute)
+	for _, n := range []int64{0, 51} {
+		resp := &validator.Int64Response{}
+		for _, v := range maxNum.Validators {
+			v.ValidateInt64(ctx, validator.Int64Request{Path: path.Root("max_num_results"), ConfigValue: types.Int64Value(n)}, resp)
+		}
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("max_num_results %d accepted", n)
+		}
+	}
+
+	ranking := schemaResp.Schema.Attributes["ranking_options"].(schema.SingleNestedAttribute)
+	threshold := ranking.Attributes["score_threshold"].(schema.Float64Attribute)
+	for _, f := range []float64{-0.1, 1.5} {
+		resp := &validator.Float64Response{}
+		for _, v := range threshold.Validators {
+			v.ValidateFloat64(ctx, validator.Float64Request{Path: path.Root("ranking_options").AtName("score_threshold"), ConfigValue: types.Float64Value(f)}, resp)
+		}
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("score_threshold %v accepted", f)
+		}
+	}
+}
@@ -90,7 +90,7 @@ func (d *VectorStoreFileDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	url := fmt.Sprintf("/v1/vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), data.FileID.ValueString())
+	url := fmt.Sprintf("vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), data.FileID.ValueString())
 	respBody, err := d.client.DoRequest("GET", url, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading vector store file", err.Error())
@@ -219,7 +219,7 @@ func (d *VectorStoreFileBatchDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	url := fmt.Sprintf("/v1/vector_stores/%s/file_batches/%s", data.VectorStoreID.ValueString(), data.BatchID.ValueString())
+	url := fmt.Sprintf("vector_stores/%s/file_batches/%s", data.VectorStoreID.ValueString(), data.BatchID.ValueString())
 	respBody, err := d.client.DoRequest("GET", url, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading vector store file batch", err.Error())
@@ -321,7 +321,7 @@ func (d *VectorStoreFileContentDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	url := fmt.Sprintf("/v1/vector_stores/%s/files/%s/content", data.VectorStoreID.ValueString(), data.FileID.ValueString())
+	url := fmt.Sprintf("vector_stores/%s/files/%s/content", data.VectorStoreID.ValueString(), data.FileID.ValueString())
 	respBody, err := d.client.DoRequest("GET", url, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading vector store file content", err.Error())
@@ -362,33 +362,53 @@ func (d *VectorStoreFilesDataSource) Metadata(ctx context.Context, req datasourc
 
 func (d *VectorStoreFilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Data source for listing files in a vector store.",
+		Description: "Data source for listing files in a vector store. Follows the pagination cursor so every matching file is returned.",
 		Attributes: map[string]schema.Attribute{
 			"vector_store_id": schema.StringAttribute{Required: true},
 			"limit": schema.Int64Attribute{
-				Optional:   true,
-				Validators: []validator.Int64{int64validator.Between(1, 100)},
+				Optional:    true,
+				Description: "Page size used when listing (1-100). All pages are still read.",
+				Validators:  []validator.Int64{int64validator.Between(1, 100)},
 			},
 			"order": schema.StringAttribute{
 				Optional:   true,
 				Validators: []validator.String{stringvalidator.OneOf("asc", "desc")},
 			},
-			"after":  schema.StringAttribute{Optional: true},
-			"before": schema.StringAttribute{Optional: true},
+			"after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start listing after this file ID.",
+			},
+			"before": schema.StringAttribute{
+				Optional:    true,
+				Description: "List the page of files before this file ID. Only a single page is returned, since the cursor cannot be followed backwards.",
+			},
 			"filter": schema.StringAttribute{
-				Optional:   true,
-				Validators: []validator.String{stringvalidator.OneOf("in_progress", "completed", "failed", "cancelled")},
+				Optional:    true,
+				Description: "Only return files with this status: `in_progress`, `completed`, `failed` or `cancelled`.",
+				Validators:  []validator.String{stringvalidator.OneOf("in_progress", "completed", "failed", "cancelled")},
 			},
-			"has_more": schema.BoolAttribute{Computed: true},
-			"id":       schema.StringAttribute{Computed: true},
+			"has_more": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether more files exist beyond those returned. Only true when `before` limits the listing to one page.",
+			},
+			"id": schema.StringAttribute{Computed: true},
 			"files": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id":         schema.StringAttribute{Computed: true},
-						"object":     schema.StringAttribute{Computed: true},
+						"id":     schema.StringAttribute{Computed: true},
+						"object": schema.StringAttribute{Computed: true},
+						"file_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the underlying file. Vector store files share their ID with the file they index.",
+						},
 						"created_at": schema.Int64Attribute{Computed: true},
 						"status":     schema.StringAttribute{Computed: true},
+						"attributes": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The file's attributes. Numbers and booleans are rendered as strings.",
+						},
 					},
 				},
 			},
@@ -415,78 +435,102 @@ func (d *VectorStoreFilesDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	url := fmt.Sprintf("/v1/vector_stores/%s/files", data.VectorStoreID.ValueString())
-	params := []string{}
-	if !data.Limit.IsNull() {
-		params = append(params, fmt.Sprintf("limit=%d", data.Limit.ValueInt64()))
-	}
-	if !data.Order.IsNull() {
-		params = append(params, fmt.Sprintf("order=%s", data.Order.ValueString()))
-	}
-	if !data.After.IsNull() {
-		params = append(params, fmt.Sprintf("after=%s", data.After.ValueString()))
-	}
-	if !data.Before.IsNull() {
-		params = append(params, fmt.Sprintf("before=%s", data.Before.ValueString()))
-	}
-	if !data.Filter.IsNull() {
-		params = append(params, fmt.Sprintf("filter=%s", data.Filter.ValueString()))
-	}
-
-	if len(params) > 0 {
-		url += "?" + strings.Join(params, "&")
-	}
-
-	respBody, err := d.client.DoRequest("GET", url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error listing vector store files", err.Error())
-		return
-	}
-
-	var listResp struct {
-		Data    []map[string]interface{} `json:"data"`
-		HasMore bool                     `json:"has_more"`
-	}
-	if err := json.Unmarshal(respBody, &listResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
-	data.HasMore = types.BoolValue(listResp.HasMore)
-
-	filesList := []attr.Value{}
+	baseURL := fmt.Sprintf("/v1/vector_stores/%s/files", data.VectorStoreID.ValueString())
 	fileType := types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"id":         types.StringType,
 			"object":     types.StringType,
+			"file_id":    types.StringType,
 			"created_at": types.Int64Type,
 			"status":     types.StringType,
+			"attributes": types.MapType{ElemType: types.StringType},
 		},
 	}
 
-	for _, item := range listResp.Data {
-		attrs := map[string]attr.Value{
-			"id":         types.StringNull(),
-			"object":     types.StringNull(),
-			"created_at": types.Int64Null(),
-			"status":     types.StringNull(),
+	filesList := []attr.Value{}
+	after := data.After.ValueString()
+	for {
+		params := []string{}
+		if !data.Limit.IsNull() {
+			params = append(params, fmt.Sprintf("limit=%d", data.Limit.ValueInt64()))
 		}
-		if v, ok := item["id"].(string); ok {
-			attrs["id"] = types.StringValue(v)
+		if !data.Order.IsNull() {
+			params = append(params, fmt.Sprintf("order=%s", data.Order.ValueString()))
 		}
-		if v, ok := item["object"].(string); ok {
-			attrs["object"] = types.StringValue(v)
+		if after != "" {
+			params = append(params, fmt.Sprintf("after=%s", after))
 		}
-		if v, ok := item["created_at"].(float64); ok {
-			attrs["created_at"] = types.Int64Value(int64(v))
+		if !data.Before.IsNull() {
+			params = append(params, fmt.Sprintf("before=%s", data.Before.ValueString()))
 		}
-		if v, ok := item["status"].(string); ok {
-			attrs["status"] = types.StringValue(v)
+		if !data.Filter.IsNull() {
+			params = append(params, fmt.Sprintf("filter=%s", data.Filter.ValueString()))
 		}
 
-		obj, _ := types.ObjectValue(fileType.AttrTypes, attrs)
-		filesList = append(filesList, obj)
+		url := baseURL
+		if len(params) > 0 {
+			url += "?" + strings.Join(params, "&")
+		}
+
+		respBody, err := d.client.DoRequest("GET", url, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing vector store files", err.Error())
+			return
+		}
+
+		var listResp struct {
+			Data    []map[string]interface{} `json:"data"`
+			LastID  string                   `json:"last_id"`
+			HasMore bool                     `json:"has_more"`
+		}
+		if err := json.Unmarshal(respBody, &listResp); err != nil {
+			resp.Diagnostics.AddError("Error parsing response", err.Error())
+			return
+		}
+
+		for _, item := range listResp.Data {
+			attrs := map[string]attr.Value{
+				"id":         types.StringNull(),
+				"object":     types.StringNull(),
+				"file_id":    types.StringNull(),
+				"created_at": types.Int64Null(),
+				"status":     types.StringNull(),
+				"attributes": types.MapNull(types.StringType),
+			}
+			if v, ok := item["id"].(string); ok {
+				attrs["id"] = types.StringValue(v)
+				attrs["file_id"] = types.StringValue(v)
+			}
+			if v, ok := item["object"].(string); ok {
+				attrs["object"] = types.StringValue(v)
+			}
+			if v, ok := item["created_at"].(float64); ok {
+				attrs["created_at"] = types.Int64Value(int64(v))
+			}
+			if v, ok := item["status"].(string); ok {
+				attrs["status"] = types.StringValue(v)
+			}
+			if v, ok := item["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+				fileAttrs := make(map[string]attr.Value, len(v))
+				for k, av := range v {
+					fileAttrs[k] = types.StringValue(fmt.Sprintf("%v", av))
+				}
+				attrs["attributes"], _ = types.MapValue(types.StringType, fileAttrs)
+			}
+
+			obj, _ := types.ObjectValue(fileType.AttrTypes, attrs)
+			filesList = append(filesList, obj)
+		}
+
+		// A before cursor pages backwards, which the after cursor cannot
+		// continue, so that listing stops at a single page.
+		if !listResp.HasMore || !data.Before.IsNull() || listResp.LastID == "" {
+			data.HasMore = types.BoolValue(listResp.HasMore)
+			break
+		}
+		after = listResp.LastID
 	}
+
 	data.Files, _ = types.ListValue(fileType, filesList)
 	data.ID = types.StringValue(fmt.Sprintf("vector_store_files_%d", time.Now().Unix()))
 
@@ -576,7 +620,7 @@ func (d *VectorStoreFileBatchFilesDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	url := fmt.Sprintf("/v1/vector_stores/%s/file_batches/%s/files", data.VectorStoreID.ValueString(), data.BatchID.ValueString())
+	url := fmt.Sprintf("vector_stores/%s/file_batches/%s/files", data.VectorStoreID.ValueString(), data.BatchID.ValueString())
 	params := []string{}
 	if !data.Limit.IsNull() {
 		params = append(params, fmt.Sprintf("limit=%d", data.Limit.ValueInt64()))
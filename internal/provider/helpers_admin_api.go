@@ -1,8 +1,14 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // adminBaseURL returns the API base URL without trailing /v1 or /
@@ -19,10 +25,48 @@ func adminAPIKey(c *OpenAIClient) string {
 	return c.OpenAIClient.APIKey
 }
 
-// setAdminAuthHeaders sets Authorization and optional OpenAI-Organization headers
+// setAdminAuthHeaders sets Authorization, the optional OpenAI-Organization
+// header and the provider's default_headers
 func setAdminAuthHeaders(c *OpenAIClient, req *http.Request) {
 	req.Header.Set("Authorization", "Bearer "+adminAPIKey(c))
 	if c.OpenAIClient.OrganizationID != "" {
 		req.Header.Set("OpenAI-Organization", c.OpenAIClient.OrganizationID)
 	}
+	c.OpenAIClient.SetDefaultHeaders(req)
+}
+
+// assignRole adds roleID to rolesURL, the roles collection of a project user
+// or group.
+func assignRole(ctx context.Context, c *OpenAIClient, rolesURL, roleID string) error {
+	body, err := json.Marshal(RoleAssignRequest{RoleID: roleID})
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "Assigning role", map[string]interface{}{"url": rolesURL, "role_id": roleID})
+	resp, err := doRequestWithRetry(ctx, c, "POST", rolesURL, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s - %s", resp.Status, string(respBody))
+	}
+	return nil
+}
+
+// unassignRole removes roleID from rolesURL. A 404 counts as success: the
+// role is already gone.
+func unassignRole(ctx context.Context, c *OpenAIClient, rolesURL, roleID string) error {
+	tflog.Debug(ctx, "Unassigning role", map[string]interface{}{"url": rolesURL, "role_id": roleID})
+	resp, err := doRequestWithRetry(ctx, c, "DELETE", rolesURL+"/"+roleID, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s - %s", resp.Status, string(respBody))
+	}
+	return nil
 }
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// adoptExistingDescription documents the adopt_existing attribute; kind is
+// the object type as it reads in prose, e.g. "project".
+func adoptExistingDescription(kind string) string {
+	return fmt.Sprintf("Adopt an existing %[1]s with the same `name` instead of creating a new one. The name must match exactly, including case; if no %[1]s matches a new one is created, and if several match the apply fails rather than guess. Only takes effect on create.", kind)
+}
+
+// findByName returns the single item whose name equals name exactly, or nil
+// if there is none. More than one match is an error, since adopting either
+// could take over an object another configuration manages.
+func findByName[T any](kind, name string, items []T, nameOf func(T) string, idOf func(T) string) (*T, error) {
+	var matches []int
+	for i, item := range items {
+		if nameOf(item) == name {
+			matches = append(matches, i)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &items[matches[0]], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, i := range matches {
+			ids = append(ids, idOf(items[i]))
+		}
+		return nil, fmt.Errorf("%d %ss are named %q (%s); rename all but one or import the intended %s explicitly", len(matches), kind, name, strings.Join(ids, ", "), kind)
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+type namedObject struct{ id, name string }
+
+func TestFindByName(t *testing.T) {
+	items := []namedObject{{"a", "docs"}, {"b", "Docs"}, {"c", "dup"}, {"d", "dup"}}
+	nameOf := func(o namedObject) string { return o.name }
+	idOf := func(o namedObject) string { return o.id }
+
+	got, err := findByName("store", "docs", items, nameOf, idOf)
+	if err != nil || got == nil || got.id != "a" {
+		t.Errorf("exact match: got %v, %v; want a", got, err)
+	}
+
+	got, err = findByName("store", "missing", items, nameOf, idOf)
+	if err != nil || got != nil {
+		t.Errorf("no match: got %v, %v; want nil", got, err)
+	}
+
+	_, err = findByName("store", "dup", items, nameOf, idOf)
+	if err == nil || !strings.Contains(err.Error(), "c, d") {
+		t.Errorf("ambiguous match: err = %v, want both IDs listed", err)
+	}
+}
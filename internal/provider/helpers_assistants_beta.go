@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// defaultAssistantsBetaHeader is the OpenAI-Beta value sent on vector store
+// requests when the provider's assistants_beta_header is not set.
+const defaultAssistantsBetaHeader = "assistants=v2"
+
+// doAssistantsRequest sends a request to an endpoint that historically
+// required the Assistants beta header.
+//
+// The header value comes from the provider's assistants_beta_header setting;
+// an empty value omits it. As the Assistants surface moves to GA the API may
+// start rejecting a stale beta version, so a 400 that names the OpenAI-Beta
+// header is retried once without it rather than failing the apply. The
+// request is sent with the client's Do, which adds the User-Agent, the
+// provider's project_id and its default_headers.
+func doAssistantsRequest(c *OpenAIClient, req *http.Request) (*http.Response, error) {
+	beta := c.AssistantsBetaHeader
+	if beta == "" {
+		return c.OpenAIClient.Do(req)
+	}
+
+	req.Header.Set("OpenAI-Beta", beta)
+	resp, err := c.OpenAIClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusBadRequest {
+		return resp, err
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if readErr != nil {
+		return nil, readErr
+	}
+	if !strings.Contains(strings.ToLower(string(body)), "openai-beta") || (req.Body != nil && req.GetBody == nil) {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	retry.Header.Del("OpenAI-Beta")
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return c.OpenAIClient.Do(retry)
+}
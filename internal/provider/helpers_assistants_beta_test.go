@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestDoAssistantsRequest_HeaderPresent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("OpenAI-Beta")
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "vs_1"})
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	c.AssistantsBetaHeader = defaultAssistantsBetaHeader
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/vector_stores/vs_1", nil)
+	resp, err := doAssistantsRequest(c, req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if got != "assistants=v2" {
+		t.Errorf("OpenAI-Beta = %q, want %q", got, "assistants=v2")
+	}
+}
+
+func TestDoAssistantsRequest_HeaderAbsent(t *testing.T) {
+	var present bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, present = r.Header["Openai-Beta"]
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "vs_1"})
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	c.AssistantsBetaHeader = ""
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/vector_stores/vs_1", nil)
+	resp, err := doAssistantsRequest(c, req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if present {
+		t.Error("OpenAI-Beta header should be omitted when assistants_beta_header is empty")
+	}
+}
+
+func TestDoAssistantsRequest_RetriesWithoutRejectedHeader(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		buf := new(bytes.Buffer)
+		_, _ = buf.ReadFrom(r.Body)
+		bodies = append(bodies, buf.String())
+		beta := r.Header.Get("OpenAI-Beta")
+		seen = append(seen, beta)
+		if beta != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+				"error": map[string]interface{}{"message": "Invalid value for 'OpenAI-Beta' header.", "type": "invalid_request_error"},
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "vs_1"})
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	c.AssistantsBetaHeader = "assistants=v1"
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/vector_stores", bytes.NewReader([]byte(`{"name":"docs"}`)))
+	resp, err := doAssistantsRequest(c, req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200 after retrying without the header", resp.StatusCode)
+	}
+	if len(seen) != 2 || seen[0] != "assistants=v1" || seen[1] != "" {
+		t.Errorf("OpenAI-Beta per attempt = %q, want [assistants=v1 \"\"]", seen)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"name":"docs"}` {
+		t.Errorf("retry must resend the request body, got %q", bodies)
+	}
+}
+
+func TestDoAssistantsRequest_DefaultHeaders(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "vs_1"})
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	c.OpenAIClient.DefaultHeaders = map[string]string{
+		"Helicone-Auth": "Bearer hk-123",
+		"authorization": "Bearer gateway",
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/vector_stores/vs_1", nil)
+	req.Header.Set("Authorization", "Bearer test-api-key")
+	resp, err := doAssistantsRequest(c, req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if v := got.Get("Helicone-Auth"); v != "Bearer hk-123" {
+		t.Errorf("Helicone-Auth = %q, want %q", v, "Bearer hk-123")
+	}
+	if v := got.Get("Authorization"); v != "Bearer test-api-key" {
+		t.Errorf("Authorization = %q, want the API key", v)
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fileSHA256 returns the hex SHA-256 of the file at p. The audio resources
+// record it on create so a changed input file is detected on refresh.
+func fileSHA256(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("reading %s: %w", p, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// audioInputDrifted reports whether the input file at p is gone or no longer
+// matches the checksum recorded in state. State written before the checksum
+// was recorded only drifts when the file is gone.
+func audioInputDrifted(p string, checksum types.String) bool {
+	sum, err := fileSHA256(p)
+	if err != nil {
+		return true
+	}
+	if checksum.IsNull() || checksum.IsUnknown() {
+		return false
+	}
+	return sum != checksum.ValueString()
+}
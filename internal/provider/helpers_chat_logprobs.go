@@ -0,0 +1,23 @@
+package provider
+
+// bestChoiceIndex returns the index of the choice with the highest mean
+// token log probability. Choices without content logprobs are ignored, and
+// false is returned if none have them (logprobs were not requested).
+func bestChoiceIndex(choices []ChatCompletionChoice) (int, bool) {
+	best, found := 0, false
+	var bestMean float64
+	for _, c := range choices {
+		if c.Logprobs == nil || len(c.Logprobs.Content) == 0 {
+			continue
+		}
+		var sum float64
+		for _, t := range c.Logprobs.Content {
+			sum += t.Logprob
+		}
+		mean := sum / float64(len(c.Logprobs.Content))
+		if !found || mean > bestMean {
+			best, bestMean, found = c.Index, mean, true
+		}
+	}
+	return best, found
+}
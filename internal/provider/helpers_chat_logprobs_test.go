@@ -0,0 +1,62 @@
+package provider
+
+import "testing"
+
+func choiceWithLogprobs(index int, logprobs ...float64) ChatCompletionChoice {
+	c := ChatCompletionChoice{Index: index, Logprobs: &ChatCompletionLogprobs{}}
+	for _, lp := range logprobs {
+		c.Logprobs.Content = append(c.Logprobs.Content, ChatTokenLogprob{Logprob: lp})
+	}
+	return c
+}
+
+func TestBestChoiceIndex(t *testing.T) {
+	cases := []struct {
+		name      string
+		choices   []ChatCompletionChoice
+		wantIndex int
+		wantFound bool
+	}{
+		{
+			name: "best of three by mean logprob",
+			choices: []ChatCompletionChoice{
+				choiceWithLogprobs(0, -0.5, -1.5),       // mean -1.0
+				choiceWithLogprobs(1, -0.1, -0.2, -0.3), // mean -0.2
+				choiceWithLogprobs(2, -0.05, -2.0),      // mean -1.025
+			},
+			wantIndex: 1,
+			wantFound: true,
+		},
+		{
+			name: "mean not sum, so longer choices are not penalised",
+			choices: []ChatCompletionChoice{
+				choiceWithLogprobs(0, -0.4),
+				choiceWithLogprobs(1, -0.3, -0.3, -0.3, -0.3),
+			},
+			wantIndex: 1,
+			wantFound: true,
+		},
+		{
+			name: "choices without logprobs are skipped",
+			choices: []ChatCompletionChoice{
+				{Index: 0},
+				choiceWithLogprobs(1, -2.0),
+			},
+			wantIndex: 1,
+			wantFound: true,
+		},
+		{
+			name:    "no logprobs",
+			choices: []ChatCompletionChoice{{Index: 0}, {Index: 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := bestChoiceIndex(tc.choices)
+			if found != tc.wantFound || got != tc.wantIndex {
+				t.Errorf("bestChoiceIndex() = (%d, %v), want (%d, %v)", got, found, tc.wantIndex, tc.wantFound)
+			}
+		})
+	}
+}
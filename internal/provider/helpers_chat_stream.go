@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+// streamChatCompletion sends a streamed chat completion request and
+// assembles the chunks into the response the non-streaming API would have
+// returned.
+func streamChatCompletion(ctx context.Context, cl *client.OpenAIClient, request ChatCompletionRequest) (*ChatCompletionResponse, error) {
+	request.Stream = true
+	acc := &chatStreamAccumulator{}
+	if err := cl.DoStreamRequestContext(ctx, http.MethodPost, "chat/completions", request, acc.add); err != nil {
+		return nil, err
+	}
+	return acc.response()
+}
+
+// chatStreamAccumulator folds the chunks of a streamed chat completion into
+// a ChatCompletionResponse: content and tool call arguments are
+// concatenated per choice, and usage is taken from the final chunk.
+type chatStreamAccumulator struct {
+	resp    *ChatCompletionResponse
+	choices map[int]*ChatCompletionChoice
+}
+
+// add folds the data of one server-sent event into the response.
+func (a *chatStreamAccumulator) add(data []byte) error {
+	var chunk ChatCompletionChunk
+	if err := json.Unmarshal(data, &chunk); err != nil {
+		return fmt.Errorf("error parsing stream chunk: %w", err)
+	}
+
+	if a.resp == nil {
+		a.resp = &ChatCompletionResponse{ID: chunk.ID, Object: "chat.completion", Created: chunk.Created, Model: chunk.Model}
+		a.choices = map[int]*ChatCompletionChoice{}
+	}
+	if chunk.Usage != nil {
+		a.resp.Usage = *chunk.Usage
+	}
+
+	for _, c := range chunk.Choices {
+		choice, ok := a.choices[c.Index]
+		if !ok {
+			choice = &ChatCompletionChoice{Index: c.Index}
+			a.choices[c.Index] = choice
+		}
+		msg := &choice.Message
+		if c.Delta.Role != "" {
+			msg.Role = c.Delta.Role
+		}
+		msg.Content += c.Delta.Content
+		if fc := c.Delta.FunctionCall; fc != nil {
+			if msg.FunctionCall == nil {
+				msg.FunctionCall = &ChatFunctionCall{}
+			}
+			msg.FunctionCall.Name += fc.Name
+			msg.FunctionCall.Arguments += fc.Arguments
+		}
+		for _, tc := range c.Delta.ToolCalls {
+			for len(msg.ToolCalls) <= tc.Index {
+				msg.ToolCalls = append(msg.ToolCalls, ChatToolCall{})
+			}
+			call := &msg.ToolCalls[tc.Index]
+			if tc.ID != "" {
+				call.ID = tc.ID
+			}
+			if tc.Type != "" {
+				call.Type = tc.Type
+			}
+			call.Function.Name += tc.Function.Name
+			call.Function.Arguments += tc.Function.Arguments
+		}
+		if c.Logprobs != nil {
+			if choice.Logprobs == nil {
+				choice.Logprobs = &ChatCompletionLogprobs{}
+			}
+			choice.Logprobs.Content = append(choice.Logprobs.Content, c.Logprobs.Content...)
+		}
+		if c.FinishReason != "" {
+			choice.FinishReason = c.FinishReason
+		}
+	}
+	return nil
+}
+
+// response returns the assembled completion, with choices in index order.
+func (a *chatStreamAccumulator) response() (*ChatCompletionResponse, error) {
+	if a.resp == nil {
+		return nil, fmt.Errorf("chat completion stream ended without any chunks")
+	}
+	indexes := make([]int, 0, len(a.choices))
+	for i := range a.choices {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	a.resp.Choices = make([]ChatCompletionChoice, 0, len(indexes))
+	for _, i := range indexes {
+		a.resp.Choices = append(a.resp.Choices, *a.choices[i])
+	}
+	return a.resp, nil
+}
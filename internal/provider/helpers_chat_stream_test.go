@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+// chatStreamBody is a streamed completion with two choices, a tool call
+// split across chunks and a final usage chunk without choices.
+const chatStreamBody = `data: {"id":"chatcmpl-s","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o-2024-08-06","choices":[{"index":0,"delta":{"role":"assistant","content":""}},{"index":1,"delta":{"role":"assistant"}}]}
+
+data: {"id":"chatcmpl-s","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o-2024-08-06","choices":[{"index":0,"delta":{"content":"Hello"}},{"index":1,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"ci"}}]}}]}
+
+data: {"id":"chatcmpl-s","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o-2024-08-06","choices":[{"index":0,"delta":{"content":", world"}},{"index":1,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"ty\":\"Paris\"}"}}]}}]}
+
+data: {"id":"chatcmpl-s","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o-2024-08-06","choices":[{"index":0,"delta":{},"finish_reason":"stop"},{"index":1,"delta":{},"finish_reason":"tool_calls"}]}
+
+data: {"id":"chatcmpl-s","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o-2024-08-06","choices":[],"usage":{"prompt_tokens":9,"completion_tokens":4,"total_tokens":13}}
+
+data: [DONE]
+
+`
+
+func TestChatStreamAccumulator(t *testing.T) {
+	acc := &chatStreamAccumulator{}
+	if err := client.ReadSSE(strings.NewReader(chatStreamBody), acc.add); err != nil {
+		t.Fatal(err)
+	}
+	got, err := acc.response()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.ID != "chatcmpl-s" || got.Object != "chat.completion" || got.Model != "gpt-4o-2024-08-06" {
+		t.Errorf("id=%s object=%s model=%s", got.ID, got.Object, got.Model)
+	}
+	if got.Usage.PromptTokens != 9 || got.Usage.CompletionTokens != 4 || got.Usage.TotalTokens != 13 {
+		t.Errorf("usage = %+v, want 9/4/13 from the final chunk", got.Usage)
+	}
+	if len(got.Choices) != 2 {
+		t.Fatalf("got %d choices, want 2", len(got.Choices))
+	}
+	if c := got.Choices[0]; c.Message.Role != "assistant" || c.Message.Content != "Hello, world" || c.FinishReason != "stop" {
+		t.Errorf("choice 0 = %+v", c)
+	}
+	c := got.Choices[1]
+	if c.FinishReason != "tool_calls" || len(c.Message.ToolCalls) != 1 {
+		t.Fatalf("choice 1 = %+v", c)
+	}
+	if tc := c.Message.ToolCalls[0]; tc.ID != "call_1" || tc.Function.Name != "get_weather" || tc.Function.Arguments != `{"city":"Paris"}` {
+		t.Errorf("tool call = %+v, want the arguments joined across chunks", tc)
+	}
+
+	if _, err := (&chatStreamAccumulator{}).response(); err == nil {
+		t.Error("empty stream: want an error")
+	}
+}
+
+func TestChatCompletionCreate_Stream(t *testing.T) {
+	var sent ChatCompletionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(chatStreamBody))
+	}))
+	defer server.Close()
+
+	messages := []map[string]tftypes.Value{{
+		"role":    tftypes.NewValue(tftypes.String, "user"),
+		"content": tftypes.NewValue(tftypes.String, "Say hello"),
+	}}
+	resp := createChatCompletionWith(t, server.URL, messages, func(vals map[string]tftypes.Value) {
+		vals["stream"] = tftypes.NewValue(tftypes.Bool, true)
+		vals["seed"] = tftypes.NewValue(tftypes.Number, 42)
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if !sent.Stream || sent.StreamOptions == nil || !sent.StreamOptions.IncludeUsage {
+		t.Errorf("stream=%v stream_options=%+v, want include_usage requested by default", sent.Stream, sent.StreamOptions)
+	}
+	if sent.Seed == nil || *sent.Seed != 42 {
+		t.Errorf("seed sent = %v, want 42", sent.Seed)
+	}
+
+	var got ChatCompletionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.ID.ValueString() != "chatcmpl-s" || len(got.Choices.Elements()) != 2 {
+		t.Errorf("id=%s choices=%d, want chatcmpl-s with 2 choices", got.ID, len(got.Choices.Elements()))
+	}
+	if total := got.Usage.Elements()["total_tokens"]; total == nil || total.String() != "13" {
+		t.Errorf("usage = %v, want total_tokens 13 from the usage chunk", got.Usage)
+	}
+}
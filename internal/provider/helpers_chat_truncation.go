@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The provider does not bundle a BPE tokenizer, so token counts are
+// estimated conservatively: roughly four characters per token for content,
+// plus a fixed per-message overhead for role and formatting tokens.
+const (
+	charsPerToken          = 4
+	tokensPerMessage       = 4
+	tokensPerReplyPriming  = 3
+	defaultCompletionQuota = 1024
+)
+
+// modelContextWindows maps model name prefixes to their context window in
+// tokens. Lookups use the longest matching prefix, so dated snapshots such as
+// "gpt-4o-2024-08-06" resolve to their family.
+var modelContextWindows = map[string]int{
+	"gpt-3.5-turbo": 16385,
+	"gpt-4":         8192,
+	"gpt-4-32k":     32768,
+	"gpt-4-turbo":   128000,
+	"gpt-4o":        128000,
+	"gpt-4o-mini":   128000,
+	"gpt-4.1":       1047576,
+	"gpt-5":         400000,
+	"o1":            200000,
+	"o3":            200000,
+	"o4-mini":       200000,
+}
+
+// contextWindowForModel returns the context window for model, or false if the
+// model is not known.
+func contextWindowForModel(model string) (int, bool) {
+	prefixes := make([]string, 0, len(modelContextWindows))
+	for p := range modelContextWindows {
+		prefixes = append(prefixes, p)
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+
+	for _, p := range prefixes {
+		if model == p || strings.HasPrefix(model, p+"-") {
+			return modelContextWindows[p], true
+		}
+	}
+	return 0, false
+}
+
+// estimateMessageTokens returns the approximate number of prompt tokens a
+// single message consumes.
+func estimateMessageTokens(msg ChatCompletionMessage) int {
+	chars := len(msg.Role) + len(msg.Content) + len(msg.Name)
+	if msg.FunctionCall != nil {
+		chars += len(msg.FunctionCall.Name) + len(msg.FunctionCall.Arguments)
+	}
+	return tokensPerMessage + (chars+charsPerToken-1)/charsPerToken
+}
+
+// estimatePromptTokens returns the approximate prompt size of messages.
+func estimatePromptTokens(messages []ChatCompletionMessage) int {
+	total := tokensPerReplyPriming
+	for _, m := range messages {
+		total += estimateMessageTokens(m)
+	}
+	return total
+}
+
+// truncateMessagesToContext drops the oldest non-system messages until the
+// estimated prompt plus completionTokens fits in contextWindow. System
+// messages and the most recent message are always kept. The second return
+// value is the number of messages dropped.
+func truncateMessagesToContext(messages []ChatCompletionMessage, contextWindow, completionTokens int) ([]ChatCompletionMessage, int) {
+	budget := contextWindow - completionTokens
+	total := estimatePromptTokens(messages)
+	if total <= budget {
+		return messages, 0
+	}
+
+	keep := make([]bool, len(messages))
+	for i := range keep {
+		keep[i] = true
+	}
+
+	dropped := 0
+	for i := 0; i < len(messages)-1 && total > budget; i++ {
+		if messages[i].Role == messageRoleSystem {
+			continue
+		}
+		keep[i] = false
+		total -= estimateMessageTokens(messages[i])
+		dropped++
+	}
+
+	result := make([]ChatCompletionMessage, 0, len(messages)-dropped)
+	for i, m := range messages {
+		if keep[i] {
+			result = append(result, m)
+		}
+	}
+	return result, dropped
+}
+
+// truncateChatHistory applies `truncate_to_context` for the chat completion
+// resource and data source: it drops the oldest non-system messages so the
+// prompt, plus maxTokens (defaultCompletionQuota if unset) reserved for the
+// completion, fits model's context window. It warns when the window is not
+// known, and when the messages it must keep still do not fit; the request is
+// sent either way.
+func truncateChatHistory(ctx context.Context, messages []ChatCompletionMessage, model string, maxTokens int, diags *diag.Diagnostics) []ChatCompletionMessage {
+	contextWindow, ok := contextWindowForModel(model)
+	if !ok {
+		diags.AddAttributeWarning(path.Root("truncate_to_context"), "Unknown context window",
+			fmt.Sprintf("The context window for model %q is not known; messages were sent without truncation.", model))
+		return messages
+	}
+
+	completionTokens := defaultCompletionQuota
+	if maxTokens > 0 {
+		completionTokens = maxTokens
+	}
+	kept, dropped := truncateMessagesToContext(messages, contextWindow, completionTokens)
+	if dropped > 0 {
+		tflog.Debug(ctx, "Truncated chat history to fit context window", map[string]interface{}{
+			"model":          model,
+			"context_window": contextWindow,
+			"dropped":        dropped,
+		})
+	}
+	if prompt := estimatePromptTokens(kept); prompt+completionTokens > contextWindow {
+		diags.AddAttributeWarning(path.Root("truncate_to_context"), "Messages do not fit the context window",
+			fmt.Sprintf("After dropping %d messages, the system messages and the latest message are estimated at %d tokens. With %d tokens reserved for the completion, that exceeds the %d-token context window of model %q, so the API may reject the request.",
+				dropped, prompt, completionTokens, contextWindow, model))
+	}
+	return kept
+}
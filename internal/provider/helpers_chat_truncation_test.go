@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestContextWindowForModel(t *testing.T) {
+	cases := []struct {
+		model string
+		want  int
+		ok    bool
+	}{
+		{"gpt-4", 8192, true},
+		{"gpt-4-0613", 8192, true},
+		{"gpt-4-turbo", 128000, true},
+		{"gpt-4o-2024-08-06", 128000, true},
+		{"gpt-4o-mini", 128000, true},
+		{"gpt-3.5-turbo", 16385, true},
+		{"my-custom-model", 0, false},
+	}
+
+	for _, tc := range cases {
+		got, ok := contextWindowForModel(tc.model)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("contextWindowForModel(%q) = (%d, %v), want (%d, %v)", tc.model, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestTruncateMessagesToContext_OverLongHistory(t *testing.T) {
+	long := strings.Repeat("x", 4000) // ~1000 tokens each
+	messages := []ChatCompletionMessage{{Role: "system", Content: "You are a helpful assistant."}}
+	for i := 0; i < 20; i++ {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		messages = append(messages, ChatCompletionMessage{Role: role, Content: long})
+	}
+	messages = append(messages, ChatCompletionMessage{Role: "user", Content: "What is the answer?"})
+
+	const contextWindow, completionTokens = 8192, 1024
+	if estimatePromptTokens(messages) <= contextWindow-completionTokens {
+		t.Fatal("test history should exceed the context window")
+	}
+
+	got, dropped := truncateMessagesToContext(messages, contextWindow, completionTokens)
+
+	if n := estimatePromptTokens(got); n > contextWindow-completionTokens {
+		t.Errorf("truncated prompt is %d tokens, want <= %d", n, contextWindow-completionTokens)
+	}
+	if dropped == 0 || len(got) != len(messages)-dropped {
+		t.Errorf("dropped = %d, len(got) = %d, len(messages) = %d", dropped, len(got), len(messages))
+	}
+	if got[0].Role != "system" {
+		t.Errorf("system message was not preserved, first message role is %q", got[0].Role)
+	}
+	if last := got[len(got)-1]; last.Content != "What is the answer?" {
+		t.Errorf("latest message was not preserved, got %q", last.Content)
+	}
+	// Only the oldest messages are dropped, so the survivors are the tail.
+	tail := messages[len(messages)-(len(got)-1):]
+	for i, m := range got[1:] {
+		if m.Role != tail[i].Role || m.Content != tail[i].Content {
+			t.Fatalf("message %d is not from the most recent history", i+1)
+		}
+	}
+}
+
+func TestTruncateMessagesToContext_FitsUnchanged(t *testing.T) {
+	messages := []ChatCompletionMessage{
+		{Role: "system", Content: "Be brief."},
+		{Role: "user", Content: "Hello"},
+	}
+
+	got, dropped := truncateMessagesToContext(messages, 8192, 1024)
+	if dropped != 0 || len(got) != len(messages) {
+		t.Errorf("expected no truncation, dropped %d of %d", dropped, len(messages))
+	}
+}
+
+func TestTruncateChatHistory_Warnings(t *testing.T) {
+	long := strings.Repeat("x", 40000) // ~10000 tokens, more than gpt-4's window
+	cases := []struct {
+		name      string
+		model     string
+		messages  []ChatCompletionMessage
+		wantCount int
+		wantWarn  string
+	}{
+		{"fits after truncation", "gpt-4", []ChatCompletionMessage{{Role: "user", Content: long}, {Role: "user", Content: "Hi"}}, 1, ""},
+		{"latest message too long", "gpt-4", []ChatCompletionMessage{{Role: "user", Content: "Hi"}, {Role: "user", Content: long}}, 1, "Messages do not fit the context window"},
+		{"unknown model", "my-custom-model", []ChatCompletionMessage{{Role: "user", Content: long}, {Role: "user", Content: "Hi"}}, 2, "Unknown context window"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := truncateChatHistory(context.Background(), tc.messages, tc.model, 0, &diags)
+			if len(got) != tc.wantCount {
+				t.Errorf("kept %d messages, want %d", len(got), tc.wantCount)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			switch {
+			case tc.wantWarn == "" && diags.WarningsCount() != 0:
+				t.Errorf("unexpected warnings: %v", diags)
+			case tc.wantWarn != "" && (diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tc.wantWarn):
+				t.Errorf("warnings = %v, want %q", diags, tc.wantWarn)
+			}
+		})
+	}
+}
+
+func TestChatCompletionDataSourceRequest_Truncates(t *testing.T) {
+	long := strings.Repeat("x", 40000)
+	data := &ChatCompletionDataSourceModel{
+		Model: types.StringValue("gpt-4"),
+		Messages: []ChatCompletionDataSourceMessageModel{
+			{Role: types.StringValue("system"), Content: types.StringValue("Be brief.")},
+			{Role: types.StringValue("user"), Content: types.StringValue(long)},
+			{Role: types.StringValue("user"), Content: types.StringValue("Hi")},
+		},
+		TruncateToContext: types.BoolValue(true),
+	}
+
+	var diags diag.Diagnostics
+	req := chatCompletionDataSourceRequest(context.Background(), data, &diags)
+	if len(req.Messages) != 2 || req.Messages[0].Role != "system" || req.Messages[1].Content != "Hi" {
+		t.Errorf("messages = %+v, want the system message and the latest one", req.Messages)
+	}
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The static chunking bounds and the values the API applies for `auto`.
+const (
+	chunkingStrategyAuto   = "auto"
+	chunkingStrategyStatic = "static"
+
+	minChunkSizeTokens     = 100
+	maxChunkSizeTokens     = 4096
+	autoMaxChunkSizeTokens = 800
+	autoChunkOverlapTokens = 400
+)
+
+// chunkingStrategyBlock returns the chunking_strategy block shared by the
+// vector store file and file batch resources. Files are chunked once, when
+// they are added, so any change forces replacement.
+func chunkingStrategyBlock(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description + " Omit it, or set `type` to `auto`, for the API's default of 800-token chunks with a 400-token overlap. Changing it re-adds the files.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Either `auto` or `static`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(chunkingStrategyAuto, chunkingStrategyStatic),
+				},
+			},
+			"max_chunk_size_tokens": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of tokens in each chunk. The minimum is 100 and the maximum is 4096. Required for `static`.",
+				Validators: []validator.Int64{
+					int64validator.Between(minChunkSizeTokens, maxChunkSizeTokens),
+				},
+			},
+			"chunk_overlap_tokens": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of tokens that overlap between chunks. The maximum is half of max_chunk_size_tokens. Only for `static`, where it defaults to 0.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			chunkingStrategyValidator{},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// chunkingStrategyRequest converts the configured block into the API's
+// chunking_strategy, or nil when it is not set.
+func chunkingStrategyRequest(m *VSChunkingStrategyModel) *ChunkingStrategy {
+	if m == nil {
+		return nil
+	}
+	cs := &ChunkingStrategy{Type: m.Type.ValueString()}
+	if cs.Type == chunkingStrategyStatic {
+		cs.Static = &StaticChunking{
+			MaxChunkSizeTokens: int(m.MaxChunkSizeTokens.ValueInt64()),
+			ChunkOverlapTokens: int(m.ChunkOverlapTokens.ValueInt64()),
+		}
+	}
+	return cs
+}
+
+// chunkingStrategyFromAPI returns the chunking_strategy to keep in state
+// given the prior value and the strategy the API reports for the files.
+// The API reports `auto` as static chunking with its default sizes, so that
+// matches an omitted block or `type = "auto"`; anything else that differs
+// from prior is returned as it was applied, showing up as drift. Files
+// chunked before strategies existed report `other`, which is not compared.
+func chunkingStrategyFromAPI(prior *VSChunkingStrategyModel, reported *ChunkingStrategy) *VSChunkingStrategyModel {
+	if reported == nil {
+		return prior
+	}
+	isAuto := prior == nil || prior.Type.ValueString() == chunkingStrategyAuto
+
+	switch reported.Type {
+	case chunkingStrategyAuto:
+		if isAuto {
+			return prior
+		}
+		return &VSChunkingStrategyModel{
+			Type:               types.StringValue(chunkingStrategyAuto),
+			MaxChunkSizeTokens: types.Int64Null(),
+			ChunkOverlapTokens: types.Int64Null(),
+		}
+	case chunkingStrategyStatic:
+		if reported.Static == nil {
+			return prior
+		}
+		size, overlap := int64(reported.Static.MaxChunkSizeTokens), int64(reported.Static.ChunkOverlapTokens)
+		if isAuto && size == autoMaxChunkSizeTokens && overlap == autoChunkOverlapTokens {
+			return prior
+		}
+		// A null chunk_overlap_tokens was sent as 0.
+		if !isAuto && prior.Type.ValueString() == chunkingStrategyStatic &&
+			prior.MaxChunkSizeTokens.ValueInt64() == size && prior.ChunkOverlapTokens.ValueInt64() == overlap {
+			return prior
+		}
+		return &VSChunkingStrategyModel{
+			Type:               types.StringValue(chunkingStrategyStatic),
+			MaxChunkSizeTokens: types.Int64Value(size),
+			ChunkOverlapTokens: types.Int64Value(overlap),
+		}
+	default:
+		return prior
+	}
+}
+
+// chunkingStrategyValidator checks that the sizes are only set for
+// `static`, which needs max_chunk_size_tokens, and that the overlap is at
+// most half the chunk size.
+type chunkingStrategyValidator struct{}
+
+func (v chunkingStrategyValidator) Description(ctx context.Context) string {
+	return "max_chunk_size_tokens must be set when type is static, and the sizes only then"
+}
+
+func (v chunkingStrategyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v chunkingStrategyValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var cs VSChunkingStrategyModel
+	if diags := req.Config.GetAttribute(ctx, req.Path, &cs); diags.HasError() || cs.Type.IsUnknown() {
+		return
+	}
+
+	sizes := []struct {
+		name  string
+		value types.Int64
+	}{
+		{"max_chunk_size_tokens", cs.MaxChunkSizeTokens},
+		{"chunk_overlap_tokens", cs.ChunkOverlapTokens},
+	}
+
+	if cs.Type.ValueString() != chunkingStrategyStatic {
+		for _, a := range sizes {
+			if !a.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(req.Path.AtName(a.name), "Invalid chunking_strategy attribute",
+					fmt.Sprintf("chunking_strategy.%s only applies when type is %q.", a.name, chunkingStrategyStatic))
+			}
+		}
+		return
+	}
+	if cs.MaxChunkSizeTokens.IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("max_chunk_size_tokens"), "Missing chunking_strategy attribute",
+			fmt.Sprintf("chunking_strategy.max_chunk_size_tokens is required when type is %q.", chunkingStrategyStatic))
+		return
+	}
+	if cs.MaxChunkSizeTokens.IsUnknown() || cs.ChunkOverlapTokens.IsUnknown() {
+		return
+	}
+	if size := cs.MaxChunkSizeTokens.ValueInt64(); cs.ChunkOverlapTokens.ValueInt64() > size/2 {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("chunk_overlap_tokens"), "Invalid chunking_strategy attribute",
+			fmt.Sprintf("chunking_strategy.chunk_overlap_tokens must not exceed half of max_chunk_size_tokens (%d).", size/2))
+	}
+}
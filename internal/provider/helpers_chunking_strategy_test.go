@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func staticChunking(size, overlap int64) *VSChunkingStrategyModel {
+	return &VSChunkingStrategyModel{
+		Type:               types.StringValue(chunkingStrategyStatic),
+		MaxChunkSizeTokens: types.Int64Value(size),
+		ChunkOverlapTokens: types.Int64Value(overlap),
+	}
+}
+
+func TestChunkingStrategyFromAPI(t *testing.T) {
+	auto := &VSChunkingStrategyModel{Type: types.StringValue(chunkingStrategyAuto), MaxChunkSizeTokens: types.Int64Null(), ChunkOverlapTokens: types.Int64Null()}
+	noOverlap := &VSChunkingStrategyModel{Type: types.StringValue(chunkingStrategyStatic), MaxChunkSizeTokens: types.Int64Value(600), ChunkOverlapTokens: types.Int64Null()}
+	reportedStatic := func(size, overlap int) *ChunkingStrategy {
+		return &ChunkingStrategy{Type: chunkingStrategyStatic, Static: &StaticChunking{MaxChunkSizeTokens: size, ChunkOverlapTokens: overlap}}
+	}
+
+	tests := []struct {
+		name     string
+		prior    *VSChunkingStrategyModel
+		reported *ChunkingStrategy
+		want     *VSChunkingStrategyModel
+	}{
+		{"unset and default sizes", nil, reportedStatic(800, 400), nil},
+		{"auto and default sizes", auto, reportedStatic(800, 400), auto},
+		{"unset and custom sizes", nil, reportedStatic(1200, 200), staticChunking(1200, 200)},
+		{"static matching", staticChunking(1200, 200), reportedStatic(1200, 200), staticChunking(1200, 200)},
+		{"static without overlap", noOverlap, reportedStatic(600, 0), noOverlap},
+		{"static drifted", staticChunking(1200, 200), reportedStatic(800, 400), staticChunking(800, 400)},
+		{"static reported as auto", staticChunking(1200, 200), &ChunkingStrategy{Type: chunkingStrategyAuto}, auto},
+		{"other is not compared", staticChunking(1200, 200), &ChunkingStrategy{Type: "other"}, staticChunking(1200, 200)},
+		{"not reported", nil, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chunkingStrategyFromAPI(tt.prior, tt.reported)
+			if (got == nil) != (tt.want == nil) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+			if got != nil && (!got.Type.Equal(tt.want.Type) || !got.MaxChunkSizeTokens.Equal(tt.want.MaxChunkSizeTokens) || !got.ChunkOverlapTokens.Equal(tt.want.ChunkOverlapTokens)) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkingStrategyValidator(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&VectorStoreFileResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	csType := objType.AttributeTypes["chunking_strategy"].(tftypes.Object)
+
+	tests := []struct {
+		name    string
+		set     map[string]interface{}
+		wantErr bool
+	}{
+		{"auto", map[string]interface{}{"type": "auto"}, false},
+		{"auto with sizes", map[string]interface{}{"type": "auto", "max_chunk_size_tokens": 800}, true},
+		{"static", map[string]interface{}{"type": "static", "max_chunk_size_tokens": 1200, "chunk_overlap_tokens": 600}, false},
+		{"static without overlap", map[string]interface{}{"type": "static", "max_chunk_size_tokens": 1200}, false},
+		{"static without size", map[string]interface{}{"type": "static", "chunk_overlap_tokens": 100}, true},
+		{"static overlap above half", map[string]interface{}{"type": "static", "max_chunk_size_tokens": 1200, "chunk_overlap_tokens": 601}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := map[string]tftypes.Value{}
+			for name, typ := range objType.AttributeTypes {
+				vals[name] = tftypes.NewValue(typ, nil)
+			}
+			vals["chunking_strategy"] = responseFormatValue(csType, tt.set)
+			config := tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+			var value types.Object
+			config.GetAttribute(ctx, path.Root("chunking_strategy"), &value)
+			resp := &validator.ObjectResponse{}
+			chunkingStrategyValidator{}.ValidateObject(ctx, validator.ObjectRequest{
+				Path:        path.Root("chunking_strategy"),
+				Config:      config,
+				ConfigValue: value,
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestVectorStoreFileRead_ChunkingStrategyDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":                "file-abc",
+			"object":            "vector_store.file",
+			"vector_store_id":   "vs_abc",
+			"status":            "completed",
+			"created_at":        1700000000,
+			"usage_bytes":       2048,
+			"chunking_strategy": map[string]interface{}{"type": "static", "static": map[string]interface{}{"max_chunk_size_tokens": 800, "chunk_overlap_tokens": 400}},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VectorStoreFileResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	read := func(prior *VSChunkingStrategyModel) *VSChunkingStrategyModel {
+		t.Helper()
+		state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+		resp := &resource.ReadResponse{State: state}
+		resp.Diagnostics.Append(state.Set(ctx, &VectorStoreFileResourceModel{
+			ID:               types.StringValue("file-abc"),
+			VectorStoreID:    types.StringValue("vs_abc"),
+			FileID:           types.StringValue("file-abc"),
+			ChunkingStrategy: prior,
+			Attributes:       types.MapNull(types.StringType),
+		})...)
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		var got VectorStoreFileResourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		return got.ChunkingStrategy
+	}
+
+	if got := read(nil); got != nil {
+		t.Errorf("auto-chunked file without a block read back as %+v, want no block", got)
+	}
+	got := read(staticChunking(1200, 200))
+	if got == nil || got.MaxChunkSizeTokens.ValueInt64() != 800 || got.ChunkOverlapTokens.ValueInt64() != 400 {
+		t.Errorf("chunking_strategy = %+v, want the 800/400 the API reports so the change shows as drift", got)
+	}
+}
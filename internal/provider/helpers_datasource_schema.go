@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// dataSourceAttribute converts a resource schema attribute into the
+// equivalent data source attribute, so a data source that takes the same
+// inputs as a resource can reuse the resource's attribute builders. Plan
+// modifiers have no data source counterpart and are dropped; descriptions,
+// validators and nested attributes are kept.
+//
+// Only the attribute kinds the builders use are supported; any other kind is
+// a programming error and panics when the schema is built.
+func dataSourceAttribute(a rschema.Attribute) dschema.Attribute {
+	switch a := a.(type) {
+	case rschema.StringAttribute:
+		return dschema.StringAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+			Validators:          a.Validators,
+		}
+	case rschema.BoolAttribute:
+		return dschema.BoolAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+			Validators:          a.Validators,
+		}
+	case rschema.ListNestedAttribute:
+		return dschema.ListNestedAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+			Validators:          a.Validators,
+			NestedObject: dschema.NestedAttributeObject{
+				Attributes: dataSourceAttributes(a.NestedObject.Attributes),
+				Validators: a.NestedObject.Validators,
+			},
+		}
+	case rschema.SingleNestedAttribute:
+		return dschema.SingleNestedAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+			Validators:          a.Validators,
+			Attributes:          dataSourceAttributes(a.Attributes),
+		}
+	}
+	panic(fmt.Sprintf("dataSourceAttribute: unsupported attribute type %T", a))
+}
+
+func dataSourceAttributes(attrs map[string]rschema.Attribute) map[string]dschema.Attribute {
+	out := make(map[string]dschema.Attribute, len(attrs))
+	for name, a := range attrs {
+		out[name] = dataSourceAttribute(a)
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// debugAttribute is the schema for the per-resource `debug` flag. It only
+// affects logging, so changing it never replaces the resource. Only
+// openai_fine_tuning_job has the flag so far; the provider docs say so.
+func debugAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Log this resource's API operations at TRACE level, regardless of the provider's log level. Output still goes to Terraform's log, so `TF_LOG` (or `TF_LOG_PATH`) must be set to see it.",
+	}
+}
+
+// withResourceLogging returns ctx with a logging subsystem for one resource
+// type. The subsystem logs at INFO, or at the level set in
+// TF_LOG_PROVIDER_OPENAI_<SUBSYSTEM>; debug raises it to TRACE.
+func withResourceLogging(ctx context.Context, subsystem string, debug types.Bool) context.Context {
+	level := hclog.LevelFromString(os.Getenv("TF_LOG_PROVIDER_OPENAI_" + strings.ToUpper(subsystem)))
+	if level == hclog.NoLevel {
+		level = hclog.Info
+	}
+	if debug.ValueBool() {
+		level = hclog.Trace
+	}
+	return tflog.NewSubsystem(ctx, subsystem, tflog.WithLevel(level))
+}
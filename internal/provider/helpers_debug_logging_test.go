@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestWithResourceLogging_DebugOnlyAffectsOneSubsystem(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_OPENAI_FINE_TUNING_JOB", "")
+	t.Setenv("TF_LOG_PROVIDER_OPENAI_BATCH", "")
+
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &out)
+
+	debugCtx := withResourceLogging(ctx, "fine_tuning_job", types.BoolValue(true))
+	quietCtx := withResourceLogging(ctx, "batch", types.BoolNull())
+
+	tflog.SubsystemDebug(debugCtx, "fine_tuning_job", "debug from fine-tuning")
+	tflog.SubsystemDebug(quietCtx, "batch", "debug from batch")
+	tflog.SubsystemInfo(quietCtx, "batch", "info from batch")
+
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil {
+		t.Fatalf("decode logs: %v", err)
+	}
+
+	var messages []string
+	for _, e := range entries {
+		messages = append(messages, e["@message"].(string))
+	}
+	got := strings.Join(messages, "|")
+	if got != "debug from fine-tuning|info from batch" {
+		t.Errorf("logged messages = %q, want the fine-tuning debug line and only the batch info line", got)
+	}
+}
+
+func TestWithResourceLogging_EnvLevel(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_OPENAI_BATCH", "DEBUG")
+
+	var out bytes.Buffer
+	ctx := withResourceLogging(tflogtest.RootLogger(context.Background(), &out), "batch", types.BoolValue(false))
+	tflog.SubsystemDebug(ctx, "batch", "debug from batch")
+	tflog.SubsystemTrace(ctx, "batch", "trace from batch")
+
+	if !strings.Contains(out.String(), "debug from batch") || strings.Contains(out.String(), "trace from batch") {
+		t.Errorf("expected only the debug line with TF_LOG_PROVIDER_OPENAI_BATCH=DEBUG, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// imageDataType is the element type of the data attribute of the image
+// resources. Only image generation returns revised_prompt.
+func imageDataType(withRevisedPrompt bool) types.ObjectType {
+	attrTypes := map[string]attr.Type{
+		"url":      types.StringType,
+		"b64_json": types.StringType,
+	}
+	if withRevisedPrompt {
+		attrTypes["revised_prompt"] = types.StringType
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+// parseImageResponse decodes the response of the image generation, edit
+// and variation endpoints.
+func parseImageResponse(body []byte) (*ImageResponseFramework, error) {
+	var imgResp ImageResponseFramework
+	if err := json.Unmarshal(body, &imgResp); err != nil {
+		return nil, err
+	}
+	return &imgResp, nil
+}
+
+// imageDataList converts the returned images into the data attribute, or a
+// null list when there are none.
+func imageDataList(images []ImageDataFramework, withRevisedPrompt bool) (types.List, diag.Diagnostics) {
+	elemType := imageDataType(withRevisedPrompt)
+	if len(images) == 0 {
+		return types.ListNull(elemType), nil
+	}
+
+	var diags diag.Diagnostics
+	objs := make([]attr.Value, 0, len(images))
+	for _, d := range images {
+		attrs := map[string]attr.Value{
+			"url":      types.StringValue(d.URL),
+			"b64_json": types.StringValue(d.B64JSON),
+		}
+		if withRevisedPrompt {
+			attrs["revised_prompt"] = types.StringValue(d.RevisedPrompt)
+		}
+		obj, d := types.ObjectValue(elemType.AttrTypes, attrs)
+		diags.Append(d...)
+		objs = append(objs, obj)
+	}
+	list, d := types.ListValue(elemType, objs)
+	diags.Append(d...)
+	return list, diags
+}
+
+// imageOutputDirAttribute and imageOutputFilesAttribute are the optional
+// local output of the image resources.
+func imageOutputDirAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Directory to save the images to, as `<id>-<index>.<format>`. It is created if missing. Images returned as URLs are downloaded. The files are removed on destroy, and a missing file causes the images to be generated again.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func imageOutputFilesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Paths of the files written to `output_dir`, in the order of `data`.",
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// writeImageFiles saves images to dir as <prefix>-<index>.<ext>, decoding
+// b64_json or downloading the URL with httpClient, and returns the paths.
+func writeImageFiles(ctx context.Context, httpClient *http.Client, dir, prefix, ext string, images []ImageDataFramework) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	paths := make([]string, 0, len(images))
+	for i, img := range images {
+		var content []byte
+		switch {
+		case img.B64JSON != "":
+			decoded, err := base64.StdEncoding.DecodeString(img.B64JSON)
+			if err != nil {
+				return paths, fmt.Errorf("decoding image %d: %w", i, err)
+			}
+			content = decoded
+		case img.URL != "":
+			downloaded, err := downloadImage(ctx, httpClient, img.URL)
+			if err != nil {
+				return paths, fmt.Errorf("downloading image %d: %w", i, err)
+			}
+			content = downloaded
+		default:
+			return paths, fmt.Errorf("image %d has neither url nor b64_json", i)
+		}
+
+		p := filepath.Join(dir, fmt.Sprintf("%s-%d.%s", prefix, i, ext))
+		if err := os.WriteFile(p, content, 0644); err != nil {
+			return paths, fmt.Errorf("writing %s: %w", p, err)
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// imageOutputFiles writes images to outputDir, when set, and returns the
+// output_files value.
+func imageOutputFiles(ctx context.Context, httpClient *http.Client, outputDir types.String, prefix, ext string, images []ImageDataFramework) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if outputDir.IsNull() || outputDir.ValueString() == "" {
+		return types.ListNull(types.StringType), diags
+	}
+
+	paths, err := writeImageFiles(ctx, httpClient, outputDir.ValueString(), prefix, ext, images)
+	if err != nil {
+		diags.AddAttributeError(path.Root("output_dir"), "Error saving images", err.Error())
+		return types.ListNull(types.StringType), diags
+	}
+	files, d := types.ListValueFrom(ctx, types.StringType, paths)
+	diags.Append(d...)
+	return files, diags
+}
+
+func downloadImage(ctx context.Context, httpClient *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// imageOutputFilesExist reports whether every file in files still exists.
+func imageOutputFilesExist(ctx context.Context, files types.List) bool {
+	var paths []string
+	if files.IsNull() || files.IsUnknown() || files.ElementsAs(ctx, &paths, false).HasError() {
+		return true
+	}
+	for _, p := range paths {
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			return false
+		}
+	}
+	return true
+}
+
+// removeImageFiles deletes the files in files, ignoring any already gone.
+func removeImageFiles(ctx context.Context, files types.List) {
+	var paths []string
+	if files.IsNull() || files.IsUnknown() || files.ElementsAs(ctx, &paths, false).HasError() {
+		return
+	}
+	for _, p := range paths {
+		os.Remove(p)
+	}
+}
+
+// stringNullIfUnknown and int64NullIfUnknown resolve Optional+Computed
+// arguments left to the API's default, which the image endpoints do not
+// echo back, to null after apply.
+func stringNullIfUnknown(v types.String) types.String {
+	if v.IsUnknown() {
+		return types.StringNull()
+	}
+	return v
+}
+
+func int64NullIfUnknown(v types.Int64) types.Int64 {
+	if v.IsUnknown() {
+		return types.Int64Null()
+	}
+	return v
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// itemErrors collects the failures of an operation applied to several items,
+// such as the roles of a project member, so that every failing item is
+// reported instead of only the first. Each failure becomes its own error
+// diagnostic, sharing the summary and naming the item in the detail.
+type itemErrors struct {
+	summary  string
+	failures []itemError
+}
+
+type itemError struct {
+	item string
+	err  error
+}
+
+func newItemErrors(summary string) *itemErrors {
+	return &itemErrors{summary: summary}
+}
+
+// Add records err for item. A nil err is ignored, so callers can pass the
+// result of each item's operation straight through.
+func (e *itemErrors) Add(item string, err error) {
+	if err != nil {
+		e.failures = append(e.failures, itemError{item: item, err: err})
+	}
+}
+
+// Diagnostics returns one error diagnostic per recorded failure, in the
+// order they were added.
+func (e *itemErrors) Diagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, f := range e.failures {
+		diags.AddError(e.summary, fmt.Sprintf("%s: %s", f.item, f.err))
+	}
+	return diags
+}
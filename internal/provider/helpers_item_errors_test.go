@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestItemErrors(t *testing.T) {
+	failures := newItemErrors("Error assigning role")
+	failures.Add("role a", errors.New("boom"))
+	failures.Add("role b", nil)
+	failures.Add("role c", errors.New("bang"))
+
+	diags := failures.Diagnostics()
+	if len(diags) != 2 || diags.ErrorsCount() != 2 {
+		t.Fatalf("diagnostics = %v, want 2 errors", diags)
+	}
+	if diags[0].Summary() != "Error assigning role" || diags[0].Detail() != "role a: boom" || diags[1].Detail() != "role c: bang" {
+		t.Errorf("diagnostics = %v", diags)
+	}
+	if len(newItemErrors("unused").Diagnostics()) != 0 {
+		t.Error("expected no diagnostics without failures")
+	}
+}
+
+func TestProjectUserDelete_ReportsEveryFailedRole(t *testing.T) {
+	resetAdminSemaphoreForTest(adminConcurrencyDefault)
+	resetAdminBucketForTest(100000, 1000)
+
+	var removed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/roles/") {
+			roleID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": map[string]interface{}{"message": "cannot remove " + roleID}})
+			return
+		}
+		removed = true
+		writeJSON(w, http.StatusOK, map[string]interface{}{"deleted": true})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &ProjectUserResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	roles := []tftypes.Value{}
+	for _, id := range []string{"role_1", "role_2", "role_3"} {
+		roles = append(roles, tftypes.NewValue(tftypes.String, id))
+	}
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["id"] = tftypes.NewValue(tftypes.String, "proj_1:user_1")
+	vals["project_id"] = tftypes.NewValue(tftypes.String, "proj_1")
+	vals["user_id"] = tftypes.NewValue(tftypes.String, "user_1")
+	vals["role_ids"] = tftypes.NewValue(objType.AttributeTypes["role_ids"], roles)
+
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if got := resp.Diagnostics.ErrorsCount(); got != 3 {
+		t.Fatalf("got %d errors, want one per role: %v", got, resp.Diagnostics)
+	}
+	for i, id := range []string{"role_1", "role_2", "role_3"} {
+		detail := resp.Diagnostics.Errors()[i].Detail()
+		if !strings.HasPrefix(detail, "role "+id+": ") || !strings.Contains(detail, "cannot remove "+id) {
+			t.Errorf("error %d detail = %q, want it to name %s", i, detail, id)
+		}
+	}
+	if removed {
+		t.Error("user was removed from the project although its roles could not be unassigned")
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import "regexp"
+
+// Published list prices used for cost estimates. They are not fetched from
+// the API, so estimates drift if OpenAI changes its pricing.
+const (
+	// vectorStoreUSDPerGBDay is the storage price of vector stores. The
+	// first GB is free, but that allowance is per organization, so
+	// per-store estimates do not subtract it.
+	vectorStoreUSDPerGBDay = 0.10
+
+	bytesPerGB       = 1e9
+	daysPerMonth     = 30
+	tokensPerMillion = 1e6
+)
+
+// estimateVectorStoreMonthlyCost returns the estimated storage cost in USD of
+// keeping usageBytes in a vector store for a 30-day month.
+func estimateVectorStoreMonthlyCost(usageBytes int64) float64 {
+	return float64(usageBytes) / bytesPerGB * vectorStoreUSDPerGBDay * daysPerMonth
+}
+
+// tokenPrice is the list price in USD per million tokens of a model. Audio
+// tokens are billed separately from text tokens on audio-capable models.
+type tokenPrice struct {
+	TextInput, TextOutput   float64
+	AudioInput, AudioOutput float64
+}
+
+// tokenPrices is keyed by model name; dated snapshots such as
+// gpt-4o-audio-preview-2024-12-17 use the price of their alias.
+var tokenPrices = map[string]tokenPrice{
+	"gpt-4o":                    {TextInput: 2.50, TextOutput: 10.00},
+	"gpt-4o-mini":               {TextInput: 0.15, TextOutput: 0.60},
+	"gpt-4o-audio-preview":      {TextInput: 2.50, TextOutput: 10.00, AudioInput: 40.00, AudioOutput: 80.00},
+	"gpt-4o-mini-audio-preview": {TextInput: 0.15, TextOutput: 0.60, AudioInput: 10.00, AudioOutput: 20.00},
+}
+
+// lookupTokenPrice returns the price of model or of the alias it is a dated
+// snapshot of.
+func lookupTokenPrice(model string) (tokenPrice, bool) {
+	if p, ok := tokenPrices[model]; ok {
+		return p, true
+	}
+	alias := regexp.MustCompile(snapshotSuffix).ReplaceAllString(model, "")
+	p, ok := tokenPrices[alias]
+	return p, ok
+}
+
+// estimateChatCompletionCost returns the estimated cost in USD of a chat
+// completion, charging the audio share of prompt and completion tokens at
+// the model's audio rates. ok is false when the model has no known price.
+func estimateChatCompletionCost(model string, usage ChatCompletionUsage) (cost float64, ok bool) {
+	price, ok := lookupTokenPrice(model)
+	if !ok {
+		return 0, false
+	}
+
+	var audioIn, audioOut int
+	if usage.PromptTokensDetails != nil {
+		audioIn = usage.PromptTokensDetails.AudioTokens
+	}
+	if usage.CompletionTokensDetails != nil {
+		audioOut = usage.CompletionTokensDetails.AudioTokens
+	}
+
+	cost = float64(usage.PromptTokens-audioIn)*price.TextInput +
+		float64(usage.CompletionTokens-audioOut)*price.TextOutput +
+		float64(audioIn)*price.AudioInput +
+		float64(audioOut)*price.AudioOutput
+	return cost / tokensPerMillion, true
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestEstimateVectorStoreMonthlyCost(t *testing.T) {
+	cases := []struct {
+		name       string
+		usageBytes int64
+		want       float64
+	}{
+		{"empty", 0, 0},
+		{"one GB", 1_000_000_000, 3.0},
+		{"half GB", 500_000_000, 1.5},
+		{"small store", 2_048_000, 0.006144},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := estimateVectorStoreMonthlyCost(tc.usageBytes); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("estimateVectorStoreMonthlyCost(%d) = %v, want %v", tc.usageBytes, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVectorStoreResponse_DecodesUsageBytes(t *testing.T) {
+	body := `{"id":"vs_1","object":"vector_store","status":"completed","usage_bytes":2500000000}`
+	var vs VectorStoreResponse
+	if err := json.Unmarshal([]byte(body), &vs); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if vs.UsageBytes != 2_500_000_000 {
+		t.Fatalf("usage_bytes = %d, want 2500000000", vs.UsageBytes)
+	}
+
+	var data VectorStoreResourceModel
+	setVectorStoreComputed(&data, &vs)
+	if got := data.EstimatedMonthlyCost.ValueFloat64(); math.Abs(got-7.5) > 1e-9 {
+		t.Errorf("estimated_monthly_cost = %v, want 7.5", got)
+	}
+}
+
+func TestEstimateChatCompletionCost_AudioTokens(t *testing.T) {
+	body := `{
+		"id": "chatcmpl-1",
+		"object": "chat.completion",
+		"model": "gpt-4o-audio-preview-2024-12-17",
+		"choices": [],
+		"usage": {
+			"prompt_tokens": 1000,
+			"completion_tokens": 2000,
+			"total_tokens": 3000,
+			"prompt_tokens_details": {"audio_tokens": 400, "cached_tokens": 0},
+			"completion_tokens_details": {"audio_tokens": 1500}
+		}
+	}`
+	var completion ChatCompletionResponse
+	if err := json.Unmarshal([]byte(body), &completion); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	// 600 text in at $2.50, 500 text out at $10, 400 audio in at $40 and
+	// 1500 audio out at $80, all per million tokens.
+	want := (600*2.50 + 500*10.00 + 400*40.00 + 1500*80.00) / 1e6
+	got, ok := estimateChatCompletionCost(completion.Model, completion.Usage)
+	if !ok || math.Abs(got-want) > 1e-12 {
+		t.Errorf("estimateChatCompletionCost = %v, %v, want %v", got, ok, want)
+	}
+
+	// The mini audio model must not take gpt-4o-mini's text-only price.
+	got, ok = estimateChatCompletionCost("gpt-4o-mini-audio-preview", completion.Usage)
+	want = (600*0.15 + 500*0.60 + 400*10.00 + 1500*20.00) / 1e6
+	if !ok || math.Abs(got-want) > 1e-12 {
+		t.Errorf("gpt-4o-mini-audio-preview cost = %v, %v, want %v", got, ok, want)
+	}
+
+	// Without token details everything is charged as text.
+	got, ok = estimateChatCompletionCost("gpt-4o-2024-08-06", ChatCompletionUsage{PromptTokens: 1000, CompletionTokens: 100})
+	if want := (1000*2.50 + 100*10.00) / 1e6; !ok || math.Abs(got-want) > 1e-12 {
+		t.Errorf("gpt-4o cost = %v, %v, want %v", got, ok, want)
+	}
+
+	if _, ok := estimateChatCompletionCost("gpt-4o-audio", completion.Usage); ok {
+		t.Error("unknown model gpt-4o-audio was priced")
+	}
+}
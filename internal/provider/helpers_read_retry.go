@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+// readRetryAttempts and readRetryBackoff bound readWithRetry: five attempts
+// with a doubling backoff wait about three seconds in all.
+var (
+	readRetryAttempts = 5
+	readRetryBackoff  = 200 * time.Millisecond
+)
+
+// readWithRetry calls read until it returns something other than a 404. Some
+// endpoints, notably projects and vector stores, answer 404 for a moment
+// after a create, so it is for the first read of an object the provider has
+// just created. It must not wrap refreshes, where a 404 means the object is
+// gone and should be removed from state.
+func readWithRetry[T any](ctx context.Context, read func() (T, error)) (T, error) {
+	backoff := readRetryBackoff
+	for attempt := 1; ; attempt++ {
+		v, err := read()
+		if !client.IsNotFound(err) || attempt >= readRetryAttempts {
+			return v, err
+		}
+
+		tflog.Debug(ctx, "Object not readable yet after create, retrying", map[string]interface{}{
+			"attempt": attempt,
+			"error":   err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return v, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+func TestReadWithRetry(t *testing.T) {
+	defer func(d time.Duration) { readRetryBackoff = d }(readRetryBackoff)
+	readRetryBackoff = time.Millisecond
+
+	notFound := &client.APIError{StatusCode: http.StatusNotFound, Message: "not found"}
+	boom := errors.New("boom")
+
+	for name, tc := range map[string]struct {
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		"404 until readable":       {errs: []error{notFound, notFound, nil}, wantCalls: 3},
+		"other errors not retried": {errs: []error{boom}, wantCalls: 1, wantErr: boom},
+		"gives up after attempts":  {errs: []error{notFound, notFound, notFound, notFound, notFound, nil}, wantCalls: readRetryAttempts, wantErr: notFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			got, err := readWithRetry(context.Background(), func() (string, error) {
+				err := tc.errs[calls]
+				calls++
+				if err != nil {
+					return "", err
+				}
+				return "ok", nil
+			})
+			if calls != tc.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tc.wantCalls)
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("err = %v, want %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil && got != "ok" {
+				t.Errorf("got %q, want ok", got)
+			}
+		})
+	}
+}
+
+func TestReadWithRetry_StopsWhenContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	_, err := readWithRetry(ctx, func() (string, error) {
+		calls++
+		return "", &client.APIError{StatusCode: http.StatusNotFound}
+	})
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Errorf("err = %v after %d calls, want context.Canceled after 1", err, calls)
+	}
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	responseFormatText       = "text"
+	responseFormatJSONObject = "json_object"
+	responseFormatJSONSchema = "json_schema"
+)
+
+// ResponseFormatModel is the response_format attribute shared by
+// openai_chat_completion and openai_response. The chat API nests name,
+// description, schema and strict under `json_schema`; the Responses API takes
+// them flat under `text.format`.
+type ResponseFormatModel struct {
+	Type        types.String `tfsdk:"type"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Schema      types.String `tfsdk:"schema"`
+	Strict      types.Bool   `tfsdk:"strict"`
+}
+
+// responseFormatAttribute returns the schema of the response_format
+// attribute. A different format yields a different output, so changing it
+// forces a new resource.
+func responseFormatAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Constrains the format of the model's output. Use `json_schema` (Structured Outputs) to force JSON matching `schema`, which downstream configuration can read with `jsondecode`.",
+		Optional:            true,
+		Validators:          []validator.Object{responseFormatValidator{}},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The output format. One of `text`, `json_object` or `json_schema`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(responseFormatText, responseFormatJSONObject, responseFormatJSONSchema),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the schema. Required for `json_schema`.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "What the output is for, used by the model to decide how to respond.",
+				Optional:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The JSON Schema the output must match, as a JSON string. Required for `json_schema`.",
+				Optional:            true,
+				Validators:          []validator.String{jsonStringValidator{}},
+			},
+			"strict": schema.BoolAttribute{
+				MarkdownDescription: "Whether the output must follow `schema` exactly. Strict mode supports a subset of JSON Schema.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// chatResponseFormat builds the chat completions `response_format` payload.
+func (rf *ResponseFormatModel) chatResponseFormat() *ChatResponseFormat {
+	out := &ChatResponseFormat{Type: rf.Type.ValueString()}
+	if out.Type == responseFormatJSONSchema {
+		out.JSONSchema = &ChatJSONSchema{
+			Name:        rf.Name.ValueString(),
+			Description: rf.Description.ValueString(),
+			Schema:      json.RawMessage(rf.Schema.ValueString()),
+			Strict:      rf.Strict.ValueBoolPointer(),
+		}
+	}
+	return out
+}
+
+// textFormat builds the Responses API `text.format` payload.
+func (rf *ResponseFormatModel) textFormat() map[string]interface{} {
+	format := map[string]interface{}{"type": rf.Type.ValueString()}
+	if rf.Type.ValueString() == responseFormatJSONSchema {
+		format["name"] = rf.Name.ValueString()
+		format["schema"] = json.RawMessage(rf.Schema.ValueString())
+		if !rf.Description.IsNull() {
+			format["description"] = rf.Description.ValueString()
+		}
+		if !rf.Strict.IsNull() {
+			format["strict"] = rf.Strict.ValueBool()
+		}
+	}
+	return format
+}
+
+// responseFormatValidator checks that name and schema are set for
+// `json_schema`, and only for it.
+type responseFormatValidator struct{}
+
+func (v responseFormatValidator) Description(ctx context.Context) string {
+	return "name and schema must be set when type is json_schema, and only then"
+}
+
+func (v responseFormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v responseFormatValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var rf ResponseFormatModel
+	if diags := req.Config.GetAttribute(ctx, req.Path, &rf); diags.HasError() || rf.Type.IsUnknown() {
+		return
+	}
+
+	optional := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"name", rf.Name},
+		{"description", rf.Description},
+		{"schema", rf.Schema},
+		{"strict", rf.Strict},
+	}
+
+	if rf.Type.ValueString() == responseFormatJSONSchema {
+		for _, a := range optional {
+			if (a.name == "name" || a.name == "schema") && a.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(req.Path.AtName(a.name), "Missing response_format attribute",
+					fmt.Sprintf("response_format.%s is required when type is %q.", a.name, responseFormatJSONSchema))
+			}
+		}
+		return
+	}
+	for _, a := range optional {
+		if !a.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName(a.name), "Invalid response_format attribute",
+				fmt.Sprintf("response_format.%s only applies when type is %q.", a.name, responseFormatJSONSchema))
+		}
+	}
+}
+
+// jsonStringValidator rejects strings that are not valid JSON.
+type jsonStringValidator struct{}
+
+func (v jsonStringValidator) Description(ctx context.Context) string {
+	return "value must be valid JSON"
+}
+
+func (v jsonStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonStringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON", fmt.Sprintf("%s must be a valid JSON string.", req.Path))
+	}
+}
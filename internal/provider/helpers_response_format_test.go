@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// responseFormatValue builds a response_format object of objType from the
+// given attributes; the rest are null.
+func responseFormatValue(objType tftypes.Object, set map[string]interface{}) tftypes.Value {
+	attrs := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(typ, set[name])
+	}
+	return tftypes.NewValue(objType, attrs)
+}
+
+func TestResponseFormat_Payloads(t *testing.T) {
+	rf := &ResponseFormatModel{
+		Type:        types.StringValue(responseFormatJSONSchema),
+		Name:        types.StringValue("weather"),
+		Description: types.StringNull(),
+		Schema:      types.StringValue(`{"type":"object"}`),
+		Strict:      types.BoolValue(true),
+	}
+
+	chat, _ := json.Marshal(rf.chatResponseFormat())
+	if want := `{"type":"json_schema","json_schema":{"name":"weather","schema":{"type":"object"},"strict":true}}`; string(chat) != want {
+		t.Errorf("chat response_format = %s, want %s", chat, want)
+	}
+
+	text, _ := json.Marshal(rf.textFormat())
+	if want := `{"name":"weather","schema":{"type":"object"},"strict":true,"type":"json_schema"}`; string(text) != want {
+		t.Errorf("text.format = %s, want %s", text, want)
+	}
+
+	rf = &ResponseFormatModel{Type: types.StringValue(responseFormatJSONObject)}
+	chat, _ = json.Marshal(rf.chatResponseFormat())
+	if want := `{"type":"json_object"}`; string(chat) != want {
+		t.Errorf("chat response_format = %s, want %s", chat, want)
+	}
+}
+
+func TestResponseFormatValidator(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&ResponseResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	rfType := objType.AttributeTypes["response_format"].(tftypes.Object)
+
+	tests := []struct {
+		name    string
+		set     map[string]interface{}
+		wantErr bool
+	}{
+		{"json_schema with name and schema", map[string]interface{}{"type": "json_schema", "name": "w", "schema": `{}`}, false},
+		{"json_schema without schema", map[string]interface{}{"type": "json_schema", "name": "w"}, true},
+		{"json_object", map[string]interface{}{"type": "json_object"}, false},
+		{"json_object with strict", map[string]interface{}{"type": "json_object", "strict": true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := map[string]tftypes.Value{}
+			for name, typ := range objType.AttributeTypes {
+				vals[name] = tftypes.NewValue(typ, nil)
+			}
+			vals["response_format"] = responseFormatValue(rfType, tt.set)
+			config := tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+			var value types.Object
+			config.GetAttribute(ctx, path.Root("response_format"), &value)
+			resp := &validator.ObjectResponse{}
+			responseFormatValidator{}.ValidateObject(ctx, validator.ObjectRequest{
+				Path:        path.Root("response_format"),
+				Config:      config,
+				ConfigValue: value,
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestJSONStringValidator(t *testing.T) {
+	for value, wantErr := range map[string]bool{`{"type":"object"}`: false, `{"type":`: true} {
+		resp := &validator.StringResponse{}
+		jsonStringValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("schema"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("%s: HasError() = %v, want %v", value, resp.Diagnostics.HasError(), wantErr)
+		}
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
@@ -14,6 +13,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 // Per-process cache of project roles, populated lazily on first lookup.
@@ -133,7 +134,6 @@ func cachedListProjectRolesFull(ctx context.Context, c *OpenAIClient, projectID
 		return cached, nil
 	}
 
-	httpClient := projectClientHTTP(c)
 	rolesURL := adminBaseURL(c) + "/v1/projects/" + projectID + "/roles"
 	cursor := ""
 	out := []RoleResponseFramework{}
@@ -150,7 +150,7 @@ func cachedListProjectRolesFull(ctx context.Context, c *OpenAIClient, projectID
 		}
 		parsedURL.RawQuery = q.Encode()
 
-		resp, err := doWithRetry(ctx, httpClient, c, parsedURL.String())
+		resp, err := doWithRetry(ctx, c, parsedURL.String())
 		if err != nil {
 			return nil, err
 		}
@@ -201,7 +201,6 @@ func cachedListAllGroups(ctx context.Context, c *OpenAIClient) ([]GroupResponseF
 		return groupCache, nil
 	}
 
-	httpClient := projectClientHTTP(c)
 	groupsURL := adminBaseURL(c) + "/v1/organization/groups"
 	cursor := ""
 	out := []GroupResponseFramework{}
@@ -218,7 +217,7 @@ func cachedListAllGroups(ctx context.Context, c *OpenAIClient) ([]GroupResponseF
 		}
 		parsedURL.RawQuery = q.Encode()
 
-		resp, err := doWithRetry(ctx, httpClient, c, parsedURL.String())
+		resp, err := doWithRetry(ctx, c, parsedURL.String())
 		if err != nil {
 			return nil, err
 		}
@@ -259,7 +258,6 @@ func cachedListAllGroups(ctx context.Context, c *OpenAIClient) ([]GroupResponseF
 // entire plan.
 func listProjectRoles(ctx context.Context, c *OpenAIClient, projectID string) (map[string]string, error) {
 	rolesURL := adminBaseURL(c) + "/v1/projects/" + projectID + "/roles"
-	httpClient := projectClientHTTP(c)
 	cursor := ""
 	out := map[string]string{}
 
@@ -275,7 +273,7 @@ func listProjectRoles(ctx context.Context, c *OpenAIClient, projectID string) (m
 		}
 		parsedURL.RawQuery = q.Encode()
 
-		resp, err := doWithRetry(ctx, httpClient, c, parsedURL.String())
+		resp, err := doWithRetry(ctx, c, parsedURL.String())
 		if err != nil {
 			return nil, err
 		}
@@ -458,153 +456,68 @@ func waitForAdminToken(ctx context.Context) error {
 	}
 }
 
-// retryStatusCodes are HTTP status codes that should trigger a retry with
-// exponential backoff (rate limiting and transient server errors).
-var retryStatusCodes = map[int]bool{
-	http.StatusTooManyRequests:     true, // 429
-	http.StatusInternalServerError: true, // 500
-	http.StatusBadGateway:          true, // 502
-	http.StatusServiceUnavailable:  true, // 503
-	http.StatusGatewayTimeout:      true, // 504
-}
-
-// retryMaxAttempts is the maximum number of attempts (including the first)
-// the retry helper makes before giving up.
-const retryMaxAttempts = 6
-
-// doWithRetry performs a GET against urlStr with exponential backoff on 429
-// (rate limiting) and transient 5xx responses.
-//
-// Thin wrapper for backward compatibility. New code should use
-// doRequestWithRetry directly.
-func doWithRetry(ctx context.Context, httpClient *http.Client, c *OpenAIClient, urlStr string) (*http.Response, error) {
-	return doRequestWithRetry(ctx, httpClient, c, "GET", urlStr, nil)
+// doWithRetry performs a GET against urlStr through doRequestWithRetry.
+func doWithRetry(ctx context.Context, c *OpenAIClient, urlStr string) (*http.Response, error) {
+	return doRequestWithRetry(ctx, c, "GET", urlStr, nil)
 }
 
-// doRequestWithRetry performs an HTTP request (any method, optional body) with
-// exponential backoff and jitter on 429 (rate limiting) and transient 5xx
-// responses.
+// doRequestWithRetry performs an admin API request (any method, optional JSON
+// body) with the admin key.
 //
 // The OpenAI admin API enforces a low rate limit (~60 RPM org-wide); a
 // terraform plan or apply touching many project users/groups can burst past
-// it. Without retry the operation fails the entire run.
-//
-// `body` is the optional JSON request body — pass nil for GET/DELETE. The
-// body bytes are buffered and a fresh reader is created per attempt, so
-// retries replay the request faithfully.
-//
-// Honours the `Retry-After` header when present (seconds), otherwise falls
-// back to a capped exponential schedule with full jitter (base values
-// 1s, 2s, 4s, 8s, 16s, 30s — actual sleep is uniformly random in [base/2,
-// base] to avoid thundering-herd when many concurrent retries fire).
-func doRequestWithRetry(ctx context.Context, httpClient *http.Client, c *OpenAIClient, method, urlStr string, body []byte) (*http.Response, error) {
+// it. On top of the client's retries on 429 and transient 5xx (see
+// client.OpenAIClient.Do), admin requests hold a concurrency slot for their
+// whole duration and take a rate-limit token before every attempt.
+func doRequestWithRetry(ctx context.Context, c *OpenAIClient, method, urlStr string, body []byte) (*http.Response, error) {
 	release, err := acquireAdminSlot(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("admin slot acquisition cancelled: %w", err)
 	}
 	defer release()
 
-	var lastErr error
-
-	for attempt := 0; attempt < retryMaxAttempts; attempt++ {
-		var bodyReader io.Reader
-		if body != nil {
-			bodyReader = bytes.NewReader(body)
-		}
-		req, err := http.NewRequestWithContext(ctx, method, urlStr, bodyReader)
-		if err != nil {
-			return nil, fmt.Errorf("error creating request: %w", err)
-		}
-		setAdminAuthHeaders(c, req)
-		req.Header.Set("Content-Type", "application/json")
-
-		// Rate-limit at *rate*, not just concurrency. The admin API throttles
-		// per endpoint at ~7-10 RPM (no exposed headers) — even one-in-flight
-		// sequential calls 429 once the bucket is empty. Consuming a token
-		// per attempt (including retries) keeps every request the provider
-		// makes paced under the ceiling.
-		if err := waitForAdminToken(ctx); err != nil {
-			return nil, fmt.Errorf("admin rate-limit wait cancelled: %w", err)
-		}
-
-		resp, err := httpClient.Do(req)
-		if err != nil {
-			lastErr = err
-			if attempt == retryMaxAttempts-1 || !sleepWithBackoff(ctx, attempt, "") {
-				return nil, fmt.Errorf("transport error after %d attempts: %w", attempt+1, err)
-			}
-			continue
-		}
-
-		if !retryStatusCodes[resp.StatusCode] {
-			return resp, nil
-		}
-
-		// Final attempt: hand the (still-retryable) response back to the caller
-		// untouched so the body remains readable for diagnostics.
-		if attempt == retryMaxAttempts-1 {
-			return resp, nil
-		}
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	setAdminAuthHeaders(c, req)
+	req.Header.Set("Content-Type", "application/json")
 
-		// Going to retry: drain and close so the connection can be reused.
-		retryAfter := resp.Header.Get("Retry-After")
-		_, _ = io.Copy(io.Discard, resp.Body)
-		resp.Body.Close()
+	return adminPacedClient(c).Do(req)
+}
 
-		if !sleepWithBackoff(ctx, attempt, retryAfter) {
-			return nil, fmt.Errorf("retry aborted: %w", ctx.Err())
-		}
+// adminPacedClient returns a copy of the provider's client whose transport
+// waits for an admin rate-limit token before every round trip, so the
+// client's retries are paced as well.
+func adminPacedClient(c *OpenAIClient) *client.OpenAIClient {
+	httpClient := *projectClientHTTP(c)
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
 	}
+	httpClient.Transport = &adminPacingTransport{base: base}
 
-	return nil, lastErr
+	paced := *c.OpenAIClient
+	paced.HTTPClient = &httpClient
+	return &paced
 }
 
-// sleepWithBackoff waits before the next retry attempt. Returns false if the
-// context was cancelled while waiting.
-func sleepWithBackoff(ctx context.Context, attempt int, retryAfter string) bool {
-	d := backoffDuration(attempt, retryAfter)
-	t := time.NewTimer(d)
-	defer t.Stop()
-	select {
-	case <-t.C:
-		return true
-	case <-ctx.Done():
-		return false
-	}
+// adminPacingTransport rate-limits at *rate*, not just concurrency. The admin
+// API throttles per endpoint at ~7-10 RPM (no exposed headers) — even
+// one-in-flight sequential calls 429 once the bucket is empty. Consuming a
+// token per round trip (including retries) keeps every request the provider
+// makes paced under the ceiling.
+type adminPacingTransport struct {
+	base http.RoundTripper
 }
 
-// backoffDuration returns the wait time for a given attempt index. If
-// retryAfter (the value of the `Retry-After` header) is a non-negative integer
-// number of seconds, that value wins (capped at 60s; 0 means "retry now").
-// Otherwise we use a capped exponential schedule with "decorrelated" jitter:
-// the actual sleep is uniformly random in [base/2, base] for base values 1,
-// 2, 4, 8, 16, 30s. The jitter avoids thundering-herd when N concurrent
-// requests all 429 at once and would otherwise retry on the same schedule.
-func backoffDuration(attempt int, retryAfter string) time.Duration {
-	if retryAfter != "" {
-		if secs, err := strconv.Atoi(strings.TrimSpace(retryAfter)); err == nil && secs >= 0 {
-			capped := secs
-			if capped > 60 {
-				capped = 60
-			}
-			return time.Duration(capped) * time.Second
-		}
-	}
-	var base time.Duration
-	switch attempt {
-	case 0:
-		base = 1 * time.Second
-	case 1:
-		base = 2 * time.Second
-	case 2:
-		base = 4 * time.Second
-	case 3:
-		base = 8 * time.Second
-	case 4:
-		base = 16 * time.Second
-	default:
-		base = 30 * time.Second
+func (t *adminPacingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := waitForAdminToken(req.Context()); err != nil {
+		return nil, fmt.Errorf("admin rate-limit wait cancelled: %w", err)
 	}
-	half := base / 2
-	return half + time.Duration(rand.Int63n(int64(half)+1))
+	return t.base.RoundTrip(req)
 }
@@ -367,9 +367,9 @@ func TestDoWithRetry_GivesUpAfterMaxAttempts(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error after exhausting retries, got nil")
 	}
-	// Exactly retryMaxAttempts requests should be made — no extra reissue.
-	if calls != retryMaxAttempts {
-		t.Errorf("expected exactly %d calls, got %d", retryMaxAttempts, calls)
+	// Exactly client.RetryMaxAttempts requests should be made — no extra reissue.
+	if calls != client.RetryMaxAttempts {
+		t.Errorf("expected exactly %d calls, got %d", client.RetryMaxAttempts, calls)
 	}
 }
 
@@ -392,32 +392,69 @@ func TestDoWithRetry_DoesNotRetryOn4xxOtherThan429(t *testing.T) {
 	}
 }
 
-func TestBackoffDuration_HonoursRetryAfter(t *testing.T) {
-	// Retry-After header values are honoured exactly (no jitter applied).
-	if got := backoffDuration(0, "5"); got.Seconds() != 5 {
-		t.Errorf("retry-after=5 → %v, want 5s", got)
-	}
-	if got := backoffDuration(0, "120"); got.Seconds() != 60 {
-		t.Errorf("retry-after=120 should cap at 60s, got %v", got)
-	}
-	// Fallback path uses jittered exponential backoff: actual sleep is in
-	// [base/2, base] for base values 1, 2, 4, 8, 16, 30s.
-	for _, tc := range []struct {
-		attempt int
-		base    time.Duration
-	}{
-		{0, 1 * time.Second},
-		{2, 4 * time.Second},
-		{5, 30 * time.Second},
-	} {
-		got := backoffDuration(tc.attempt, "")
-		if got < tc.base/2 || got > tc.base {
-			t.Errorf("attempt %d → %v, want in [%v, %v]", tc.attempt, got, tc.base/2, tc.base)
+// TestDoRequestWithRetry_WaitsForRateLimitReset asserts a 429 carrying
+// x-ratelimit-reset-* headers is retried after the indicated reset rather
+// than the generic backoff, whose first step is at least 500ms.
+func TestDoRequestWithRetry_WaitsForRateLimitReset(t *testing.T) {
+	resetAdminSemaphoreForTest(adminConcurrencyDefault)
+	resetAdminBucketForTest(100000, 1000)
+
+	var (
+		mu    sync.Mutex
+		calls []time.Time
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, time.Now())
+		n := len(calls)
+		mu.Unlock()
+		if n == 1 {
+			w.Header().Set("x-ratelimit-reset-requests", "50ms")
+			w.Header().Set("x-ratelimit-reset-tokens", "150ms")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
 		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	resp, err := doRequestWithRetry(context.Background(), c, "GET", server.URL+"/v1/anything", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 calls, got %d", len(calls))
+	}
+	if gap := calls[1].Sub(calls[0]); gap < 150*time.Millisecond || gap >= 500*time.Millisecond {
+		t.Errorf("retried after %v, want the 150ms reset (and less than the 500ms backoff floor)", gap)
+	}
+}
+
+func TestDoRequestWithRetry_RateLimitResetRespectsCancellation(t *testing.T) {
+	resetAdminSemaphoreForTest(adminConcurrencyDefault)
+	resetAdminBucketForTest(100000, 1000)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-reset-requests", "30s")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	c := newTestOpenAIClient(server.URL)
+	start := time.Now()
+	_, err := doRequestWithRetry(ctx, c, "GET", server.URL+"/v1/anything", nil)
+	if err == nil {
+		t.Fatal("expected an error once the context is cancelled")
 	}
-	got := backoffDuration(0, "not-a-number")
-	if got < 500*time.Millisecond || got > 1*time.Second {
-		t.Errorf("invalid retry-after should fall back to attempt 0 jitter range [500ms, 1s], got %v", got)
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("cancellation took %v; the reset wait should be interruptible", elapsed)
 	}
 }
 
@@ -451,7 +488,6 @@ func TestAdminSemaphore_LimitsConcurrency(t *testing.T) {
 	defer server.Close()
 
 	c := newTestOpenAIClient(server.URL)
-	httpClient := projectClientHTTP(c)
 
 	const N = 10
 	var wg sync.WaitGroup
@@ -459,7 +495,7 @@ func TestAdminSemaphore_LimitsConcurrency(t *testing.T) {
 	for i := 0; i < N; i++ {
 		go func() {
 			defer wg.Done()
-			resp, err := doRequestWithRetry(context.Background(), httpClient, c, "GET", server.URL+"/v1/anything", nil)
+			resp, err := doRequestWithRetry(context.Background(), c, "GET", server.URL+"/v1/anything", nil)
 			if err != nil {
 				t.Errorf("request failed: %v", err)
 				return
@@ -479,7 +515,7 @@ func TestAdminSemaphore_LimitsConcurrency(t *testing.T) {
 // in-flight simultaneously. With the semaphore sized to <= serverLimit, all
 // N concurrent callers must complete successfully without exhausting their
 // retry budget. Without the semaphore, retries pile up and the test exhausts
-// retryMaxAttempts (this was the v2.2.5 production behaviour).
+// client.RetryMaxAttempts (this was the v2.2.5 production behaviour).
 func TestAdminSemaphore_RateLimitedServer(t *testing.T) {
 	const (
 		N           = 50 // concurrent callers — well above Terraform's default parallelism of 10
@@ -512,7 +548,6 @@ func TestAdminSemaphore_RateLimitedServer(t *testing.T) {
 	defer server.Close()
 
 	c := newTestOpenAIClient(server.URL)
-	httpClient := projectClientHTTP(c)
 
 	var (
 		wg       sync.WaitGroup
@@ -522,7 +557,7 @@ func TestAdminSemaphore_RateLimitedServer(t *testing.T) {
 	for i := 0; i < N; i++ {
 		go func() {
 			defer wg.Done()
-			resp, err := doRequestWithRetry(context.Background(), httpClient, c, "GET", server.URL+"/v1/anything", nil)
+			resp, err := doRequestWithRetry(context.Background(), c, "GET", server.URL+"/v1/anything", nil)
 			if err != nil {
 				atomic.AddInt32(&failures, 1)
 				return
@@ -579,14 +614,13 @@ func TestAdminSemaphore_RateLimitedServer_WithoutSemaphore(t *testing.T) {
 	defer server.Close()
 
 	c := newTestOpenAIClient(server.URL)
-	httpClient := projectClientHTTP(c)
 
 	var wg sync.WaitGroup
 	wg.Add(N)
 	for i := 0; i < N; i++ {
 		go func() {
 			defer wg.Done()
-			resp, err := doRequestWithRetry(context.Background(), httpClient, c, "GET", server.URL+"/v1/anything", nil)
+			resp, err := doRequestWithRetry(context.Background(), c, "GET", server.URL+"/v1/anything", nil)
 			if err == nil && resp != nil {
 				resp.Body.Close()
 			}
@@ -622,7 +656,6 @@ func TestAdminRateLimiter_PacesRequests(t *testing.T) {
 	defer server.Close()
 
 	c := newTestOpenAIClient(server.URL)
-	httpClient := projectClientHTTP(c)
 
 	start := time.Now()
 	var wg sync.WaitGroup
@@ -630,7 +663,7 @@ func TestAdminRateLimiter_PacesRequests(t *testing.T) {
 	for i := 0; i < N; i++ {
 		go func() {
 			defer wg.Done()
-			resp, err := doRequestWithRetry(context.Background(), httpClient, c, "GET", server.URL+"/v1/anything", nil)
+			resp, err := doRequestWithRetry(context.Background(), c, "GET", server.URL+"/v1/anything", nil)
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 				return
@@ -0,0 +1,34 @@
+package provider
+
+// Role names accepted by the OpenAI API. Attributes that take a role
+// validate against the lists below, so a typo fails at plan time instead of
+// as an API error on apply.
+const (
+	roleOwner  = "owner"
+	roleReader = "reader"
+	roleMember = "member"
+
+	messageRoleSystem    = "system"
+	messageRoleDeveloper = "developer"
+	messageRoleUser      = "user"
+	messageRoleAssistant = "assistant"
+	messageRoleTool      = "tool"
+	messageRoleFunction  = "function"
+)
+
+// organizationRoles are the roles a user or invite can hold in the
+// organization.
+var organizationRoles = []string{roleOwner, roleReader}
+
+// projectRoles are the roles a user can hold in a project.
+var projectRoles = []string{roleOwner, roleMember}
+
+// chatMessageRoles are the authors a chat completion message can have.
+var chatMessageRoles = []string{
+	messageRoleSystem,
+	messageRoleDeveloper,
+	messageRoleUser,
+	messageRoleAssistant,
+	messageRoleTool,
+	messageRoleFunction,
+}
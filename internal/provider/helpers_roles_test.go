@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(r resource.Resource) schema.Schema {
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// TestRoleAttributeValidation checks that every configurable role attribute
+// accepts its documented roles and rejects anything else at plan time.
+func TestRoleAttributeValidation(t *testing.T) {
+	chatMessage := resourceSchema(NewChatCompletionResource()).Attributes["messages"].(schema.ListNestedAttribute).NestedObject
+	invite := resourceSchema(NewInviteResource())
+	inviteProject := invite.Blocks["projects"].(schema.ListNestedBlock).NestedObject
+	chatDataSource := &datasource.SchemaResponse{}
+	NewChatCompletionDataSource().Schema(context.Background(), datasource.SchemaRequest{}, chatDataSource)
+	chatDataSourceMessage := chatDataSource.Schema.Attributes["messages"].(dschema.ListNestedAttribute).NestedObject
+
+	cases := []struct {
+		name       string
+		validators []validator.String
+		valid      []string
+	}{
+		{"openai_chat_completion messages.role", chatMessage.Attributes["role"].(schema.StringAttribute).Validators, chatMessageRoles},
+		{"data.openai_chat_completion messages.role", chatDataSourceMessage.Attributes["role"].(dschema.StringAttribute).Validators, chatMessageRoles},
+		{"openai_invite role", invite.Attributes["role"].(schema.StringAttribute).Validators, organizationRoles},
+		{"openai_invite projects.role", inviteProject.Attributes["role"].(schema.StringAttribute).Validators, projectRoles},
+		{"openai_organization_user role", resourceSchema(NewOrganizationUserResource()).Attributes["role"].(schema.StringAttribute).Validators, organizationRoles},
+	}
+
+	validate := func(validators []validator.String, value string) bool {
+		req := validator.StringRequest{Path: path.Root("role"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+		for _, v := range validators {
+			v.ValidateString(context.Background(), req, resp)
+		}
+		return !resp.Diagnostics.HasError()
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, role := range tc.valid {
+				if !validate(tc.validators, role) {
+					t.Errorf("role %q was rejected", role)
+				}
+			}
+			for _, role := range []string{"admin", "Owner", ""} {
+				if validate(tc.validators, role) {
+					t.Errorf("invalid role %q was accepted", role)
+				}
+			}
+		})
+	}
+}
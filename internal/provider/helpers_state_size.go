@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+// stateString returns s as a state value, truncated to the provider's
+// max_response_bytes. A truncated value is flagged with a warning on p so
+// that a cut-off completion is never mistaken for the model's full output.
+func stateString(cl *client.OpenAIClient, p path.Path, s string, diags *diag.Diagnostics) types.String {
+	truncated, ok := cl.TruncateForState(s)
+	if ok {
+		diags.AddAttributeWarning(p, "Value truncated to max_response_bytes",
+			fmt.Sprintf("The API returned %d bytes, more than the provider's max_response_bytes of %d, so only the first %d bytes are stored in state. Raise max_response_bytes to keep the full value.",
+				len(s), cl.MaxResponseBytes, len(truncated)))
+	}
+	return types.StringValue(truncated)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+func TestStateString(t *testing.T) {
+	cl := client.NewClientWithConfig(client.ClientConfig{MaxResponseBytes: 5})
+
+	var diags diag.Diagnostics
+	if got := stateString(cl, path.Root("content"), "hello", &diags); got.ValueString() != "hello" || diags.WarningsCount() != 0 {
+		t.Errorf("value at the limit = %q with %d warnings, want it kept as is", got.ValueString(), diags.WarningsCount())
+	}
+
+	// "é" is two bytes; cutting at 5 would split it, so only "héll" fits.
+	got := stateString(cl, path.Root("content"), "héllo", &diags)
+	if got.ValueString() != "héll" {
+		t.Errorf("truncated value = %q, want %q", got.ValueString(), "héll")
+	}
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("diagnostics = %v, want a single warning", diags)
+	}
+
+	unlimited := client.NewClientWithConfig(client.ClientConfig{})
+	long := strings.Repeat("x", 1<<20)
+	diags = nil
+	if got := stateString(unlimited, path.Root("content"), long, &diags); len(got.ValueString()) != len(long) || diags.WarningsCount() != 0 {
+		t.Errorf("without max_response_bytes got %d bytes with %d warnings, want the full value", len(got.ValueString()), diags.WarningsCount())
+	}
+}
+
+func TestSetChatCompletionComputed_TruncatesOversizedContent(t *testing.T) {
+	ctx := context.Background()
+	cl := client.NewClientWithConfig(client.ClientConfig{MaxResponseBytes: 16})
+
+	completion := &ChatCompletionResponse{
+		ID:    "chatcmpl-big",
+		Model: "gpt-4o",
+		Choices: []ChatCompletionChoice{
+			{Index: 0, FinishReason: "stop", Message: ChatCompletionMessage{Role: "assistant", Content: strings.Repeat("a", 64)}},
+			{Index: 1, FinishReason: "stop", Message: ChatCompletionMessage{Role: "assistant", Content: "short"}},
+		},
+	}
+
+	var data ChatCompletionResourceModel
+	diags := setChatCompletionComputed(ctx, cl, &data, completion)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("got %d warnings, want one for the oversized choice: %v", diags.WarningsCount(), diags)
+	}
+	warning := diags.Warnings()[0]
+	want := path.Root("choices").AtListIndex(0).AtName("message").AtListIndex(0).AtName("content")
+	if p, ok := warning.(diag.DiagnosticWithPath); !ok || !p.Path().Equal(want) {
+		t.Errorf("warning = %v, want it on %s", warning, want)
+	}
+
+	var choices []ChoiceModel
+	diags.Append(data.Choices.ElementsAs(ctx, &choices, false)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected error reading choices: %v", diags)
+	}
+	if got := choices[0].Message[0].Content.ValueString(); got != strings.Repeat("a", 16) {
+		t.Errorf("choice 0 content = %q, want the first 16 bytes", got)
+	}
+	if got := choices[1].Message[0].Content.ValueString(); got != "short" {
+		t.Errorf("choice 1 content = %q, want it untouched", got)
+	}
+}
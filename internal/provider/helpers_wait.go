@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// waitTimeout returns how long a resource may wait for an object to finish
+// after creating it: the provider `timeout` if set, otherwise def.
+func waitTimeout(c *OpenAIClient, def time.Duration) time.Duration {
+	if c.OpenAIClient.Timeout > 0 {
+		return c.OpenAIClient.Timeout
+	}
+	return def
+}
+
+// waitForStatus calls fetch every interval until it reports done, for at
+// most timeout. fetch returns the object and its status; what names the
+// object in logs and errors. The last object fetched is returned alongside
+// any error, so the caller can still record it in state.
+func waitForStatus[T any](ctx context.Context, timeout, interval time.Duration, what string, fetch func(ctx context.Context) (v T, status string, done bool, err error)) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last T
+	status := ""
+	stillRunning := func(err error) error {
+		if status != "" && errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%s is still %q after %s", what, status, timeout)
+		}
+		return err
+	}
+
+	for {
+		v, s, done, err := fetch(ctx)
+		if err != nil {
+			return last, stillRunning(err)
+		}
+		last, status = v, s
+		if done {
+			return v, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for "+what, map[string]interface{}{
+			"status": s,
+		})
+		select {
+		case <-ctx.Done():
+			return last, stillRunning(ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// saveAfterFailedWait records data in state and reports err under summary.
+// It is for a wait that fails after the object was created: keeping the
+// object in state means it is not orphaned, and the error taints it for
+// replacement on the next apply.
+func saveAfterFailedWait(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics, data interface{}, summary string, err error) {
+	diags.Append(state.Set(ctx, data)...)
+	diags.AddError(summary, err.Error())
+}
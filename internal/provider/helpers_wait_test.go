@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForStatus(t *testing.T) {
+	boom := errors.New("boom")
+
+	t.Run("returns once done", func(t *testing.T) {
+		statuses := []string{"queued", "in_progress", "completed"}
+		calls := 0
+		got, err := waitForStatus(context.Background(), time.Second, time.Millisecond, "job 1", func(ctx context.Context) (int, string, bool, error) {
+			s := statuses[calls]
+			calls++
+			return calls, s, s == "completed", nil
+		})
+		if err != nil || got != 3 || calls != 3 {
+			t.Errorf("got %d after %d calls (err %v), want 3 after 3", got, calls, err)
+		}
+	})
+
+	t.Run("times out with the last status", func(t *testing.T) {
+		got, err := waitForStatus(context.Background(), 20*time.Millisecond, time.Millisecond, "job 1", func(ctx context.Context) (int, string, bool, error) {
+			return 7, "in_progress", false, nil
+		})
+		if err == nil || !strings.Contains(err.Error(), `job 1 is still "in_progress" after 20ms`) {
+			t.Errorf("err = %v, want a timeout naming the last status", err)
+		}
+		if got != 7 {
+			t.Errorf("got %d, want the last object fetched", got)
+		}
+	})
+
+	t.Run("fetch errors are returned with the last object", func(t *testing.T) {
+		calls := 0
+		got, err := waitForStatus(context.Background(), time.Second, time.Millisecond, "job 1", func(ctx context.Context) (int, string, bool, error) {
+			calls++
+			if calls == 2 {
+				return 0, "", false, boom
+			}
+			return calls, "queued", false, nil
+		})
+		if !errors.Is(err, boom) || got != 1 {
+			t.Errorf("got %d, err %v; want 1 and boom", got, err)
+		}
+	})
+}
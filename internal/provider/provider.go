@@ -3,15 +3,22 @@ package provider
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
@@ -32,6 +39,27 @@ type OpenAIClient struct {
 	*client.OpenAIClient        // Embed the client package's OpenAIClient
 	ProjectAPIKey        string // Store the project API key separately
 	AdminAPIKey          string // Store the admin API key separately
+	AssistantsBetaHeader string // OpenAI-Beta value for vector store requests; empty omits it
+}
+
+// withAPIKey returns a client that authenticates with key but otherwise
+// shares the provider's configuration. projectScoped keeps the provider's
+// project ID; organization-level (admin) requests leave it out.
+func (c *OpenAIClient) withAPIKey(key string, projectScoped bool) *client.OpenAIClient {
+	config := client.ClientConfig{
+		APIKey:           key,
+		OrganizationID:   c.OpenAIClient.OrganizationID,
+		APIURL:           c.OpenAIClient.APIURL,
+		Timeout:          c.OpenAIClient.Timeout,
+		DryRun:           c.OpenAIClient.DryRun,
+		DefaultHeaders:   c.OpenAIClient.DefaultHeaders,
+		TLSConfig:        c.OpenAIClient.TLSConfig,
+		MaxResponseBytes: c.OpenAIClient.MaxResponseBytes,
+	}
+	if projectScoped {
+		config.ProjectID = c.OpenAIClient.ProjectID
+	}
+	return client.NewClientWithConfig(config)
 }
 
 // GetOpenAIClient extracts the client from the meta interface passed to resource functions
@@ -60,13 +88,7 @@ func GetOpenAIClientWithProjectKey(m interface{}) (*client.OpenAIClient, error)
 		// If project API key is available, create a new client with it
 		if c.ProjectAPIKey != "" {
 			log.Printf("[DEBUG] Using project API key for request")
-			config := client.ClientConfig{
-				APIKey:         c.ProjectAPIKey,
-				OrganizationID: c.OpenAIClient.OrganizationID,
-				APIURL:         c.OpenAIClient.APIURL,
-				Timeout:        c.OpenAIClient.Timeout,
-			}
-			return client.NewClientWithConfig(config), nil
+			return c.withAPIKey(c.ProjectAPIKey, true), nil
 		}
 		// Fall back to the default client if no project key
 		log.Printf("[DEBUG] No project API key available, using default client")
@@ -91,13 +113,7 @@ func GetOpenAIClientWithAdminKey(m interface{}) (*client.OpenAIClient, error) {
 		// If admin API key is available, create a new client with it
 		if c.AdminAPIKey != "" {
 			log.Printf("[DEBUG] Using admin API key for request")
-			config := client.ClientConfig{
-				APIKey:         c.AdminAPIKey,
-				OrganizationID: c.OpenAIClient.OrganizationID,
-				APIURL:         c.OpenAIClient.APIURL,
-				Timeout:        c.OpenAIClient.Timeout,
-			}
-			return client.NewClientWithConfig(config), nil
+			return c.withAPIKey(c.AdminAPIKey, false), nil
 		}
 		// Fall back to the project API key if no admin key
 		log.Printf("[DEBUG] No admin API key available, using project API key")
@@ -156,6 +172,10 @@ func (p *FrameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Description: "The Organization ID for OpenAI API operations.",
 				Optional:    true,
 			},
+			"project_id": schema.StringAttribute{
+				Description: "Project ID sent as the `OpenAI-Project` header on project-level API requests (files, vector stores, models, completions, ...), for keys that can access several projects. Calls made with `admin_key`, which are organization-wide, never send it. It does not change resources' own `project_id` arguments, which name the project an admin operation targets. Can also be set with the OPENAI_PROJECT_ID environment variable.",
+				Optional:    true,
+			},
 			"api_url": schema.StringAttribute{
 				Description: "The URL for OpenAI API. Defaults to https://api.openai.com/v1",
 				Optional:    true,
@@ -164,6 +184,38 @@ func (p *FrameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Description: "Timeout in seconds for API operations. Defaults to 300.",
 				Optional:    true,
 			},
+			"assistants_beta_header": schema.StringAttribute{
+				Description: "Value of the `OpenAI-Beta` header sent on vector store requests. Defaults to `assistants=v2`. Set to an empty string to omit the header once the endpoints no longer need it.",
+				Optional:    true,
+			},
+			"default_headers": schema.MapAttribute{
+				Description: "Extra HTTP headers sent on every request, e.g. for an API gateway or proxy (`Helicone-Auth`, `OpenAI-Project`). They cannot override `Authorization`. Marked sensitive since such headers often carry credentials.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive("Authorization")),
+				},
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Description: "Path to a PEM file of CA certificates to trust, in addition to the system roots, when `api_url` points at a proxy or gateway with an internal CA. Can also be set with the OPENAI_CA_BUNDLE_FILE environment variable.",
+				Optional:    true,
+			},
+			"tls_insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip TLS certificate verification. Only for testing against a proxy whose certificate cannot be verified; prefer `ca_bundle_file`. Defaults to false.",
+				Optional:    true,
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Build and validate every request, log it and answer it with a synthetic success instead of calling the API. Resources are populated with plausible placeholder values. For testing configurations without network access or cost. Can also be set with the OPENAI_DRY_RUN environment variable.",
+				Optional:    true,
+			},
+			"max_response_bytes": schema.Int64Attribute{
+				Description: "Maximum size in bytes of generated text (chat completion choices, response output) stored in state. Longer values are truncated with a warning instead of bloating state. Defaults to no limit. Can also be set with the OPENAI_MAX_RESPONSE_BYTES environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -192,6 +244,11 @@ func (p *FrameworkProvider) Configure(ctx context.Context, req provider.Configur
 		organization = os.Getenv("OPENAI_ORGANIZATION")
 	}
 
+	projectID := data.ProjectID.ValueString()
+	if projectID == "" {
+		projectID = os.Getenv("OPENAI_PROJECT_ID")
+	}
+
 	apiURL := data.APIURL.ValueString()
 	if apiURL == "" {
 		apiURL = os.Getenv("OPENAI_API_URL")
@@ -212,20 +269,88 @@ func (p *FrameworkProvider) Configure(ctx context.Context, req provider.Configur
 		timeoutVal = 300
 	}
 
+	// Null means "not configured" and falls back to the default; an explicit
+	// empty string disables the header.
+	assistantsBeta := defaultAssistantsBetaHeader
+	if !data.AssistantsBetaHeader.IsNull() {
+		assistantsBeta = data.AssistantsBetaHeader.ValueString()
+	} else if envVal, ok := os.LookupEnv("OPENAI_ASSISTANTS_BETA_HEADER"); ok {
+		assistantsBeta = envVal
+	}
+
+	caBundleFile := data.CABundleFile.ValueString()
+	if caBundleFile == "" {
+		caBundleFile = os.Getenv("OPENAI_CA_BUNDLE_FILE")
+	}
+	insecureSkipVerify := data.TLSInsecureSkipVerify.ValueBool()
+	tlsConfig, err := client.NewTLSConfig(caBundleFile, insecureSkipVerify)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_bundle_file"), "Invalid CA bundle", err.Error())
+		return
+	}
+	if insecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(path.Root("tls_insecure_skip_verify"), "TLS certificate verification is disabled",
+			"tls_insecure_skip_verify is set, so the API server's certificate is not checked and the API key can be intercepted. Use ca_bundle_file to trust a proxy's CA instead.")
+	}
+
+	dryRun := data.DryRun.ValueBool()
+	if data.DryRun.IsNull() {
+		if envVal := os.Getenv("OPENAI_DRY_RUN"); envVal != "" {
+			if v, err := strconv.ParseBool(envVal); err == nil {
+				dryRun = v
+			}
+		}
+	}
+	if dryRun {
+		tflog.Warn(ctx, "dry_run is enabled: requests are validated and logged but not sent to the OpenAI API")
+	}
+
+	maxResponseBytes := data.MaxResponseBytes.ValueInt64()
+	if data.MaxResponseBytes.IsNull() {
+		if envVal := os.Getenv("OPENAI_MAX_RESPONSE_BYTES"); envVal != "" {
+			if v, err := strconv.ParseInt(envVal, 10, 64); err == nil && v > 0 {
+				maxResponseBytes = v
+			}
+		}
+	}
+
+	var defaultHeaders map[string]string
+	if !data.DefaultHeaders.IsNull() {
+		resp.Diagnostics.Append(data.DefaultHeaders.ElementsAs(ctx, &defaultHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if projectID != "" {
+		for name := range defaultHeaders {
+			if http.CanonicalHeaderKey(name) == "Openai-Project" {
+				resp.Diagnostics.AddAttributeError(path.Root("default_headers"), "Conflicting OpenAI-Project header",
+					"default_headers sets OpenAI-Project while project_id is also set. Remove one of them.")
+				return
+			}
+		}
+	}
+
 	// Create client config
 	config := client.ClientConfig{
-		APIKey:         apiKey,
-		OrganizationID: organization,
-		APIURL:         apiURL,
-		Timeout:        time.Duration(timeoutVal) * time.Second,
+		APIKey:           apiKey,
+		OrganizationID:   organization,
+		ProjectID:        projectID,
+		APIURL:           apiURL,
+		Timeout:          time.Duration(timeoutVal) * time.Second,
+		DryRun:           dryRun,
+		DefaultHeaders:   defaultHeaders,
+		TLSConfig:        tlsConfig,
+		MaxResponseBytes: int(maxResponseBytes),
 	}
 
 	// Create provider client
 	// OpenAIClient struct must be defined in the provider package (e.g. in provider.go)
 	providerClient := &OpenAIClient{
-		OpenAIClient:  client.NewClientWithConfig(config),
-		ProjectAPIKey: apiKey,
-		AdminAPIKey:   adminKey,
+		OpenAIClient:         client.NewClientWithConfig(config),
+		ProjectAPIKey:        apiKey,
+		AdminAPIKey:          adminKey,
+		AssistantsBetaHeader: assistantsBeta,
 	}
 
 	resp.DataSourceData = providerClient
@@ -236,6 +361,7 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 	return []func() resource.Resource{
 		NewFileResource,
 		NewChatCompletionResource,
+		NewCompletionResource,
 		NewVectorStoreResource,
 		NewVectorStoreFileResource,
 		NewVectorStoreFileBatchResource,
@@ -245,11 +371,13 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		NewInviteResource,
 		NewProjectResource,
 		NewProjectUserResource,
+		NewProjectUsersResource,
 		NewProjectGroupResource,
 		NewGroupResource,
 		NewGroupUserResource,
 		NewOrganizationUserResource,
 		NewAdminAPIKeyResource,
+		NewCertificateResource,
 		// Role management
 		NewOrganizationRoleResource,
 		NewProjectRoleResource,
@@ -276,6 +404,7 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		NewModelDataSource,
 		NewModelsDataSource,
+		NewModelSnapshotDataSource,
 		NewFileDataSource,
 		NewFilesDataSource,
 		NewVectorStoreDataSource,
@@ -305,10 +434,16 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		NewGroupUsersDataSource,
 		NewOrganizationUserDataSource,
 		NewOrganizationUsersDataSource,
+		NewAccountDataSource,
+		NewUsageDataSource,
+		NewRawRequestDataSource,
 		NewAdminAPIKeyDataSource,
 		NewAdminAPIKeysDataSource,
 		NewInviteDataSource,
 		NewInvitesDataSource,
+		NewRateLimitDataSource,
+		NewRateLimitDefaultsDataSource,
+		NewRateLimitsDataSource,
 		// Batch 9: Audio
 		NewAudioTranscriptionDataSource,
 		NewAudioTranscriptionsDataSource,
@@ -323,10 +458,15 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		NewBatchesDataSource,
 		NewFineTuningJobDataSource,
 		NewFineTuningJobsDataSource,
+		NewFineTuningEventsDataSource,
+		NewFineTuningCheckpointsDataSource,
+		NewInventoryDataSource,
 		// Batch 9: Chat & Model
 		NewChatCompletionDataSource,
 		NewChatCompletionsDataSource,
 		NewChatCompletionMessagesDataSource,
+		NewResponseDataSource,
+		NewEmbeddingSimilarityDataSource,
 
 		// Batch 9: Vector Store Utils
 		NewVectorStoreFileDataSource,
@@ -334,13 +474,21 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		NewVectorStoreFileContentDataSource,
 		NewVectorStoreFilesDataSource,
 		NewVectorStoreFileBatchFilesDataSource,
+		NewVectorStoreSearchDataSource,
 	}
 }
 
 type OpenAIProviderModel struct {
-	APIKey       types.String `tfsdk:"api_key"`
-	AdminKey     types.String `tfsdk:"admin_key"`
-	Organization types.String `tfsdk:"organization"`
-	APIURL       types.String `tfsdk:"api_url"`
-	Timeout      types.Int64  `tfsdk:"timeout"`
+	APIKey                types.String `tfsdk:"api_key"`
+	AdminKey              types.String `tfsdk:"admin_key"`
+	Organization          types.String `tfsdk:"organization"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	APIURL                types.String `tfsdk:"api_url"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+	AssistantsBetaHeader  types.String `tfsdk:"assistants_beta_header"`
+	DryRun                types.Bool   `tfsdk:"dry_run"`
+	DefaultHeaders        types.Map    `tfsdk:"default_headers"`
+	CABundleFile          types.String `tfsdk:"ca_bundle_file"`
+	TLSInsecureSkipVerify types.Bool   `tfsdk:"tls_insecure_skip_verify"`
+	MaxResponseBytes      types.Int64  `tfsdk:"max_response_bytes"`
 }
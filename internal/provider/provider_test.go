@@ -1,11 +1,20 @@
 package provider
 
 import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
@@ -36,3 +45,209 @@ func testClient() *client.OpenAIClient {
 		"https://api.openai.com/v1",
 	)
 }
+
+// configureDryRunProvider configures the provider with dry_run enabled and
+// api_url pointing at apiURL, and returns the client it hands to resources.
+func configureDryRunProvider(t *testing.T, apiURL string) *OpenAIClient {
+	t.Helper()
+	ctx := context.Background()
+
+	p := &FrameworkProvider{version: "test"}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+	vals["admin_key"] = tftypes.NewValue(tftypes.String, "test-admin-key")
+	vals["api_url"] = tftypes.NewValue(tftypes.String, apiURL+"/v1")
+	vals["dry_run"] = tftypes.NewValue(tftypes.Bool, true)
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure: %v", resp.Diagnostics)
+	}
+	return resp.ResourceData.(*OpenAIClient)
+}
+
+func TestDryRun_NoOutboundRequests(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		t.Errorf("dry_run sent %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := configureDryRunProvider(t, server.URL)
+	if !c.DryRun {
+		t.Fatal("client DryRun = false, want true")
+	}
+
+	// openai_response goes through the configured client.
+	respResp := createResponseWithClient(t, c, func(vals map[string]tftypes.Value) {})
+	if respResp.Diagnostics.HasError() {
+		t.Fatalf("response create: %v", respResp.Diagnostics)
+	}
+	var response ResponseResourceModel
+	respResp.Diagnostics.Append(respResp.State.Get(context.Background(), &response)...)
+	if response.ID.ValueString() == "" || response.Status.ValueString() != "completed" {
+		t.Errorf("response state id=%q status=%q, want a synthetic id and completed", response.ID.ValueString(), response.Status.ValueString())
+	}
+
+	// Streaming reads server-sent events, which dry_run synthesizes too.
+	streamResp := createResponseWithClient(t, c, func(vals map[string]tftypes.Value) {
+		vals["stream"] = tftypes.NewValue(tftypes.Bool, true)
+	})
+	if streamResp.Diagnostics.HasError() {
+		t.Fatalf("streamed response create: %v", streamResp.Diagnostics)
+	}
+
+	// openai_vector_store builds its own request and sends it with the
+	// client's Do.
+	vsResp := createVectorStoreWithClient(t, c, true)
+	if vsResp.Diagnostics.HasError() {
+		t.Fatalf("vector store create: %v", vsResp.Diagnostics)
+	}
+	var store VectorStoreResourceModel
+	vsResp.Diagnostics.Append(vsResp.State.Get(context.Background(), &store)...)
+	if store.ID.ValueString() == "" || store.Name.ValueString() != "docs" {
+		t.Errorf("vector store state id=%q name=%q, want a synthetic id and the configured name", store.ID.ValueString(), store.Name.ValueString())
+	}
+
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("server received %d requests, want none", n)
+	}
+}
+
+func TestProjectID_ComposesWithKeys(t *testing.T) {
+	c := &OpenAIClient{
+		OpenAIClient: client.NewClientWithConfig(client.ClientConfig{
+			APIKey:    "sk-proj-main",
+			ProjectID: "proj_123",
+			APIURL:    "https://api.example.com/v1",
+		}),
+		ProjectAPIKey: "sk-proj-other",
+		AdminAPIKey:   "sk-admin-key",
+	}
+
+	projectClient, err := GetOpenAIClientWithProjectKey(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if projectClient.APIKey != "sk-proj-other" || projectClient.ProjectID != "proj_123" {
+		t.Errorf("project-key client: key %q project %q, want the project key scoped to proj_123", projectClient.APIKey, projectClient.ProjectID)
+	}
+
+	adminClient, err := GetOpenAIClientWithAdminKey(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adminClient.ProjectID != "" {
+		t.Errorf("admin-key client ProjectID = %q, want none for organization-wide calls", adminClient.ProjectID)
+	}
+}
+
+func TestWithAPIKey_KeepsProviderSettings(t *testing.T) {
+	c := &OpenAIClient{
+		OpenAIClient: client.NewClientWithConfig(client.ClientConfig{
+			APIKey:           "sk-proj-main",
+			OrganizationID:   "org_123",
+			ProjectID:        "proj_123",
+			APIURL:           "https://api.example.com/v1",
+			Timeout:          42 * time.Second,
+			DryRun:           true,
+			DefaultHeaders:   map[string]string{"X-Team": "ml"},
+			TLSConfig:        &tls.Config{ServerName: "proxy.internal"},
+			MaxResponseBytes: 1024,
+		}),
+	}
+
+	for _, projectScoped := range []bool{true, false} {
+		got := c.withAPIKey("sk-other", projectScoped)
+		wantProject := ""
+		if projectScoped {
+			wantProject = "proj_123"
+		}
+		if got.APIKey != "sk-other" || got.ProjectID != wantProject || got.OrganizationID != "org_123" ||
+			got.APIURL != "https://api.example.com/v1" || got.Timeout != 42*time.Second || !got.DryRun ||
+			got.DefaultHeaders["X-Team"] != "ml" || got.TLSConfig == nil || got.TLSConfig.ServerName != "proxy.internal" ||
+			got.MaxResponseBytes != 1024 {
+			t.Errorf("withAPIKey(projectScoped=%v) = %+v, want the provider's settings with the new key", projectScoped, got)
+		}
+	}
+}
+
+func TestConfigure_ProjectIDConflictsWithDefaultHeader(t *testing.T) {
+	ctx := context.Background()
+	p := &FrameworkProvider{version: "test"}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["project_id"] = tftypes.NewValue(tftypes.String, "proj_123")
+	vals["default_headers"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"openai-project": tftypes.NewValue(tftypes.String, "proj_456"),
+	})
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a conflict error when project_id and default_headers both set OpenAI-Project")
+	}
+}
+
+func TestConfigure_TLSOptions(t *testing.T) {
+	ctx := context.Background()
+
+	p := &FrameworkProvider{version: "test"}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	configure := func(attr string, value tftypes.Value) *provider.ConfigureResponse {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		vals["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+		vals[attr] = value
+		resp := &provider.ConfigureResponse{}
+		p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+		return resp
+	}
+
+	resp := configure("tls_insecure_skip_verify", tftypes.NewValue(tftypes.Bool, true))
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("tls_insecure_skip_verify diagnostics = %v, want a single warning", resp.Diagnostics)
+	}
+	c := resp.ResourceData.(*OpenAIClient)
+	if c.OpenAIClient.TLSConfig == nil || !c.OpenAIClient.TLSConfig.InsecureSkipVerify {
+		t.Error("client TLS config does not skip verification")
+	}
+	if admin, _ := GetOpenAIClientWithAdminKey(&OpenAIClient{OpenAIClient: c.OpenAIClient, AdminAPIKey: "sk-admin-x"}); admin.TLSConfig != c.OpenAIClient.TLSConfig {
+		t.Error("admin client does not inherit the TLS config")
+	}
+	if ct, ok := c.OpenAIClient.HTTPClient.Transport.(*http.Transport); !ok || ct.TLSClientConfig == nil || !ct.TLSClientConfig.InsecureSkipVerify {
+		t.Error("client transport does not skip verification")
+	}
+	if dt, ok := http.DefaultTransport.(*http.Transport); ok && dt.TLSClientConfig != nil && dt.TLSClientConfig.InsecureSkipVerify {
+		t.Error("http.DefaultTransport skips verification; the setting must stay on the provider's own client")
+	}
+
+	resp = configure("ca_bundle_file", tftypes.NewValue(tftypes.String, "/nonexistent/ca.pem"))
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a missing ca_bundle_file")
+	}
+}
@@ -7,19 +7,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &AdminAPIKeyResource{}
 var _ resource.ResourceWithImportState = &AdminAPIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &AdminAPIKeyResource{}
 
 type AdminAPIKeyResource struct {
 	client *OpenAIClient
@@ -34,13 +36,15 @@ func (r *AdminAPIKeyResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 type AdminAPIKeyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Scopes      types.List   `tfsdk:"scopes"`
-	ExpiresAt   types.Int64  `tfsdk:"expires_at"`
-	CreatedAt   types.Int64  `tfsdk:"created_at"`
-	APIKeyValue types.String `tfsdk:"api_key_value"`
-	Object      types.String `tfsdk:"object"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+	ExpiresAt        types.Int64  `tfsdk:"expires_at"`
+	RotateTrigger    types.String `tfsdk:"rotate_trigger"`
+	RecreateOnExpiry types.Bool   `tfsdk:"recreate_on_expiry"`
+	CreatedAt        types.Int64  `tfsdk:"created_at"`
+	APIKeyValue      types.String `tfsdk:"api_key_value"`
+	Object           types.String `tfsdk:"object"`
 }
 
 func (r *AdminAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -62,21 +66,31 @@ func (r *AdminAPIKeyResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"scopes": schema.ListAttribute{
+			"scopes": schema.SetAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "Scopes to assign to the API key.",
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+				Description: "Scopes to assign to the API key. Order is not significant.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
 				},
 			},
 			"expires_at": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Unix timestamp when the API key should expire.",
+				Computed:            true,
+				MarkdownDescription: "Unix timestamp when the API key should expire. Read back from the API; null if the key never expires.",
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotate_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value (e.g. a timestamp) that rotates the key when changed. A new key is created, `id` and `api_key_value` are updated, and the old key is deleted in the same apply.",
+			},
+			"recreate_on_expiry": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Replace the key on the next apply once `expires_at` has passed, so an expired key is reissued instead of lingering in state. If `expires_at` is configured it must then be moved to a future time, e.g. with a `time_rotating` resource.",
+			},
 			"created_at": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The timestamp (in Unix time) when the API key was created.",
@@ -88,7 +102,7 @@ func (r *AdminAPIKeyResource) Schema(ctx context.Context, req resource.SchemaReq
 			"api_key_value": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The value of the API key (only available upon creation).",
+				MarkdownDescription: "The value of the API key (only available upon creation or rotation).",
 			},
 		},
 	}
@@ -116,6 +130,10 @@ func (r *AdminAPIKeyResource) Create(ctx context.Context, req resource.CreateReq
 	createRequest := AdminAPIKeyCreateRequest{
 		Name: data.Name.ValueString(),
 	}
+	// expires_at is unknown when it is not configured; only send a real value.
+	if !data.ExpiresAt.IsUnknown() {
+		createRequest.ExpiresAt = data.ExpiresAt.ValueInt64Pointer()
+	}
 
 	if !data.Scopes.IsNull() {
 		var scopes []string
@@ -149,7 +167,7 @@ func (r *AdminAPIKeyResource) Create(ctx context.Context, req resource.CreateReq
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -173,9 +191,7 @@ func (r *AdminAPIKeyResource) Create(ctx context.Context, req resource.CreateReq
 	data.CreatedAt = types.Int64Value(keyResp.CreatedAt)
 	data.Object = types.StringValue(keyResp.Object)
 	data.APIKeyValue = types.StringValue(keyResp.Key)
-	if keyResp.ExpiresAt != nil {
-		data.ExpiresAt = types.Int64Value(*keyResp.ExpiresAt)
-	}
+	data.ExpiresAt = types.Int64PointerValue(keyResp.ExpiresAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -203,7 +219,7 @@ func (r *AdminAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -229,20 +245,125 @@ func (r *AdminAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest
 	data.Name = types.StringValue(keyResp.Name)
 	data.CreatedAt = types.Int64Value(keyResp.CreatedAt)
 	data.Object = types.StringValue(keyResp.Object)
-	if keyResp.ExpiresAt != nil {
-		data.ExpiresAt = types.Int64Value(*keyResp.ExpiresAt)
-	}
+	data.ExpiresAt = types.Int64PointerValue(keyResp.ExpiresAt)
 
 	if len(keyResp.Scopes) > 0 {
-		scopes, _ := types.ListValueFrom(ctx, types.StringType, keyResp.Scopes)
+		scopes, diags := types.SetValueFrom(ctx, types.StringType, keyResp.Scopes)
+		resp.Diagnostics.Append(diags...)
 		data.Scopes = scopes
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan marks the key's identity as unknown when rotate_trigger changes,
+// since Update replaces the key in place and returns a new ID and secret. With
+// recreate_on_expiry set, it also forces replacement of a key whose
+// expires_at has passed.
+func (r *AdminAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AdminAPIKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expired := plan.RecreateOnExpiry.ValueBool() && !state.ExpiresAt.IsNull() &&
+		time.Now().Unix() >= state.ExpiresAt.ValueInt64()
+	if !expired && plan.RotateTrigger.Equal(state.RotateTrigger) {
+		return
+	}
+
+	if expired {
+		var configured types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_at"), &configured)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !configured.IsNull() && !configured.IsUnknown() && time.Now().Unix() >= configured.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Admin API key has expired",
+				fmt.Sprintf("Key %s expired at %d and recreate_on_expiry is set, but the configured expires_at is not in the future, so a replacement key would already be expired. Set expires_at to a future time.",
+					state.ID.ValueString(), configured.ValueInt64()),
+			)
+			return
+		}
+		if configured.IsNull() {
+			plan.ExpiresAt = types.Int64Unknown()
+		}
+		plan.Object = types.StringUnknown()
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+	}
+
+	plan.ID = types.StringUnknown()
+	plan.APIKeyValue = types.StringUnknown()
+	plan.CreatedAt = types.Int64Unknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Update only handles rotate_trigger; every other argument forces replacement.
+// Rotation creates the new key before deleting the old one so the
+// organization is never left without a usable key.
 func (r *AdminAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Immutable
+	var plan, state AdminAPIKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotateTrigger.Equal(state.RotateTrigger) {
+		plan.ID = state.ID
+		plan.APIKeyValue = state.APIKeyValue
+		plan.CreatedAt = state.CreatedAt
+		plan.Object = state.Object
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	adminClient, err := GetOpenAIClientWithAdminKey(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
+	}
+
+	var scopes []string
+	if !plan.Scopes.IsNull() {
+		resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	newKey, err := adminClient.CreateAPIKey(plan.Name.ValueString(), plan.ExpiresAt.ValueInt64Pointer(), scopes)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rotating admin API key", fmt.Sprintf("Could not create replacement key: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(newKey.ID)
+	plan.APIKeyValue = types.StringValue(newKey.Key)
+	plan.CreatedAt = types.Int64Value(newKey.CreatedAt)
+	plan.Object = types.StringValue(newKey.Object)
+
+	// Persist the new key before deleting the old one, so a failed delete
+	// never loses track of the secret that was just issued.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := adminClient.DeleteAPIKey(state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Old admin API key not deleted",
+			fmt.Sprintf("The key was rotated to %s, but deleting the previous key %s failed: %s. Delete it manually.", newKey.ID, state.ID.ValueString(), err),
+		)
+	}
 }
 
 func (r *AdminAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -267,7 +388,7 @@ func (r *AdminAPIKeyResource) Delete(ctx context.Context, req resource.DeleteReq
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	r.client.Do(apiReq)
 }
 
 func (r *AdminAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
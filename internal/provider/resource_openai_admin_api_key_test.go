@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccResourceOpenAIAdminAPIKey_RotateTrigger changes rotate_trigger and
+// checks that the key is replaced in place: a new ID and secret land in state
+// and the previous key is deleted.
+//
+// Set TF_ACC=1 to run.
+func TestAccResourceOpenAIAdminAPIKey_RotateTrigger(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := newMockAdminAPIKeyServer()
+	defer srv.Close()
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOpenAIAdminAPIKeyRotate(srv.URL, "2026-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "id", "key_1"),
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "api_key_value", "sk-admin-1"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["openai_admin_api_key.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceOpenAIAdminAPIKeyRotate(srv.URL, "2026-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "id", "key_2"),
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "api_key_value", "sk-admin-2"),
+					func(s *terraform.State) error {
+						if srv.exists(firstID) {
+							return fmt.Errorf("old key %s was not deleted after rotation", firstID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestAccResourceOpenAIAdminAPIKey_ScopesAndExpiry creates a key with two
+// scopes and an expiry against a mock API that reorders the scopes, then
+// re-plans the same config. The second plan must be empty.
+//
+// Set TF_ACC=1 to run.
+func TestAccResourceOpenAIAdminAPIKey_ScopesAndExpiry(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := newMockAdminAPIKeyServer()
+	defer srv.Close()
+
+	config := fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+resource "openai_admin_api_key" "test" {
+  name       = "scoped"
+  scopes     = ["api.management.read", "api.management.write"]
+  expires_at = 1893456000
+}
+`, srv.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "scopes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("openai_admin_api_key.test", "scopes.*", "api.management.read"),
+					resource.TestCheckTypeSetElemAttr("openai_admin_api_key.test", "scopes.*", "api.management.write"),
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "expires_at", "1893456000"),
+				),
+			},
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAdminAPIKeyModifyPlan_RecreateOnExpiry(t *testing.T) {
+	ctx := context.Background()
+	r := &AdminAPIKeyResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	past := time.Now().Add(-time.Hour).Unix()
+	future := time.Now().Add(90 * 24 * time.Hour).Unix()
+
+	int64Value := func(v *int64) tftypes.Value {
+		if v == nil {
+			return tftypes.NewValue(tftypes.Number, nil)
+		}
+		return tftypes.NewValue(tftypes.Number, *v)
+	}
+	// modifyPlan plans a key whose state expires at stateExpiry against a
+	// config with the given recreate_on_expiry and expires_at.
+	modifyPlan := func(recreate bool, stateExpiry int64, configExpiry *int64) *fwresource.ModifyPlanResponse {
+		base := func() map[string]tftypes.Value {
+			vals := map[string]tftypes.Value{}
+			for name, typ := range objType.AttributeTypes {
+				vals[name] = tftypes.NewValue(typ, nil)
+			}
+			vals["name"] = tftypes.NewValue(tftypes.String, "ci")
+			vals["recreate_on_expiry"] = tftypes.NewValue(tftypes.Bool, recreate)
+			return vals
+		}
+
+		stateVals := base()
+		stateVals["id"] = tftypes.NewValue(tftypes.String, "key_1")
+		stateVals["expires_at"] = tftypes.NewValue(tftypes.Number, stateExpiry)
+		stateVals["created_at"] = tftypes.NewValue(tftypes.Number, 1700000000)
+		stateVals["object"] = tftypes.NewValue(tftypes.String, "organization.admin_api_key")
+		stateVals["api_key_value"] = tftypes.NewValue(tftypes.String, "sk-admin-1")
+
+		configVals := base()
+		configVals["expires_at"] = int64Value(configExpiry)
+
+		planVals := base()
+		for name, v := range stateVals {
+			planVals[name] = v
+		}
+		if configExpiry != nil {
+			planVals["expires_at"] = int64Value(configExpiry)
+		}
+
+		plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, planVals)}
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, configVals)},
+			State:  tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, stateVals)},
+			Plan:   plan,
+		}, resp)
+		return resp
+	}
+
+	resp := modifyPlan(true, past, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expired key: %v", resp.Diagnostics)
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("expires_at")) {
+		t.Errorf("expired key: RequiresReplace = %v, want expires_at", resp.RequiresReplace)
+	}
+	var planned AdminAPIKeyResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planned)...)
+	if !planned.ID.IsUnknown() || !planned.APIKeyValue.IsUnknown() || !planned.ExpiresAt.IsUnknown() {
+		t.Errorf("expired key: id=%s api_key_value unknown=%v expires_at=%s, want a fresh key planned", planned.ID, planned.APIKeyValue.IsUnknown(), planned.ExpiresAt)
+	}
+
+	if resp := modifyPlan(true, past, &future); resp.Diagnostics.HasError() || len(resp.RequiresReplace) != 1 {
+		t.Errorf("expired key with a future expires_at: diagnostics=%v RequiresReplace=%v, want replacement", resp.Diagnostics, resp.RequiresReplace)
+	}
+
+	if resp := modifyPlan(false, past, nil); len(resp.RequiresReplace) != 0 {
+		t.Errorf("recreate_on_expiry unset: RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+
+	if resp := modifyPlan(true, future, nil); len(resp.RequiresReplace) != 0 {
+		t.Errorf("unexpired key: RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+
+	if resp := modifyPlan(true, past, &past); !resp.Diagnostics.HasError() {
+		t.Error("expired key whose configured expires_at has also passed: want an error, got none")
+	}
+}
+
+func testAccResourceOpenAIAdminAPIKeyRotate(apiURL, trigger string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+resource "openai_admin_api_key" "test" {
+  name           = "rotated"
+  rotate_trigger = %q
+}
+`, apiURL, trigger)
+}
+
+// mockAdminAPIKeyServer fakes the admin API key endpoints, issuing
+// sequential IDs and secrets.
+type mockAdminAPIKeyServer struct {
+	*httptest.Server
+	mu   sync.Mutex
+	next int
+	keys map[string]map[string]interface{}
+}
+
+func newMockAdminAPIKeyServer() *mockAdminAPIKeyServer {
+	srv := &mockAdminAPIKeyServer{keys: map[string]map[string]interface{}{}}
+	srv.Server = httptest.NewServer(http.HandlerFunc(srv.handle))
+	return srv
+}
+
+func (s *mockAdminAPIKeyServer) exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.keys[id]
+	return ok
+}
+
+func (s *mockAdminAPIKeyServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	const base = "/v1/organization/admin_api_keys"
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, base), "/")
+
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.next++
+		key := map[string]interface{}{
+			"object":     "organization.admin_api_key",
+			"id":         fmt.Sprintf("key_%d", s.next),
+			"name":       body["name"],
+			"created_at": 1700000000 + s.next,
+		}
+		if v, ok := body["expires_at"]; ok {
+			key["expires_at"] = v
+		}
+		// Return scopes in the reverse of the requested order, as the API
+		// makes no ordering guarantee.
+		if scopes, ok := body["scopes"].([]interface{}); ok {
+			reversed := make([]interface{}, len(scopes))
+			for i, sc := range scopes {
+				reversed[len(scopes)-1-i] = sc
+			}
+			key["scopes"] = reversed
+		}
+		s.keys[key["id"].(string)] = key
+		created := map[string]interface{}{"key": fmt.Sprintf("sk-admin-%d", s.next)}
+		for k, v := range key {
+			created[k] = v
+		}
+		writeJSON(w, http.StatusOK, created)
+	case r.Method == http.MethodGet && s.keys[id] != nil:
+		writeJSON(w, http.StatusOK, s.keys[id])
+	case r.Method == http.MethodDelete && s.keys[id] != nil:
+		delete(s.keys, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": id, "object": "organization.admin_api_key.deleted", "deleted": true})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "not found"}})
+	}
+}
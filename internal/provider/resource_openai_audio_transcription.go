@@ -228,7 +228,7 @@ func (r *AudioTranscriptionResource) Create(ctx context.Context, req resource.Cr
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
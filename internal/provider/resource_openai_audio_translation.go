@@ -1,17 +1,14 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/http"
-	"os"
-	"path/filepath"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,12 +16,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &AudioTranslationResource{}
 var _ resource.ResourceWithImportState = &AudioTranslationResource{}
 
+// translationModels are the models the translations endpoint accepts. The
+// gpt-4o transcribe models only support transcriptions.
+var translationModels = []string{"whisper-1"}
+
+var translationSegmentType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":    types.Int64Type,
+		"start": types.Float64Type,
+		"end":   types.Float64Type,
+		"text":  types.StringType,
+	},
+}
+
 type AudioTranslationResource struct {
 	client *OpenAIClient
 }
@@ -46,14 +57,15 @@ type AudioTranslationResourceModel struct {
 	Temperature    types.Float64 `tfsdk:"temperature"`
 
 	// Computed outputs
-	Text     types.String  `tfsdk:"text"`
-	Duration types.Float64 `tfsdk:"duration"`
-	Segments types.List    `tfsdk:"segments"` // List of Objects
+	FileSHA256 types.String  `tfsdk:"file_sha256"`
+	Text       types.String  `tfsdk:"text"`
+	Duration   types.Float64 `tfsdk:"duration"`
+	Segments   types.List    `tfsdk:"segments"` // List of Objects
 }
 
 func (r *AudioTranslationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates an audio translation. Note: This resource does not support updates.",
+		MarkdownDescription: "Translates a local audio file into English text. Note: This resource does not support updates; it is recreated when the audio file changes or is removed.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -72,14 +84,17 @@ func (r *AudioTranslationResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"model": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "ID of the model to use (e.g., 'whisper-1').",
+				MarkdownDescription: "ID of the model to use. Only `whisper-1` supports translation.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(translationModels...),
+				},
 			},
 			"prompt": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "An optional text to guide the model.",
+				MarkdownDescription: "An optional text in English to guide the model.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -87,10 +102,13 @@ func (r *AudioTranslationResource) Schema(ctx context.Context, req resource.Sche
 			"response_format": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The format of the transcript output (e.g. json, text).",
+				MarkdownDescription: "The format of the output: `json`, `text`, `srt`, `verbose_json` or `vtt`. Defaults to `json`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("json", "text", "srt", "verbose_json", "vtt"),
+				},
 			},
 			"temperature": schema.Float64Attribute{
 				Optional:            true,
@@ -99,26 +117,29 @@ func (r *AudioTranslationResource) Schema(ctx context.Context, req resource.Sche
 				PlanModifiers: []planmodifier.Float64{
 					float64planmodifier.RequiresReplace(),
 				},
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"file_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 of `file` when it was translated. The translation is recreated when the file no longer matches.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"text": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The translated text.",
+				MarkdownDescription: "The English translation, or the subtitles for `srt` and `vtt`.",
 			},
 			"duration": schema.Float64Attribute{
 				Computed:            true,
-				MarkdownDescription: "Duration of the audio in seconds.",
+				MarkdownDescription: "Duration of the audio in seconds. Only set for `verbose_json`.",
 			},
 			"segments": schema.ListAttribute{
-				Computed: true,
-				ElementType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"id":    types.Int64Type,
-						"start": types.Float64Type,
-						"end":   types.Float64Type,
-						"text":  types.StringType,
-					},
-				},
-				MarkdownDescription: "Segments of the translation.",
+				Computed:            true,
+				ElementType:         translationSegmentType,
+				MarkdownDescription: "Segments of the translation. Only set for `verbose_json`.",
 			},
 		},
 	}
@@ -143,131 +164,74 @@ func (r *AudioTranslationResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// Prepare multipart request
-	var requestBody bytes.Buffer
-	writer := multipart.NewWriter(&requestBody)
-
-	writer.WriteField("model", data.Model.ValueString())
-	if !data.Prompt.IsNull() {
-		writer.WriteField("prompt", data.Prompt.ValueString())
-	}
-
-	responseFormat := "json"
-	if !data.ResponseFormat.IsNull() {
-		responseFormat = data.ResponseFormat.ValueString()
-		writer.WriteField("response_format", responseFormat)
-	}
-
-	if !data.Temperature.IsNull() {
-		writer.WriteField("temperature", fmt.Sprintf("%f", data.Temperature.ValueFloat64()))
-	}
-
-	// File
-	filePathProp := data.File.ValueString()
-	file, err := os.Open(filePathProp)
+	filePath := data.File.ValueString()
+	checksum, err := fileSHA256(filePath)
 	if err != nil {
-		resp.Diagnostics.AddError("Error opening file", err.Error())
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "Error reading audio file", err.Error())
 		return
 	}
-	defer file.Close()
 
-	part, err := writer.CreateFormFile("file", filepath.Base(filePathProp))
-	if err != nil {
-		resp.Diagnostics.AddError("Error adding file to form", err.Error())
-		return
+	responseFormat := "json"
+	if v := data.ResponseFormat.ValueString(); v != "" {
+		responseFormat = v
 	}
-	io.Copy(part, file)
-	writer.Close()
-
-	url := fmt.Sprintf("%s/audio/translations", r.client.OpenAIClient.APIURL)
-	apiReq, err := http.NewRequest("POST", url, &requestBody)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
+	fields := map[string]string{
+		"model":           data.Model.ValueString(),
+		"response_format": responseFormat,
 	}
-
-	apiReq.Header.Set("Content-Type", writer.FormDataContentType())
-	apiKey := r.client.OpenAIClient.APIKey
-	apiReq.Header.Set("Authorization", "Bearer "+apiKey)
-	if r.client.OpenAIClient.OrganizationID != "" {
-		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
+	if v := data.Prompt.ValueString(); v != "" {
+		fields["prompt"] = v
 	}
-
-	apiResp, err := http.DefaultClient.Do(apiReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
+	if !data.Temperature.IsNull() && !data.Temperature.IsUnknown() {
+		fields["temperature"] = strconv.FormatFloat(data.Temperature.ValueFloat64(), 'f', -1, 64)
 	}
-	defer apiResp.Body.Close()
 
-	if apiResp.StatusCode != http.StatusOK {
-		respBodyBytes, _ := io.ReadAll(apiResp.Body)
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes)))
+	respBody, err := r.client.PostMultipart(ctx, "audio/translations", fields, map[string]string{"file": filePath})
+	if err != nil {
+		resp.Diagnostics.AddError("Error translating audio", err.Error())
 		return
 	}
 
-	respBodyBytes, _ := io.ReadAll(apiResp.Body)
-
+	data.Duration = types.Float64Null()
+	data.Segments = types.ListNull(translationSegmentType)
 	if responseFormat == "json" || responseFormat == "verbose_json" {
 		var transResp TranslationResponseFramework
-		if err := json.Unmarshal(respBodyBytes, &transResp); err != nil {
+		if err := json.Unmarshal(respBody, &transResp); err != nil {
 			resp.Diagnostics.AddError("Error parsing response", err.Error())
 			return
 		}
 		data.Text = types.StringValue(transResp.Text)
-		data.Duration = types.Float64Value(transResp.Duration)
 
-		// Segments
-		if len(transResp.Segments) > 0 {
-			segmentObjects := []attr.Value{}
+		if responseFormat == "verbose_json" {
+			data.Duration = types.Float64Value(transResp.Duration)
+			segments := make([]attr.Value, 0, len(transResp.Segments))
 			for _, s := range transResp.Segments {
-				obj, _ := types.ObjectValue(
-					map[string]attr.Type{
-						"id":    types.Int64Type,
-						"start": types.Float64Type,
-						"end":   types.Float64Type,
-						"text":  types.StringType,
-					},
-					map[string]attr.Value{
-						"id":    types.Int64Value(int64(s.ID)),
-						"start": types.Float64Value(s.Start),
-						"end":   types.Float64Value(s.End),
-						"text":  types.StringValue(s.Text),
-					},
-				)
-				segmentObjects = append(segmentObjects, obj)
+				obj, d := types.ObjectValue(translationSegmentType.AttrTypes, map[string]attr.Value{
+					"id":    types.Int64Value(int64(s.ID)),
+					"start": types.Float64Value(s.Start),
+					"end":   types.Float64Value(s.End),
+					"text":  types.StringValue(s.Text),
+				})
+				resp.Diagnostics.Append(d...)
+				segments = append(segments, obj)
 			}
-
-			listVal, _ := types.ListValue(types.ObjectType{AttrTypes: map[string]attr.Type{
-				"id":    types.Int64Type,
-				"start": types.Float64Type,
-				"end":   types.Float64Type,
-				"text":  types.StringType,
-			}}, segmentObjects)
-			data.Segments = listVal
-		} else {
-			data.Segments = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-				"id":    types.Int64Type,
-				"start": types.Float64Type,
-				"end":   types.Float64Type,
-				"text":  types.StringType,
-			}})
+			list, d := types.ListValue(translationSegmentType, segments)
+			resp.Diagnostics.Append(d...)
+			data.Segments = list
 		}
 	} else {
-		// Text/SRT/VTT
-		data.Text = types.StringValue(string(respBodyBytes))
-		data.Duration = types.Float64Null()
-		data.Segments = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-			"id":    types.Int64Type,
-			"start": types.Float64Type,
-			"end":   types.Float64Type,
-			"text":  types.StringType,
-		}})
+		// text, srt and vtt are returned as is.
+		data.Text = types.StringValue(string(respBody))
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	data.ID = types.StringValue(fmt.Sprintf("translation-%d", time.Now().UnixNano()))
-	if data.ResponseFormat.IsNull() {
-		data.ResponseFormat = types.StringValue(responseFormat)
+	data.FileSHA256 = types.StringValue(checksum)
+	data.ResponseFormat = types.StringValue(responseFormat)
+	if data.Temperature.IsUnknown() {
+		data.Temperature = types.Float64Null()
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -280,7 +244,9 @@ func (r *AudioTranslationResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	if _, err := os.Stat(data.File.ValueString()); os.IsNotExist(err) {
+	// A removed or modified audio file makes the translation stale, so drop
+	// it and let Terraform translate the file again.
+	if audioInputDrifted(data.File.ValueString(), data.FileSHA256) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAudioTranslationCreate_UploadsAndDetectsDrift(t *testing.T) {
+	ctx := context.Background()
+	audio := writeTestImage(t, t.TempDir(), "speech.mp3", "hola-bytes")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/audio/translations" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("parsing form: %v", err)
+		}
+		for field, want := range map[string]string{"model": "whisper-1", "response_format": "verbose_json", "temperature": "0.2"} {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("%s = %q, want %q", field, got, want)
+			}
+		}
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("form file: %v", err)
+		}
+		if got, _ := io.ReadAll(f); string(got) != "hola-bytes" {
+			t.Errorf("file = %q", got)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"text":     "Hello",
+			"duration": 1.5,
+			"segments": []map[string]interface{}{{"id": 0, "start": 0, "end": 1.5, "text": "Hello"}},
+		})
+	}))
+	defer server.Close()
+
+	r := &AudioTranslationResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	vals["file"] = tftypes.NewValue(tftypes.String, audio)
+	vals["model"] = tftypes.NewValue(tftypes.String, "whisper-1")
+	vals["prompt"] = tftypes.NewValue(tftypes.String, nil)
+	vals["response_format"] = tftypes.NewValue(tftypes.String, "verbose_json")
+	vals["temperature"] = tftypes.NewValue(tftypes.Number, 0.2)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", resp.Diagnostics)
+	}
+
+	var data AudioTranslationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.Text.ValueString() != "Hello" || data.Duration.ValueFloat64() != 1.5 || len(data.Segments.Elements()) != 1 {
+		t.Errorf("text=%s duration=%s segments=%s", data.Text, data.Duration, data.Segments)
+	}
+	if want, _ := fileSHA256(audio); data.FileSHA256.ValueString() != want {
+		t.Errorf("file_sha256 = %s, want %s", data.FileSHA256, want)
+	}
+
+	readResp := &resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+	if readResp.State.Raw.IsNull() {
+		t.Fatal("read dropped the resource although the file is unchanged")
+	}
+
+	// Changing the audio file drops the resource so it is translated again.
+	if err := os.WriteFile(audio, []byte("bonjour-bytes"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	readResp = &resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+	if !readResp.State.Raw.IsNull() {
+		t.Error("read kept the resource although the file changed")
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,6 +30,16 @@ func NewBatchResource() resource.Resource {
 	return &BatchResource{}
 }
 
+// batchEndpoints lists the endpoints the Batch API accepts. Every request line
+// in the input file must target the same endpoint.
+var batchEndpoints = []string{
+	"/v1/responses",
+	"/v1/chat/completions",
+	"/v1/embeddings",
+	"/v1/completions",
+	"/v1/moderations",
+}
+
 func (r *BatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_batch"
 }
@@ -97,10 +109,13 @@ func (r *BatchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"endpoint": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The endpoint to use for the batch request (e.g., '/v1/chat/completions').",
+				MarkdownDescription: "The endpoint to use for the batch request. One of `/v1/responses`, `/v1/chat/completions`, `/v1/embeddings`, `/v1/completions` or `/v1/moderations`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(batchEndpoints...),
+				},
 			},
 			"completion_window": schema.StringAttribute{
 				Optional: true,
@@ -241,7 +256,7 @@ func (r *BatchResource) Create(ctx context.Context, req resource.CreateRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -293,7 +308,7 @@ func (r *BatchResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -419,7 +434,7 @@ func (r *BatchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	r.client.Do(apiReq)
 }
 
 func (r *BatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
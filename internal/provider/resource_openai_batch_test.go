@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBatchEndpointValidation(t *testing.T) {
+	schemaResp := &resource.SchemaResponse{}
+	NewBatchResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	endpoint := schemaResp.Schema.Attributes["endpoint"].(schema.StringAttribute)
+
+	cases := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"chat completions", "/v1/chat/completions", false},
+		{"responses", "/v1/responses", false},
+		{"embeddings", "/v1/embeddings", false},
+		{"unsupported endpoint", "/v1/images/generations", true},
+		{"missing version prefix", "chat/completions", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("endpoint"), ConfigValue: types.StringValue(tc.value)}
+			resp := &validator.StringResponse{}
+			for _, v := range endpoint.Validators {
+				v.ValidateString(context.Background(), req, resp)
+			}
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Errorf("HasError() = %v, want %v (diags: %v)", got, tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
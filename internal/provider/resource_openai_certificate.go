@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+
+type CertificateResource struct {
+	client *client.OpenAIClient
+}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+type CertificateResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Content    types.String `tfsdk:"content"`
+	Active     types.Bool   `tfsdk:"active"`
+	ProjectIDs types.Set    `tfsdk:"project_ids"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+	ValidAt    types.Int64  `tfsdk:"valid_at"`
+	ExpiresAt  types.Int64  `tfsdk:"expires_at"`
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a client certificate uploaded to the OpenAI organization for mutual TLS, and where it is active. Requires an admin API key.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the certificate.",
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The PEM-encoded certificate, e.g. `file(\"client.pem\")`. Changing it uploads a new certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the certificate is active for the whole organization. Defaults to `false`.",
+			},
+			"project_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the projects the certificate is active in. Only these projects are checked on refresh; activations in other projects are not managed.",
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The Unix timestamp when the certificate was uploaded.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The Unix timestamp when the certificate becomes valid.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The Unix timestamp when the certificate expires.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+
+	// Certificates can only be managed with an Admin API Key
+	cl, err := GetOpenAIClientWithAdminKey(providerClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
+	}
+	r.client = cl
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectIDs []string
+	resp.Diagnostics.Append(data.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.UploadCertificate(data.Name.ValueString(), data.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error uploading certificate", err.Error())
+		return
+	}
+	setCertificateComputed(&data, cert)
+
+	// Record the upload before activating it, so a failed activation is
+	// retried on the next apply instead of uploading a second copy.
+	wantActive, wantProjects := data.Active, data.ProjectIDs
+	data.Active = types.BoolValue(false)
+	data.ProjectIDs = types.SetNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if wantActive.ValueBool() {
+		if err := r.client.ActivateCertificate("", cert.ID); err != nil {
+			resp.Diagnostics.AddError("Error activating certificate", err.Error())
+			return
+		}
+		data.Active = wantActive
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	var activated []string
+	for _, projectID := range projectIDs {
+		if err := r.client.ActivateCertificate(projectID, cert.ID); err != nil {
+			resp.Diagnostics.AddError("Error activating certificate", fmt.Sprintf("Activating in project %s: %s", projectID, err))
+			return
+		}
+		// Record each project as it is activated, so a later failure
+		// leaves it in state for the next apply and for Delete.
+		activated = append(activated, projectID)
+		projects, diags := types.SetValueFrom(ctx, types.StringType, activated)
+		resp.Diagnostics.Append(diags...)
+		data.ProjectIDs = projects
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	data.Active = wantActive
+	data.ProjectIDs = wantProjects
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setCertificateComputed copies the API's computed fields into data.
+func setCertificateComputed(data *CertificateResourceModel, cert *client.Certificate) {
+	data.ID = types.StringValue(cert.ID)
+	data.CreatedAt = types.Int64Value(cert.CreatedAt)
+	data.ValidAt = types.Int64Value(cert.CertificateDetails.ValidAt)
+	data.ExpiresAt = types.Int64Value(cert.CertificateDetails.ExpiresAt)
+}
+
+// certificateActive reports whether id is active among the certificates of
+// a project, or of the organization when projectID is empty.
+func (r *CertificateResource) certificateActive(projectID, id string) (bool, error) {
+	certs, err := r.client.ListCertificates(projectID)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range certs {
+		if c.ID == id {
+			return c.Active, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Content is only read back after an import; the API may normalise
+	// the PEM, which would otherwise show as a diff against the config.
+	cert, err := r.client.GetCertificate(data.ID.ValueString(), data.Content.IsNull())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading certificate", err.Error())
+		return
+	}
+	setCertificateComputed(&data, cert)
+	if cert.Name != "" || !data.Name.IsNull() {
+		data.Name = types.StringValue(cert.Name)
+	}
+	if data.Content.IsNull() {
+		data.Content = types.StringValue(cert.CertificateDetails.Content)
+	}
+
+	active, err := r.certificateActive("", cert.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing organization certificates", err.Error())
+		return
+	}
+	data.Active = types.BoolValue(active)
+
+	if !data.ProjectIDs.IsNull() {
+		var projectIDs, activeIn []string
+		resp.Diagnostics.Append(data.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, projectID := range projectIDs {
+			active, err := r.certificateActive(projectID, cert.ID)
+			if err != nil && !client.IsNotFound(err) {
+				resp.Diagnostics.AddError("Error listing project certificates", fmt.Sprintf("Project %s: %s", projectID, err))
+				return
+			}
+			if active {
+				activeIn = append(activeIn, projectID)
+			}
+		}
+		projects, diags := types.SetValueFrom(ctx, types.StringType, activeIn)
+		resp.Diagnostics.Append(diags...)
+		data.ProjectIDs = projects
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	if !plan.Name.Equal(state.Name) {
+		if _, err := r.client.UpdateCertificate(id, plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error renaming certificate", err.Error())
+			return
+		}
+	}
+
+	if !plan.Active.Equal(state.Active) {
+		var err error
+		if plan.Active.ValueBool() {
+			err = r.client.ActivateCertificate("", id)
+		} else {
+			err = r.client.DeactivateCertificate("", id)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error changing certificate activation", err.Error())
+			return
+		}
+	}
+
+	var planProjects, stateProjects []string
+	resp.Diagnostics.Append(plan.ProjectIDs.ElementsAs(ctx, &planProjects, false)...)
+	resp.Diagnostics.Append(state.ProjectIDs.ElementsAs(ctx, &stateProjects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	add, remove := diffStringSets(planProjects, stateProjects)
+	for _, projectID := range remove {
+		if err := r.client.DeactivateCertificate(projectID, id); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error deactivating certificate", fmt.Sprintf("Deactivating in project %s: %s", projectID, err))
+			return
+		}
+	}
+	for _, projectID := range add {
+		if err := r.client.ActivateCertificate(projectID, id); err != nil {
+			resp.Diagnostics.AddError("Error activating certificate", fmt.Sprintf("Activating in project %s: %s", projectID, err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = state.CreatedAt
+	plan.ValidAt = state.ValidAt
+	plan.ExpiresAt = state.ExpiresAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// diffStringSets returns the elements of want missing from have, and the
+// elements of have missing from want, each sorted.
+func diffStringSets(want, have []string) (add, remove []string) {
+	inWant := make(map[string]bool, len(want))
+	for _, v := range want {
+		inWant[v] = true
+	}
+	inHave := make(map[string]bool, len(have))
+	for _, v := range have {
+		inHave[v] = true
+		if !inWant[v] {
+			remove = append(remove, v)
+		}
+	}
+	for _, v := range want {
+		if !inHave[v] {
+			add = append(add, v)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := data.ID.ValueString()
+
+	// The API only deletes certificates that are active nowhere.
+	var projectIDs []string
+	resp.Diagnostics.Append(data.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, projectID := range projectIDs {
+		if err := r.client.DeactivateCertificate(projectID, id); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error deactivating certificate", fmt.Sprintf("Deactivating in project %s: %s", projectID, err))
+			return
+		}
+	}
+	if data.Active.ValueBool() {
+		if err := r.client.DeactivateCertificate("", id); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error deactivating certificate", err.Error())
+			return
+		}
+	}
+
+	if err := r.client.DeleteCertificate(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting certificate", err.Error())
+	}
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
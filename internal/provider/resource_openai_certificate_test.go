@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+const testCertificatePEM = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+// mockCertificateServer serves the organization and project certificate
+// endpoints for a single certificate, tracking where it is active and
+// logging every mutating call.
+type mockCertificateServer struct {
+	mu      sync.Mutex
+	name    string
+	deleted bool
+	active  map[string]bool // "" for the organization, else a project ID
+	failIn  string          // project whose activation fails, if any
+	calls   []string
+}
+
+func (m *mockCertificateServer) handler(t *testing.T) http.HandlerFunc {
+	cert := func() map[string]interface{} {
+		return map[string]interface{}{
+			"object":     "certificate",
+			"id":         "cert_abc",
+			"name":       m.name,
+			"created_at": 1700000000,
+			"certificate_details": map[string]interface{}{
+				"valid_at":   1700000000,
+				"expires_at": 1800000000,
+			},
+		}
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		scope := ""
+		p := strings.TrimPrefix(r.URL.Path, "/v1/organization/")
+		if strings.HasPrefix(p, "projects/") {
+			parts := strings.SplitN(strings.TrimPrefix(p, "projects/"), "/", 2)
+			scope, p = parts[0], parts[1]
+		}
+		if r.Method != http.MethodGet {
+			m.calls = append(m.calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/v1/"))
+		}
+
+		switch {
+		case r.Method == http.MethodPost && p == "certificates":
+			var body client.UploadCertificateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.Content != testCertificatePEM {
+				t.Errorf("uploaded content = %q", body.Content)
+			}
+			m.name = body.Name
+			writeJSON(w, http.StatusOK, cert())
+		case r.Method == http.MethodPost && p == "certificates/activate" && scope != "" && scope == m.failIn:
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": map[string]interface{}{"message": "activation failed"}})
+		case r.Method == http.MethodPost && (p == "certificates/activate" || p == "certificates/deactivate"):
+			var body struct {
+				CertificateIDs []string `json:"certificate_ids"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if !reflect.DeepEqual(body.CertificateIDs, []string{"cert_abc"}) {
+				t.Errorf("certificate_ids = %v", body.CertificateIDs)
+			}
+			m.active[scope] = p == "certificates/activate"
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{}})
+		case r.Method == http.MethodGet && p == "certificates":
+			c := cert()
+			c["object"] = "organization.certificate"
+			c["active"] = m.active[scope]
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": []interface{}{c}, "has_more": false})
+		case p == "certificates/cert_abc" && m.deleted:
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "not found"}})
+		case r.Method == http.MethodGet && p == "certificates/cert_abc":
+			writeJSON(w, http.StatusOK, cert())
+		case r.Method == http.MethodPost && p == "certificates/cert_abc":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			m.name = body["name"]
+			writeJSON(w, http.StatusOK, cert())
+		case r.Method == http.MethodDelete && p == "certificates/cert_abc":
+			for s, on := range m.active {
+				if on {
+					t.Errorf("certificate deleted while still active in %q", s)
+				}
+			}
+			m.deleted = true
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "certificate.deleted", "id": "cert_abc"})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+// takeCalls returns and clears the mutating calls seen so far.
+func (m *mockCertificateServer) takeCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := m.calls
+	m.calls = nil
+	return calls
+}
+
+func certificateModel(name string, active bool, projects ...string) CertificateResourceModel {
+	projectIDs := types.SetNull(types.StringType)
+	if len(projects) > 0 {
+		elems := make([]attr.Value, 0, len(projects))
+		for _, p := range projects {
+			elems = append(elems, types.StringValue(p))
+		}
+		projectIDs = types.SetValueMust(types.StringType, elems)
+	}
+	return CertificateResourceModel{
+		ID:         types.StringUnknown(),
+		Name:       types.StringValue(name),
+		Content:    types.StringValue(testCertificatePEM),
+		Active:     types.BoolValue(active),
+		ProjectIDs: projectIDs,
+		CreatedAt:  types.Int64Unknown(),
+		ValidAt:    types.Int64Unknown(),
+		ExpiresAt:  types.Int64Unknown(),
+	}
+}
+
+func TestCertificateResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockCertificateServer{active: map[string]bool{}}
+	server := httptest.NewServer(mock.handler(t))
+	defer server.Close()
+
+	r := &CertificateResource{client: client.NewClient("test-admin-key", "", server.URL+"/v1")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	empty := tftypes.NewValue(sch.Type().TerraformType(ctx), nil)
+
+	stateOf := func(t *testing.T, s tfsdk.State) CertificateResourceModel {
+		t.Helper()
+		var m CertificateResourceModel
+		if diags := s.Get(ctx, &m); diags.HasError() {
+			t.Fatalf("reading state: %v", diags)
+		}
+		return m
+	}
+
+	// Create: upload, then activate for the organization and proj_a.
+	plan := tfsdk.Plan{Schema: sch, Raw: empty}
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: empty}}
+	createResp.Diagnostics.Append(plan.Set(ctx, certificateModel("gateway", true, "proj_a"))...)
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+	if got, want := mock.takeCalls(), []string{
+		"POST organization/certificates",
+		"POST organization/certificates/activate",
+		"POST organization/projects/proj_a/certificates/activate",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("create calls = %v, want %v", got, want)
+	}
+	created := stateOf(t, createResp.State)
+	if created.ID.ValueString() != "cert_abc" || created.ExpiresAt.ValueInt64() != 1800000000 {
+		t.Errorf("created state = %+v", created)
+	}
+
+	// Read reports both activations back.
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", readResp.Diagnostics)
+	}
+	read := stateOf(t, readResp.State)
+	if !read.Active.ValueBool() || len(read.ProjectIDs.Elements()) != 1 || read.Name.ValueString() != "gateway" {
+		t.Errorf("read state = %+v, want active in the organization and proj_a", read)
+	}
+
+	// Update: rename, deactivate for the organization, move to proj_b.
+	update := certificateModel("gateway-2", false, "proj_b")
+	plan = tfsdk.Plan{Schema: sch, Raw: empty}
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	updateResp.Diagnostics.Append(plan.Set(ctx, update)...)
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("update: %v", updateResp.Diagnostics)
+	}
+	if got, want := mock.takeCalls(), []string{
+		"POST organization/certificates/cert_abc",
+		"POST organization/certificates/deactivate",
+		"POST organization/projects/proj_a/certificates/deactivate",
+		"POST organization/projects/proj_b/certificates/activate",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("update calls = %v, want %v", got, want)
+	}
+	if updated := stateOf(t, updateResp.State); updated.ID.ValueString() != "cert_abc" || updated.CreatedAt.IsUnknown() {
+		t.Errorf("updated state = %+v, want the computed values kept", updated)
+	}
+
+	// Delete: deactivate everywhere first, then delete.
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", deleteResp.Diagnostics)
+	}
+	if got, want := mock.takeCalls(), []string{
+		"POST organization/projects/proj_b/certificates/deactivate",
+		"DELETE organization/certificates/cert_abc",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("delete calls = %v, want %v", got, want)
+	}
+
+	// Once deleted, Read drops the certificate from state.
+	readResp = &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("read after delete: diags=%v, state null=%v", readResp.Diagnostics, readResp.State.Raw.IsNull())
+	}
+}
+
+func TestCertificateResource_CreateKeepsActivatedProjectsOnFailure(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockCertificateServer{active: map[string]bool{}, failIn: "proj_b"}
+	server := httptest.NewServer(mock.handler(t))
+	defer server.Close()
+
+	r := &CertificateResource{client: client.NewClient("test-admin-key", "", server.URL+"/v1")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	empty := tftypes.NewValue(sch.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: sch, Raw: empty}
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: empty}}
+	createResp.Diagnostics.Append(plan.Set(ctx, certificateModel("gateway", false, "proj_a", "proj_b"))...)
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if !createResp.Diagnostics.HasError() {
+		t.Fatal("create: expected an error when activating in proj_b fails")
+	}
+	if got, want := mock.takeCalls(), []string{
+		"POST organization/certificates",
+		"POST organization/projects/proj_a/certificates/activate",
+		"POST organization/projects/proj_b/certificates/activate",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("create calls = %v, want %v", got, want)
+	}
+
+	// proj_a stays in state, so the next apply or a destroy deactivates it.
+	var got CertificateResourceModel
+	if diags := createResp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	var projects []string
+	if diags := got.ProjectIDs.ElementsAs(ctx, &projects, false); diags.HasError() {
+		t.Fatalf("reading project_ids: %v", diags)
+	}
+	if got.ID.ValueString() != "cert_abc" || !reflect.DeepEqual(projects, []string{"proj_a"}) {
+		t.Errorf("state = %+v, project_ids = %v, want cert_abc active in proj_a only", got, projects)
+	}
+}
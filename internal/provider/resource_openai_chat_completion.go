@@ -4,13 +4,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ resource.Resource = &ChatCompletionResource{}
@@ -29,34 +44,47 @@ func (r *ChatCompletionResource) Metadata(ctx context.Context, req resource.Meta
 }
 
 type ChatCompletionResourceModel struct {
-	ID               types.String    `tfsdk:"id"`
-	Model            types.String    `tfsdk:"model"`
-	Messages         []MessageModel  `tfsdk:"messages"`
-	Functions        []FunctionModel `tfsdk:"functions"`     // Deprecated
-	FunctionCall     types.String    `tfsdk:"function_call"` // Deprecated
-	Tools            []ToolModel     `tfsdk:"tools"`
-	ToolChoice       types.String    `tfsdk:"tool_choice"`
-	Temperature      types.Float64   `tfsdk:"temperature"`
-	TopP             types.Float64   `tfsdk:"top_p"`
-	N                types.Int64     `tfsdk:"n"`
-	Stream           types.Bool      `tfsdk:"stream"`
-	Stop             []types.String  `tfsdk:"stop"`
-	MaxTokens        types.Int64     `tfsdk:"max_tokens"`
-	PresencePenalty  types.Float64   `tfsdk:"presence_penalty"`
-	FrequencyPenalty types.Float64   `tfsdk:"frequency_penalty"`
-	LogitBias        types.Map       `tfsdk:"logit_bias"`
-	User             types.String    `tfsdk:"user"`
-	ProjectID        types.String    `tfsdk:"project_id"`
-	Store            types.Bool      `tfsdk:"store"`
-	Metadata         types.Map       `tfsdk:"metadata"`
-	Imported         types.Bool      `tfsdk:"imported"`
-	ImportedResource types.String    `tfsdk:"_imported_resource"`
-	ChatCompletionID types.String    `tfsdk:"chat_completion_id"`
-	Created          types.Int64     `tfsdk:"created"`
-	Object           types.String    `tfsdk:"object"`
-	ModelUsed        types.String    `tfsdk:"model_used"`
-	Choices          []ChoiceModel   `tfsdk:"choices"`
-	Usage            types.Map       `tfsdk:"usage"`
+	ID                types.String         `tfsdk:"id"`
+	Model             types.String         `tfsdk:"model"`
+	Messages          []MessageModel       `tfsdk:"messages"`
+	Functions         []FunctionModel      `tfsdk:"functions"`     // Deprecated
+	FunctionCall      types.String         `tfsdk:"function_call"` // Deprecated
+	Tools             []ToolModel          `tfsdk:"tools"`
+	ToolChoice        types.String         `tfsdk:"tool_choice"`
+	Temperature       types.Float64        `tfsdk:"temperature"`
+	TopP              types.Float64        `tfsdk:"top_p"`
+	N                 types.Int64          `tfsdk:"n"`
+	Stream            types.Bool           `tfsdk:"stream"`
+	StreamOptions     *StreamOptionsModel  `tfsdk:"stream_options"`
+	Seed              types.Int64          `tfsdk:"seed"`
+	Stop              []types.String       `tfsdk:"stop"`
+	MaxTokens         types.Int64          `tfsdk:"max_tokens"`
+	PresencePenalty   types.Float64        `tfsdk:"presence_penalty"`
+	FrequencyPenalty  types.Float64        `tfsdk:"frequency_penalty"`
+	LogitBias         types.Map            `tfsdk:"logit_bias"`
+	User              types.String         `tfsdk:"user"`
+	SafetyIdentifier  types.String         `tfsdk:"safety_identifier"`
+	PromptCacheKey    types.String         `tfsdk:"prompt_cache_key"`
+	ProjectID         types.String         `tfsdk:"project_id"`
+	Store             types.Bool           `tfsdk:"store"`
+	Metadata          types.Map            `tfsdk:"metadata"`
+	ResponseFormat    *ResponseFormatModel `tfsdk:"response_format"`
+	TruncateToContext types.Bool           `tfsdk:"truncate_to_context"`
+	Logprobs          types.Bool           `tfsdk:"logprobs"`
+	BestChoiceIndex   types.Int64          `tfsdk:"best_choice_index"`
+	Imported          types.Bool           `tfsdk:"imported"`
+	ChatCompletionID  types.String         `tfsdk:"chat_completion_id"`
+	Created           types.Int64          `tfsdk:"created"`
+	Object            types.String         `tfsdk:"object"`
+	ModelUsed         types.String         `tfsdk:"model_used"`
+	Choices           types.List           `tfsdk:"choices"`
+	Usage             types.Map            `tfsdk:"usage"`
+	EstimatedCost     types.Float64        `tfsdk:"estimated_cost"`
+}
+
+// StreamOptionsModel configures a streamed chat completion.
+type StreamOptionsModel struct {
+	IncludeUsage types.Bool `tfsdk:"include_usage"`
 }
 
 type MessageModel struct {
@@ -65,6 +93,15 @@ type MessageModel struct {
 	Name         types.String        `tfsdk:"name"`
 	FunctionCall []FunctionCallModel `tfsdk:"function_call"` // Deprecated
 	ToolCalls    []ToolCallModel     `tfsdk:"tool_calls"`
+	ToolCallID   types.String        `tfsdk:"tool_call_id"`
+}
+
+// ChoiceMessageModel is the message the model generated for a choice.
+type ChoiceMessageModel struct {
+	Role         types.String        `tfsdk:"role"`
+	Content      types.String        `tfsdk:"content"`
+	FunctionCall []FunctionCallModel `tfsdk:"function_call"` // Deprecated
+	ToolCalls    []ToolCallModel     `tfsdk:"tool_calls"`
 }
 
 type ToolCallModel struct {
@@ -89,10 +126,34 @@ type FunctionModel struct {
 	Parameters  types.String `tfsdk:"parameters"`
 }
 
+// chatFunctionCallType, chatToolCallType and chatChoiceType describe the
+// computed choices attribute.
+var (
+	chatFunctionCallType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":      types.StringType,
+		"arguments": types.StringType,
+	}}
+	chatToolCallType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":       types.StringType,
+		"type":     types.StringType,
+		"function": types.ListType{ElemType: chatFunctionCallType},
+	}}
+	chatChoiceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"index":         types.Int64Type,
+		"finish_reason": types.StringType,
+		"message": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"role":          types.StringType,
+			"content":       types.StringType,
+			"function_call": types.ListType{ElemType: chatFunctionCallType},
+			"tool_calls":    types.ListType{ElemType: chatToolCallType},
+		}}},
+	}}
+)
+
 type ChoiceModel struct {
-	Index        types.Int64    `tfsdk:"index"`
-	FinishReason types.String   `tfsdk:"finish_reason"`
-	Message      []MessageModel `tfsdk:"message"`
+	Index        types.Int64          `tfsdk:"index"`
+	FinishReason types.String         `tfsdk:"finish_reason"`
+	Message      []ChoiceMessageModel `tfsdk:"message"`
 }
 
 func (r *ChatCompletionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -121,11 +182,18 @@ func (r *ChatCompletionResource) Schema(ctx context.Context, req resource.Schema
 					Attributes: map[string]schema.Attribute{
 						"role": schema.StringAttribute{
 							Required:            true,
-							MarkdownDescription: "The role of the message author. One of 'system', 'user', 'assistant', or 'function'.",
+							MarkdownDescription: "The role of the message author. One of 'system', 'developer', 'user', 'assistant', 'tool', or 'function'.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(chatMessageRoles...),
+							},
 						},
 						"content": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "The content of the message.",
+							Optional:            true,
+							MarkdownDescription: "The content of the message. May be omitted for an assistant message that only carries `tool_calls`.",
+						},
+						"tool_call_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "For a `tool` message, the ID of the tool call this message answers.",
 						},
 						"name": schema.StringAttribute{
 							Optional:            true,
@@ -150,28 +218,28 @@ func (r *ChatCompletionResource) Schema(ctx context.Context, req resource.Schema
 						},
 						"tool_calls": schema.ListNestedAttribute{
 							Optional:            true,
-							MarkdownDescription: "The tool calls generated by the model, such as function calls.",
+							MarkdownDescription: "The tool calls an assistant message made earlier in the conversation. Answer each with a `tool` message whose `tool_call_id` matches.",
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"id": schema.StringAttribute{
-										Computed:            true,
+										Required:            true,
 										MarkdownDescription: "The ID of the tool call.",
 									},
 									"type": schema.StringAttribute{
-										Computed:            true,
+										Required:            true,
 										MarkdownDescription: "The type of the tool. Currently, only 'function' is supported.",
 									},
 									"function": schema.ListNestedAttribute{
-										Computed:            true,
+										Required:            true,
 										MarkdownDescription: "The function that the model called.",
 										NestedObject: schema.NestedAttributeObject{
 											Attributes: map[string]schema.Attribute{
 												"name": schema.StringAttribute{
-													Computed:            true,
+													Required:            true,
 													MarkdownDescription: "The name of the function to call.",
 												},
 												"arguments": schema.StringAttribute{
-													Computed:            true,
+													Required:            true,
 													MarkdownDescription: "The arguments to call the function with, as a JSON string.",
 												},
 											},
@@ -182,140 +250,128 @@ func (r *ChatCompletionResource) Schema(ctx context.Context, req resource.Schema
 						},
 					},
 				},
-				PlanModifiers: []planmodifier.List{
-					// ListRequiresReplace? Check if that exists or custom needed.
-					// Lists don't have built-in RequiresReplace modifier in standard usually,
-					// but standard practice for immutable resources is everything requires replace.
-				},
-			},
-			"functions": schema.ListNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "Deprecated. A list of functions the model may generate JSON inputs for.",
-				DeprecationMessage:  "Use tools instead.",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "The name of the function.",
-						},
-						"description": schema.StringAttribute{
-							Optional:            true,
-							MarkdownDescription: "A description of what the function does.",
-						},
-						"parameters": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "The parameters the function accepts, described as a JSON Schema object.",
-						},
-					},
-				},
-			},
-			"function_call": schema.StringAttribute{
-				Optional:            true,
-				MarkdownDescription: "Deprecated. Controls how the model responds to function calls.",
-				DeprecationMessage:  "Use tool_choice instead.",
-			},
-			"tools": schema.ListNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "A list of tools the model may call. Currently, only functions are supported as a tool.",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "The type of the tool. Currently, only 'function' is supported.",
-						},
-						"function": schema.ListNestedAttribute{
-							Required:            true,
-							MarkdownDescription: "Function definition for the tool.",
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										Required:            true,
-										MarkdownDescription: "The name of the function.",
-									},
-									"description": schema.StringAttribute{
-										Optional:            true,
-										MarkdownDescription: "A description of what the function does.",
-									},
-									"parameters": schema.StringAttribute{
-										Required:            true,
-										MarkdownDescription: "The parameters the function accepts, described as a JSON Schema object.",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"tool_choice": schema.StringAttribute{
-				Optional:            true,
-				MarkdownDescription: "Controls which (if any) tool is called by the model.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
 			},
+			"functions":     chatFunctionsAttribute(),
+			"function_call": chatFunctionCallAttribute(),
+			"tools":         chatToolsAttribute(),
+			"tool_choice":   chatToolChoiceAttribute(),
 			"temperature": schema.Float64Attribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Float64{float64planmodifier.RequiresReplace()},
 				MarkdownDescription: "What sampling temperature to use, between 0 and 2.",
 			},
 			"top_p": schema.Float64Attribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Float64{float64planmodifier.RequiresReplace()},
 				MarkdownDescription: "Nucleus sampling parameter.",
 			},
 			"n": schema.Int64Attribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
 				MarkdownDescription: "How many chat completion choices to generate for each input message.",
 			},
 			"stream": schema.BoolAttribute{
 				Optional:            true,
-				MarkdownDescription: "Whether to stream back partial progress.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				MarkdownDescription: "Whether to stream back partial progress. The streamed chunks are assembled into the same `choices` as a non-streamed completion.",
+			},
+			"stream_options": schema.SingleNestedAttribute{
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Validators:          []validator.Object{objectvalidator.AlsoRequires(path.MatchRoot("stream"))},
+				MarkdownDescription: "Options for a streamed completion. Only used when `stream` is true.",
+				Attributes: map[string]schema.Attribute{
+					"include_usage": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether the stream ends with a chunk carrying token usage, which fills in `usage` and `estimated_cost`. Defaults to `true`.",
+					},
+				},
+			},
+			"seed": schema.Int64Attribute{
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				MarkdownDescription: "If set, the model makes a best effort to sample deterministically, so repeated requests with the same seed and parameters return the same result.",
 			},
 			"stop": schema.ListAttribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.RequiresReplace()},
 				ElementType:         types.StringType,
 				MarkdownDescription: "Up to 4 sequences where the API will stop generating further tokens.",
 			},
 			"max_tokens": schema.Int64Attribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
 				MarkdownDescription: "The maximum number of tokens to generate in the chat completion.",
 				DeprecationMessage:  "This field is deprecated. Use max_completion_tokens instead.",
 			},
 			"presence_penalty": schema.Float64Attribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Float64{float64planmodifier.RequiresReplace()},
 				MarkdownDescription: "Presence penalty parameter.",
 			},
 			"frequency_penalty": schema.Float64Attribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Float64{float64planmodifier.RequiresReplace()},
 				MarkdownDescription: "Frequency penalty parameter.",
 			},
 			"logit_bias": schema.MapAttribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
 				ElementType:         types.Float64Type,
 				MarkdownDescription: "Modify the likelihood of specified tokens appearing in the completion.",
 			},
 			"user": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "A unique identifier representing your end-user.",
-				DeprecationMessage:  "This field is deprecated. Use safety_identifier and prompt_cache_key instead.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "A unique identifier representing your end-user. Deprecated: use `safety_identifier` to identify end-users and `prompt_cache_key` to group requests for caching.",
+				DeprecationMessage:  "Use safety_identifier instead, and prompt_cache_key for prompt caching. user is still sent for now.",
+			},
+			"safety_identifier": schema.StringAttribute{
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "A stable identifier for the end-user, used by OpenAI to detect abuse. Hash usernames or emails rather than sending them in the clear. Replaces `user`.",
+			},
+			"prompt_cache_key": schema.StringAttribute{
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "A key that groups requests sharing a long common prefix so they are routed to the same prompt cache, improving cache hit rates.",
 			},
 			"project_id": schema.StringAttribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				MarkdownDescription: "The project to use for this request.",
 			},
 			"store": schema.BoolAttribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 				MarkdownDescription: "Whether to store the chat completion for later retrieval via API.",
 			},
 			"metadata": schema.MapAttribute{
 				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
 				ElementType:         types.StringType,
 				MarkdownDescription: "A map of key-value pairs that can be used to filter chat completions.",
 			},
-			"imported": schema.BoolAttribute{
+			"response_format": responseFormatAttribute(),
+			"truncate_to_context": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Drop the oldest non-system messages until the request fits the model's context window. Token counts are estimated (about four characters per token), and `max_tokens` (or 1024 if unset) is reserved for the completion.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"logprobs": schema.BoolAttribute{
 				Optional:            true,
+				MarkdownDescription: "Whether to return log probabilities of the output tokens. Required for `best_choice_index`.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"best_choice_index": schema.Int64Attribute{
 				Computed:            true,
-				MarkdownDescription: "Whether this resource was imported from an existing chat completion.",
+				MarkdownDescription: "The index of the choice with the highest mean token log probability. Only set when `logprobs` is enabled.",
 			},
-			"_imported_resource": schema.StringAttribute{
+			"imported": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Internal field to prevent recreation of imported resources.",
+				MarkdownDescription: "Whether this resource was imported from an existing chat completion.",
 			},
 			"created": schema.Int64Attribute{
 				Computed:            true,
@@ -355,6 +411,25 @@ func (r *ChatCompletionResource) Schema(ctx context.Context, req resource.Schema
 											},
 										},
 									},
+									"tool_calls": schema.ListNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "The tools the model called. `finish_reason` is `tool_calls` when the model stopped to wait for their results.",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id":   schema.StringAttribute{Computed: true},
+												"type": schema.StringAttribute{Computed: true},
+												"function": schema.ListNestedAttribute{
+													Computed: true,
+													NestedObject: schema.NestedAttributeObject{
+														Attributes: map[string]schema.Attribute{
+															"name":      schema.StringAttribute{Computed: true},
+															"arguments": schema.StringAttribute{Computed: true},
+														},
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -366,6 +441,10 @@ func (r *ChatCompletionResource) Schema(ctx context.Context, req resource.Schema
 				ElementType:         types.Int64Type,
 				MarkdownDescription: "Usage statistics for the chat completion request.",
 			},
+			"estimated_cost": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Estimated cost in USD of the request from its token usage and the model's list price. Audio input and output tokens are charged at the model's audio rates. Null when the model has no known price.",
+			},
 		},
 	}
 }
@@ -410,78 +489,39 @@ func (r *ChatCompletionResource) Create(ctx context.Context, req resource.Create
 					Arguments: msgModel.FunctionCall[0].Arguments.ValueString(),
 				}
 			}
+			for _, tc := range msgModel.ToolCalls {
+				call := ChatToolCall{ID: tc.ID.ValueString(), Type: tc.Type.ValueString()}
+				if len(tc.Function) > 0 {
+					call.Function = ChatFunctionCall{
+						Name:      tc.Function[0].Name.ValueString(),
+						Arguments: tc.Function[0].Arguments.ValueString(),
+					}
+				}
+				msg.ToolCalls = append(msg.ToolCalls, call)
+			}
+			if !msgModel.ToolCallID.IsNull() {
+				msg.ToolCallID = msgModel.ToolCallID.ValueString()
+			}
 			messages = append(messages, msg)
 		}
 		request.Messages = messages
 	}
 
-	if data.Functions != nil {
-		functions := make([]ChatFunction, 0, len(data.Functions))
-		for _, funcModel := range data.Functions {
-			description := ""
-			if !funcModel.Description.IsNull() {
-				description = funcModel.Description.ValueString()
-			}
-			functions = append(functions, ChatFunction{
-				Name:        funcModel.Name.ValueString(),
-				Description: description,
-				Parameters:  json.RawMessage(funcModel.Parameters.ValueString()),
-			})
-		}
-		request.Functions = functions
+	if data.TruncateToContext.ValueBool() {
+		request.Messages = truncateChatHistory(ctx, request.Messages, request.Model, int(data.MaxTokens.ValueInt64()), &resp.Diagnostics)
 	}
 
-	if data.Tools != nil {
-		tools := make([]ChatTool, 0, len(data.Tools))
-		for _, toolModel := range data.Tools {
-			tool := ChatTool{
-				Type: toolModel.Type.ValueString(),
-			}
-
-			if len(toolModel.Function) > 0 {
-				funcModel := toolModel.Function[0]
-				description := ""
-				if !funcModel.Description.IsNull() {
-					description = funcModel.Description.ValueString()
-				}
-				tool.Function = ChatFunction{
-					Name:        funcModel.Name.ValueString(),
-					Description: description,
-					Parameters:  json.RawMessage(funcModel.Parameters.ValueString()),
-				}
-			}
-			tools = append(tools, tool)
-		}
-		request.Tools = tools
-	}
-
-	// FunctionCall string handling logic from SDKv2
-	if !data.FunctionCall.IsNull() {
-		fcValue := data.FunctionCall.ValueString()
-		if fcValue == "none" || fcValue == "auto" {
-			request.FunctionCall = fcValue
-		} else {
-			request.FunctionCall = map[string]string{"name": fcValue}
-		}
-	}
+	request.Functions = chatFunctions(data.Functions)
+	request.Tools = chatTools(data.Tools)
+	request.FunctionCall = chatFunctionCall(data.FunctionCall)
 
 	if !data.ToolChoice.IsNull() {
-		tcValue := data.ToolChoice.ValueString()
-		if tcValue == "none" || tcValue == "auto" || tcValue == "required" {
-			request.ToolChoice = tcValue
-		} else {
-			// Assume it's a specific tool call object construction if complexity needed,
-			// but for simple string parity with function_call, let's treat it as struct
-			// or minimal naming.
-			// The API for `tool_choice` object is: {"type": "function", "function": {"name": "my_function"}}
-			// For simplicity we might just support simple strings or need more logic for named tools.
-			// Let's assume user passes "auto" or "none" mostly.
-			// If they pass a specific function name, we might need helper logic to wrap it.
-			// For now, let's pass as string if it's simple, or try to respect `tools` schema.
-			// Since TF String, we assume it's one of the enums or a raw JSON string if complex?
-			// Let's stick to simple string for now.
-			request.ToolChoice = tcValue
+		toolChoice, err := buildToolChoice(data.ToolChoice.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("tool_choice"), "Invalid tool_choice", err.Error())
+			return
 		}
+		request.ToolChoice = toolChoice
 	}
 
 	if !data.Temperature.IsNull() {
@@ -496,6 +536,15 @@ func (r *ChatCompletionResource) Create(ctx context.Context, req resource.Create
 	if !data.Stream.IsNull() {
 		request.Stream = data.Stream.ValueBool()
 	}
+	if request.Stream {
+		// Without include_usage a stream carries no usage at all, leaving
+		// usage and estimated_cost zero, so it is requested by default.
+		includeUsage := data.StreamOptions == nil || data.StreamOptions.IncludeUsage.IsNull() || data.StreamOptions.IncludeUsage.ValueBool()
+		request.StreamOptions = &ChatStreamOptions{IncludeUsage: includeUsage}
+	}
+	if !data.Seed.IsNull() {
+		request.Seed = data.Seed.ValueInt64Pointer()
+	}
 	if data.Stop != nil {
 		stop := make([]string, 0, len(data.Stop))
 		for _, s := range data.Stop {
@@ -520,14 +569,24 @@ func (r *ChatCompletionResource) Create(ctx context.Context, req resource.Create
 	if !data.User.IsNull() {
 		request.User = data.User.ValueString()
 	}
+	if !data.SafetyIdentifier.IsNull() {
+		request.SafetyIdentifier = data.SafetyIdentifier.ValueString()
+	}
+	if !data.PromptCacheKey.IsNull() {
+		request.PromptCacheKey = data.PromptCacheKey.ValueString()
+	}
 	if !data.Store.IsNull() {
 		request.Store = data.Store.ValueBool()
 	}
+	request.Logprobs = data.Logprobs.ValueBool()
 	if !data.Metadata.IsNull() {
 		metadata := make(map[string]string)
 		data.Metadata.ElementsAs(ctx, &metadata, false)
 		request.Metadata = metadata
 	}
+	if data.ResponseFormat != nil {
+		request.ResponseFormat = data.ResponseFormat.chatResponseFormat()
+	}
 
 	// Use project key if needed (simplified logic here assuming configured client is sufficient)
 	client := r.client.OpenAIClient
@@ -542,59 +601,96 @@ func (r *ChatCompletionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	url := "/v1/chat/completions"
-	respBody, err := client.DoRequest("POST", url, json.RawMessage(reqJson))
-	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
-	}
-
 	var completionResponse ChatCompletionResponse
-	if err := json.Unmarshal(respBody, &completionResponse); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
+	if request.Stream {
+		streamed, err := streamChatCompletion(ctx, client, request)
+		if err != nil {
+			resp.Diagnostics.AddError("Error making request", err.Error())
+			return
+		}
+		completionResponse = *streamed
+	} else {
+		url := "chat/completions"
+		respBody, err := client.DoRequest("POST", url, json.RawMessage(reqJson))
+		if err != nil {
+			resp.Diagnostics.AddError("Error making request", err.Error())
+			return
+		}
+		if err := json.Unmarshal(respBody, &completionResponse); err != nil {
+			resp.Diagnostics.AddError("Error parsing response", err.Error())
+			return
+		}
 	}
 
-	// Populate state
 	data.ID = types.StringValue(completionResponse.ID)
-	data.ChatCompletionID = types.StringValue(completionResponse.ID)
-	data.Created = types.Int64Value(int64(completionResponse.Created))
-	data.Object = types.StringValue(completionResponse.Object)
-	data.ModelUsed = types.StringValue(completionResponse.Model)
-
-	// Map Choices
-	choices := make([]ChoiceModel, 0, len(completionResponse.Choices))
-	for _, c := range completionResponse.Choices {
-		msgModel := MessageModel{
+	resp.Diagnostics.Append(setChatCompletionComputed(ctx, client, &data, &completionResponse)...)
+
+	// Update Imported flag
+	data.Imported = types.BoolValue(false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setChatCompletionComputed copies the API's computed fields into data,
+// truncating generated text to cl's max_response_bytes.
+func setChatCompletionComputed(ctx context.Context, cl *client.OpenAIClient, data *ChatCompletionResourceModel, completion *ChatCompletionResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.ChatCompletionID = types.StringValue(completion.ID)
+	data.Created = types.Int64Value(int64(completion.Created))
+	data.Object = types.StringValue(completion.Object)
+	data.ModelUsed = types.StringValue(completion.Model)
+
+	choices := make([]ChoiceModel, 0, len(completion.Choices))
+	for i, c := range completion.Choices {
+		msgPath := path.Root("choices").AtListIndex(i).AtName("message").AtListIndex(0)
+		msgModel := ChoiceMessageModel{
 			Role:    types.StringValue(c.Message.Role),
-			Content: types.StringValue(c.Message.Content),
+			Content: stateString(cl, msgPath.AtName("content"), c.Message.Content, &diags),
 		}
 		if c.Message.FunctionCall != nil {
 			msgModel.FunctionCall = []FunctionCallModel{{
 				Name:      types.StringValue(c.Message.FunctionCall.Name),
-				Arguments: types.StringValue(c.Message.FunctionCall.Arguments),
+				Arguments: stateString(cl, msgPath.AtName("function_call").AtListIndex(0).AtName("arguments"), c.Message.FunctionCall.Arguments, &diags),
 			}}
 		}
+		for j, tc := range c.Message.ToolCalls {
+			msgModel.ToolCalls = append(msgModel.ToolCalls, ToolCallModel{
+				ID:   types.StringValue(tc.ID),
+				Type: types.StringValue(tc.Type),
+				Function: []FunctionCallModel{{
+					Name:      types.StringValue(tc.Function.Name),
+					Arguments: stateString(cl, msgPath.AtName("tool_calls").AtListIndex(j).AtName("function").AtListIndex(0).AtName("arguments"), tc.Function.Arguments, &diags),
+				}},
+			})
+		}
 		choices = append(choices, ChoiceModel{
 			Index:        types.Int64Value(int64(c.Index)),
 			FinishReason: types.StringValue(c.FinishReason),
-			Message:      []MessageModel{msgModel},
+			Message:      []ChoiceMessageModel{msgModel},
 		})
 	}
-	data.Choices = choices
+	choiceList, choiceDiags := types.ListValueFrom(ctx, chatChoiceType, choices)
+	diags.Append(choiceDiags...)
+	data.Choices = choiceList
 
-	// Map Usage
-	usage := map[string]int64{
-		"prompt_tokens":     int64(completionResponse.Usage.PromptTokens),
-		"completion_tokens": int64(completionResponse.Usage.CompletionTokens),
-		"total_tokens":      int64(completionResponse.Usage.TotalTokens),
+	data.BestChoiceIndex = types.Int64Null()
+	if best, ok := bestChoiceIndex(completion.Choices); ok {
+		data.BestChoiceIndex = types.Int64Value(int64(best))
 	}
-	data.Usage, _ = types.MapValueFrom(ctx, types.Int64Type, usage)
-
-	// Update Imported flag
-	data.Imported = types.BoolValue(false)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	usage, usageDiags := types.MapValueFrom(ctx, types.Int64Type, map[string]int64{
+		"prompt_tokens":     int64(completion.Usage.PromptTokens),
+		"completion_tokens": int64(completion.Usage.CompletionTokens),
+		"total_tokens":      int64(completion.Usage.TotalTokens),
+	})
+	diags.Append(usageDiags...)
+	data.Usage = usage
+
+	data.EstimatedCost = types.Float64Null()
+	if cost, ok := estimateChatCompletionCost(completion.Model, completion.Usage); ok {
+		data.EstimatedCost = types.Float64Value(cost)
+	}
+	return diags
 }
 
 func (r *ChatCompletionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -607,46 +703,248 @@ func (r *ChatCompletionResource) Read(ctx context.Context, req resource.ReadRequ
 	if data.ID.ValueString() == "" {
 		return
 	}
-	// Chat completions are immutable.
-	// We might only need minimal check.
-	// But let's check existence if we have ID.
-	// API doesn't support generic GET /v1/chat/completions/{id} unless Store is enabled.
-	// If store=true was used, we can fetch.
-	// Replicate SDKv2 logic: try to fetch, if fail (404/error) and store wasn't expected, assume it's fine.
-	// But SDKv2 returned ID if error occurred, meaning it assumes existence unless strictly 404 AND we expected to find it?
-	// Actually SDKv2: "If there's an error ... just keep the ID and return"
-	// So effectively it blindly trusts the ID unless it can prove it doesn't exist.
-
-	client := r.client.OpenAIClient
-	url := fmt.Sprintf("/v1/chat/completions/%s", data.ID.ValueString())
 
-	respBody, err := client.DoRequest("GET", url, nil)
+	// Only completions created with store = true can be retrieved. Anything
+	// else is trusted to exist, since a completion never changes once made.
+	respBody, err := r.client.OpenAIClient.DoRequestContext(ctx, "GET", fmt.Sprintf("chat/completions/%s", data.ID.ValueString()), nil)
 	if err != nil {
-		// If error, just return current state as per SDKv2 behavior
+		tflog.Debug(ctx, "Chat completion not retrievable, keeping state", map[string]interface{}{
+			"id":    data.ID.ValueString(),
+			"error": err.Error(),
+		})
 		return
 	}
 
 	var completion ChatCompletionResponse
-	if err := json.Unmarshal(respBody, &completion); err == nil {
-		// Update state if we got data
-		data.ChatCompletionID = types.StringValue(completion.ID)
-		data.Created = types.Int64Value(int64(completion.Created))
-		data.Object = types.StringValue(completion.Object)
-		data.ModelUsed = types.StringValue(completion.Model)
-		// ... update choices ... (omitted for brevity, assume similar to create)
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if err := json.Unmarshal(respBody, &completion); err != nil {
+		resp.Diagnostics.AddError("Error parsing chat completion", err.Error())
+		return
 	}
+
+	resp.Diagnostics.Append(setChatCompletionComputed(ctx, r.client.OpenAIClient, &data, &completion)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Update only runs for changes to imported, since every input forces
+// replacement; the completion itself is left as it is in state.
 func (r *ChatCompletionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Immutable
+	var plan, state ChatCompletionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Imported = plan.Imported
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ChatCompletionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Nothing to do
+	// Completions cannot be deleted through the API; removing the resource
+	// from state is sufficient.
 }
 
+// ImportState imports a chat completion by ID. The completion's computed
+// fields are only filled in when it was created with store = true.
 func (r *ChatCompletionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-	// We might want to set "imported" = true here
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("imported"), true)...)
+}
+
+// chatFunctionsAttribute returns the schema of the deprecated functions
+// attribute, shared with the chat completion data source.
+func chatFunctionsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:            true,
+		PlanModifiers:       []planmodifier.List{listplanmodifier.RequiresReplace()},
+		MarkdownDescription: "Deprecated. A list of functions the model may generate JSON inputs for.",
+		DeprecationMessage:  "Use tools instead.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The name of the function.",
+				},
+				"description": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "A description of what the function does.",
+				},
+				"parameters": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The parameters the function accepts, described as a JSON Schema object.",
+				},
+			},
+		},
+	}
+}
+
+// chatFunctionCallAttribute returns the schema of the deprecated
+// function_call attribute, shared with the chat completion data source.
+func chatFunctionCallAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		MarkdownDescription: "Deprecated. Controls how the model responds to function calls.",
+		DeprecationMessage:  "Use tool_choice instead.",
+	}
+}
+
+// chatToolsAttribute returns the schema of the tools attribute, shared with
+// the chat completion data source.
+func chatToolsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:            true,
+		PlanModifiers:       []planmodifier.List{listplanmodifier.RequiresReplace()},
+		MarkdownDescription: "A list of tools the model may call. Currently, only functions are supported as a tool.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The type of the tool. Currently, only 'function' is supported.",
+				},
+				"function": schema.ListNestedAttribute{
+					Required:            true,
+					MarkdownDescription: "Function definition for the tool.",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "The name of the function.",
+							},
+							"description": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "A description of what the function does.",
+							},
+							"parameters": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "The parameters the function accepts, described as a JSON Schema object.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// chatToolChoiceAttribute returns the schema of the tool_choice attribute,
+// shared with the chat completion data source.
+func chatToolChoiceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		MarkdownDescription: "Controls which (if any) tool is called by the model. One of `none`, `auto`, `required`, the name of a function declared in `tools` to force that function, or a JSON object of the form `{\"type\": \"function\", \"function\": {\"name\": \"my_function\"}}`.",
+		Validators:          []validator.String{toolChoiceValidator{}},
+	}
+}
+
+// chatFunctions converts the deprecated functions attribute into its wire
+// form.
+func chatFunctions(functions []FunctionModel) []ChatFunction {
+	if functions == nil {
+		return nil
+	}
+	out := make([]ChatFunction, 0, len(functions))
+	for _, fn := range functions {
+		out = append(out, chatFunction(fn))
+	}
+	return out
+}
+
+// chatTools converts the tools attribute into its wire form.
+func chatTools(tools []ToolModel) []ChatTool {
+	if tools == nil {
+		return nil
+	}
+	out := make([]ChatTool, 0, len(tools))
+	for _, toolModel := range tools {
+		tool := ChatTool{Type: toolModel.Type.ValueString()}
+		if len(toolModel.Function) > 0 {
+			tool.Function = chatFunction(toolModel.Function[0])
+		}
+		out = append(out, tool)
+	}
+	return out
+}
+
+func chatFunction(fn FunctionModel) ChatFunction {
+	return ChatFunction{
+		Name:        fn.Name.ValueString(),
+		Description: fn.Description.ValueString(),
+		Parameters:  json.RawMessage(fn.Parameters.ValueString()),
+	}
+}
+
+// chatFunctionCall converts the deprecated function_call attribute into its
+// wire form: "none" and "auto" are sent as is, anything else names the
+// function to call. Returns nil when unset.
+func chatFunctionCall(v types.String) interface{} {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	if fc := v.ValueString(); fc == "none" || fc == "auto" {
+		return fc
+	}
+	return map[string]string{"name": v.ValueString()}
+}
+
+// toolChoiceModes are the string forms of tool_choice accepted by the API.
+var toolChoiceModes = map[string]bool{"none": true, "auto": true, "required": true}
+
+// functionNameRegex matches the function names accepted by the API.
+var functionNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// chatToolChoice is the object form of tool_choice that forces a specific function.
+type chatToolChoice struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name string `json:"name"`
+	} `json:"function"`
+}
+
+// buildToolChoice converts the tool_choice attribute into its wire form: the
+// plain modes are sent as strings, while a function name or a JSON object is
+// sent as {"type": "function", "function": {"name": ...}}.
+func buildToolChoice(value string) (interface{}, error) {
+	if toolChoiceModes[value] {
+		return value, nil
+	}
+
+	var choice chatToolChoice
+	if strings.HasPrefix(strings.TrimSpace(value), "{") {
+		if err := json.Unmarshal([]byte(value), &choice); err != nil {
+			return nil, fmt.Errorf("tool_choice is not a valid JSON object: %v", err)
+		}
+		if choice.Type != "function" {
+			return nil, fmt.Errorf("tool_choice object must have type \"function\", got %q", choice.Type)
+		}
+	} else {
+		choice.Type = "function"
+		choice.Function.Name = value
+	}
+
+	if !functionNameRegex.MatchString(choice.Function.Name) {
+		return nil, fmt.Errorf("tool_choice must be one of none, auto, required, or a function name (a-z, A-Z, 0-9, _ and -, max 64 characters), got %q", choice.Function.Name)
+	}
+	return choice, nil
+}
+
+// toolChoiceValidator rejects tool_choice values buildToolChoice cannot serialize.
+type toolChoiceValidator struct{}
+
+func (v toolChoiceValidator) Description(ctx context.Context) string {
+	return "value must be none, auto, required, a function name, or a function tool_choice JSON object"
+}
+
+func (v toolChoiceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v toolChoiceValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := buildToolChoice(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid tool_choice", err.Error())
+	}
 }
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBuildToolChoice(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		want  string // expected JSON encoding
+	}{
+		{"none", "none", `"none"`},
+		{"auto", "auto", `"auto"`},
+		{"required", "required", `"required"`},
+		{"function name", "get_weather", `{"type":"function","function":{"name":"get_weather"}}`},
+		{"function object", `{"type": "function", "function": {"name": "get_weather"}}`, `{"type":"function","function":{"name":"get_weather"}}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := buildToolChoice(tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			encoded, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(encoded) != tc.want {
+				t.Errorf("got %s, want %s", encoded, tc.want)
+			}
+		})
+	}
+}
+
+func TestToolChoiceValidator(t *testing.T) {
+	cases := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"required", types.StringValue("required"), false},
+		{"named function", types.StringValue("lookup-user_2"), false},
+		{"object", types.StringValue(`{"type":"function","function":{"name":"lookup"}}`), false},
+		{"wrong object type", types.StringValue(`{"type":"file_search"}`), true},
+		{"object without name", types.StringValue(`{"type":"function","function":{}}`), true},
+		{"malformed JSON", types.StringValue(`{"type":`), true},
+		{"invalid name", types.StringValue("not a function"), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("tool_choice"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+			toolChoiceValidator{}.ValidateString(context.Background(), req, resp)
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Errorf("HasError() = %v, want %v (diags: %v)", got, tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// createChatCompletion runs the chat completion resource's Create against
+// apiURL with the given messages, each a map of attribute values, and a
+// get_weather function tool.
+func createChatCompletion(t *testing.T, apiURL string, messages []map[string]tftypes.Value) *resource.CreateResponse {
+	t.Helper()
+	return createChatCompletionWith(t, apiURL, messages, func(map[string]tftypes.Value) {})
+}
+
+// createChatCompletionWith is createChatCompletion with further attributes
+// set by configure.
+func createChatCompletionWith(t *testing.T, apiURL string, messages []map[string]tftypes.Value, configure func(vals map[string]tftypes.Value)) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ChatCompletionResource{client: newTestOpenAIClient(apiURL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "chat_completion_id", "created", "object", "model_used", "choices", "usage", "imported", "best_choice_index"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+
+	msgListType := objType.AttributeTypes["messages"].(tftypes.List)
+	msgType := msgListType.ElementType.(tftypes.Object)
+	msgs := []tftypes.Value{}
+	for _, m := range messages {
+		attrs := map[string]tftypes.Value{}
+		for name, typ := range msgType.AttributeTypes {
+			attrs[name] = tftypes.NewValue(typ, nil)
+			if v, ok := m[name]; ok {
+				attrs[name] = v
+			}
+		}
+		msgs = append(msgs, tftypes.NewValue(msgType, attrs))
+	}
+
+	toolListType := objType.AttributeTypes["tools"].(tftypes.List)
+	toolType := toolListType.ElementType.(tftypes.Object)
+	fnListType := toolType.AttributeTypes["function"].(tftypes.List)
+	fnType := fnListType.ElementType.(tftypes.Object)
+	fn := tftypes.NewValue(fnType, map[string]tftypes.Value{
+		"name":        tftypes.NewValue(tftypes.String, "get_weather"),
+		"description": tftypes.NewValue(tftypes.String, nil),
+		"parameters":  tftypes.NewValue(tftypes.String, `{"type":"object","properties":{"city":{"type":"string"}}}`),
+	})
+	tool := tftypes.NewValue(toolType, map[string]tftypes.Value{
+		"type":     tftypes.NewValue(tftypes.String, "function"),
+		"function": tftypes.NewValue(fnListType, []tftypes.Value{fn}),
+	})
+
+	vals["model"] = tftypes.NewValue(tftypes.String, "gpt-4o")
+	vals["messages"] = tftypes.NewValue(msgListType, msgs)
+	vals["tools"] = tftypes.NewValue(toolListType, []tftypes.Value{tool})
+	configure(vals)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// toolCallMessages is a conversation in which the assistant called
+// get_weather and the tool answered.
+func toolCallMessages(t *testing.T) []map[string]tftypes.Value {
+	ctx := context.Background()
+	sch := resource.SchemaResponse{}
+	(&ChatCompletionResource{}).Schema(ctx, resource.SchemaRequest{}, &sch)
+	msgType := sch.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["messages"].(tftypes.List).ElementType.(tftypes.Object)
+	callListType := msgType.AttributeTypes["tool_calls"].(tftypes.List)
+	callType := callListType.ElementType.(tftypes.Object)
+	fnListType := callType.AttributeTypes["function"].(tftypes.List)
+	fnType := fnListType.ElementType.(tftypes.Object)
+
+	call := tftypes.NewValue(callType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "call_1"),
+		"type": tftypes.NewValue(tftypes.String, "function"),
+		"function": tftypes.NewValue(fnListType, []tftypes.Value{tftypes.NewValue(fnType, map[string]tftypes.Value{
+			"name":      tftypes.NewValue(tftypes.String, "get_weather"),
+			"arguments": tftypes.NewValue(tftypes.String, `{"city":"Paris"}`),
+		})}),
+	})
+	return []map[string]tftypes.Value{
+		{"role": tftypes.NewValue(tftypes.String, "user"), "content": tftypes.NewValue(tftypes.String, "Weather in Paris?")},
+		{"role": tftypes.NewValue(tftypes.String, "assistant"), "tool_calls": tftypes.NewValue(callListType, []tftypes.Value{call})},
+		{"role": tftypes.NewValue(tftypes.String, "tool"), "tool_call_id": tftypes.NewValue(tftypes.String, "call_1"), "content": tftypes.NewValue(tftypes.String, `{"temp":18}`)},
+	}
+}
+
+func TestChatCompletionCreate_RecordsToolCalls(t *testing.T) {
+	var sent ChatCompletionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "chatcmpl-1", "object": "chat.completion", "created": 1700000000, "model": "gpt-4o-2024-08-06",
+			"choices": []map[string]interface{}{{
+				"index":         0,
+				"finish_reason": "tool_calls",
+				"message": map[string]interface{}{
+					"role":    "assistant",
+					"content": nil,
+					"tool_calls": []map[string]interface{}{{
+						"id": "call_2", "type": "function",
+						"function": map[string]interface{}{"name": "get_weather", "arguments": `{"city":"Lyon"}`},
+					}},
+				},
+			}},
+			"usage": map[string]interface{}{"prompt_tokens": 40, "completion_tokens": 12, "total_tokens": 52},
+		})
+	}))
+	defer server.Close()
+
+	resp := createChatCompletion(t, server.URL, toolCallMessages(t))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if len(sent.Tools) != 1 || sent.Tools[0].Function.Name != "get_weather" {
+		t.Errorf("tools sent = %+v", sent.Tools)
+	}
+	if len(sent.Messages) != 3 || len(sent.Messages[1].ToolCalls) != 1 || sent.Messages[1].ToolCalls[0].Function.Arguments != `{"city":"Paris"}` {
+		t.Errorf("assistant tool call not sent: %+v", sent.Messages)
+	}
+	if sent.Messages[2].ToolCallID != "call_1" {
+		t.Errorf("tool_call_id = %q, want call_1", sent.Messages[2].ToolCallID)
+	}
+
+	var got ChatCompletionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading state: %v", resp.Diagnostics)
+	}
+	var choices []ChoiceModel
+	resp.Diagnostics.Append(got.Choices.ElementsAs(context.Background(), &choices, false)...)
+	if got.ID.ValueString() != "chatcmpl-1" || len(choices) != 1 {
+		t.Fatalf("state = %+v", got)
+	}
+	choice := choices[0]
+	if choice.FinishReason.ValueString() != "tool_calls" {
+		t.Errorf("finish_reason = %q", choice.FinishReason.ValueString())
+	}
+	calls := choice.Message[0].ToolCalls
+	if len(calls) != 1 || calls[0].ID.ValueString() != "call_2" || calls[0].Function[0].Arguments.ValueString() != `{"city":"Lyon"}` {
+		t.Errorf("tool_calls = %+v", calls)
+	}
+	if total := got.Usage.Elements()["total_tokens"]; total == nil || total.String() != "52" {
+		t.Errorf("usage = %v", got.Usage)
+	}
+}
+
+func TestChatCompletionCreate_SendsResponseFormat(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "chatcmpl-2", "object": "chat.completion", "created": 1700000000, "model": "gpt-4o-2024-08-06",
+			"choices": []map[string]interface{}{{
+				"index": 0, "finish_reason": "stop",
+				"message": map[string]interface{}{"role": "assistant", "content": `{"city":"Paris"}`},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	messages := []map[string]tftypes.Value{{
+		"role":    tftypes.NewValue(tftypes.String, "user"),
+		"content": tftypes.NewValue(tftypes.String, "Where is the Louvre?"),
+	}}
+	resp := createChatCompletionWith(t, server.URL, messages, func(vals map[string]tftypes.Value) {
+		rfType := vals["response_format"].Type().(tftypes.Object)
+		vals["response_format"] = responseFormatValue(rfType, map[string]interface{}{
+			"type":   "json_schema",
+			"name":   "place",
+			"schema": `{"type":"object","properties":{"city":{"type":"string"}}}`,
+			"strict": true,
+		})
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	got, _ := json.Marshal(sent["response_format"])
+	want := `{"json_schema":{"name":"place","schema":{"properties":{"city":{"type":"string"}},"type":"object"},"strict":true},"type":"json_schema"}`
+	if string(got) != want {
+		t.Errorf("response_format sent = %s, want %s", got, want)
+	}
+}
+
+func TestChatCompletionSchema_InputsRequireReplace(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&ChatCompletionResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	for name, a := range schemaResp.Schema.Attributes {
+		if !a.IsRequired() && !a.IsOptional() || name == "imported" {
+			continue
+		}
+		var n int
+		switch a := a.(type) {
+		case interface{ StringPlanModifiers() []planmodifier.String }:
+			n = len(a.StringPlanModifiers())
+		case interface{ ListPlanModifiers() []planmodifier.List }:
+			n = len(a.ListPlanModifiers())
+		case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+			n = len(a.BoolPlanModifiers())
+		case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+			n = len(a.Int64PlanModifiers())
+		case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+			n = len(a.Float64PlanModifiers())
+		case interface{ MapPlanModifiers() []planmodifier.Map }:
+			n = len(a.MapPlanModifiers())
+		case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+			n = len(a.ObjectPlanModifiers())
+		}
+		if n == 0 {
+			t.Errorf("%s does not force replacement; completions cannot be updated", name)
+		}
+	}
+}
+
+func TestChatCompletionCreate_SendsSafetyIdentifierAndPromptCacheKey(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "chatcmpl-3", "object": "chat.completion", "created": 1700000000, "model": "gpt-4o-mini",
+			"choices": []map[string]interface{}{{
+				"index": 0, "finish_reason": "stop",
+				"message": map[string]interface{}{"role": "assistant", "content": "Hi"},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	messages := []map[string]tftypes.Value{{
+		"role":    tftypes.NewValue(tftypes.String, "user"),
+		"content": tftypes.NewValue(tftypes.String, "Hello"),
+	}}
+	resp := createChatCompletionWith(t, server.URL, messages, func(vals map[string]tftypes.Value) {
+		vals["safety_identifier"] = tftypes.NewValue(tftypes.String, "user-7f3a")
+		vals["prompt_cache_key"] = tftypes.NewValue(tftypes.String, "support-bot-v2")
+		vals["user"] = tftypes.NewValue(tftypes.String, "legacy-user")
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if sent["safety_identifier"] != "user-7f3a" || sent["prompt_cache_key"] != "support-bot-v2" {
+		t.Errorf("safety_identifier/prompt_cache_key sent = %v/%v", sent["safety_identifier"], sent["prompt_cache_key"])
+	}
+	// The deprecated user field keeps working until it is removed.
+	if sent["user"] != "legacy-user" {
+		t.Errorf("user sent = %v, want legacy-user", sent["user"])
+	}
+}
+
+func TestChatCompletionSchema_UserDeprecatedForSafetyIdentifier(t *testing.T) {
+	schemaResp := &resource.SchemaResponse{}
+	(&ChatCompletionResource{}).Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+	msg := schemaResp.Schema.Attributes["user"].GetDeprecationMessage()
+	if !strings.Contains(msg, "safety_identifier") {
+		t.Errorf("user deprecation message = %q, want it to point at safety_identifier", msg)
+	}
+	if schemaResp.Schema.Attributes["safety_identifier"].GetDeprecationMessage() != "" {
+		t.Error("safety_identifier should not be deprecated")
+	}
+}
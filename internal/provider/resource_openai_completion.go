@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ resource.Resource = &CompletionResource{}
+
+type CompletionResource struct {
+	client *OpenAIClient
+}
+
+func NewCompletionResource() resource.Resource {
+	return &CompletionResource{}
+}
+
+func (r *CompletionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_completion"
+}
+
+type CompletionResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Model       types.String   `tfsdk:"model"`
+	Prompt      types.String   `tfsdk:"prompt"`
+	Suffix      types.String   `tfsdk:"suffix"`
+	MaxTokens   types.Int64    `tfsdk:"max_tokens"`
+	Temperature types.Float64  `tfsdk:"temperature"`
+	TopP        types.Float64  `tfsdk:"top_p"`
+	N           types.Int64    `tfsdk:"n"`
+	Stop        []types.String `tfsdk:"stop"`
+	Logprobs    types.Int64    `tfsdk:"logprobs"`
+
+	// Computed
+	Created   types.Int64  `tfsdk:"created"`
+	ModelUsed types.String `tfsdk:"model_used"`
+	Choices   types.List   `tfsdk:"choices"`
+	Usage     types.Map    `tfsdk:"usage"`
+}
+
+// completionLogprobsType and completionChoiceType describe the computed
+// choices attribute.
+var (
+	completionLogprobsType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"tokens":         types.ListType{ElemType: types.StringType},
+		"token_logprobs": types.ListType{ElemType: types.Float64Type},
+		"top_logprobs":   types.ListType{ElemType: types.MapType{ElemType: types.Float64Type}},
+		"text_offset":    types.ListType{ElemType: types.Int64Type},
+	}}
+	completionChoiceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"index":         types.Int64Type,
+		"text":          types.StringType,
+		"finish_reason": types.StringType,
+		"logprobs":      completionLogprobsType,
+	}}
+)
+
+func (r *CompletionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates text with the legacy Completions API (`/v1/completions`), for instruct and base models such as `gpt-3.5-turbo-instruct` and `davinci-002`. The completion is made once; changing any argument generates a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the completion.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the model to use, e.g. `gpt-3.5-turbo-instruct`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The prompt to generate a completion for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"suffix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The text that comes after the completion, for inserting text. Only supported by `gpt-3.5-turbo-instruct`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_tokens": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of tokens to generate. Defaults to 16.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"temperature": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Sampling temperature between 0 and 2. Defaults to 1.",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 2),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"top_p": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Nucleus sampling probability mass between 0 and 1. Defaults to 1.",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"n": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "How many completions to generate. Defaults to 1.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"stop": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Up to 4 sequences where the API stops generating further tokens.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 4),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"logprobs": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Include the log probabilities of the `logprobs` most likely tokens at each position, up to 5. Fills in `choices.logprobs`.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"created": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The Unix timestamp when the completion was created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"model_used": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The model that generated the completion.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"choices": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The completions the model generated.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							Computed: true,
+						},
+						"text": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The generated text.",
+						},
+						"finish_reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Why the model stopped: `stop` or `length`.",
+						},
+						"logprobs": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Per-token log probabilities. Null unless `logprobs` is set.",
+							Attributes: map[string]schema.Attribute{
+								"tokens": schema.ListAttribute{
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"token_logprobs": schema.ListAttribute{
+									Computed:    true,
+									ElementType: types.Float64Type,
+								},
+								"top_logprobs": schema.ListAttribute{
+									Computed:    true,
+									ElementType: types.MapType{ElemType: types.Float64Type},
+								},
+								"text_offset": schema.ListAttribute{
+									Computed:    true,
+									ElementType: types.Int64Type,
+								},
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Usage statistics for the completion request.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CompletionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *CompletionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CompletionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &client.CompletionRequest{
+		Model:  data.Model.ValueString(),
+		Prompt: data.Prompt.ValueString(),
+		Suffix: data.Suffix.ValueString(),
+	}
+	if !data.MaxTokens.IsNull() {
+		v := int(data.MaxTokens.ValueInt64())
+		request.MaxTokens = &v
+	}
+	if !data.Temperature.IsNull() {
+		v := data.Temperature.ValueFloat64()
+		request.Temperature = &v
+	}
+	if !data.TopP.IsNull() {
+		v := data.TopP.ValueFloat64()
+		request.TopP = &v
+	}
+	if !data.N.IsNull() {
+		v := int(data.N.ValueInt64())
+		request.N = &v
+	}
+	if !data.Logprobs.IsNull() {
+		v := int(data.Logprobs.ValueInt64())
+		request.Logprobs = &v
+	}
+	for _, s := range data.Stop {
+		request.Stop = append(request.Stop, s.ValueString())
+	}
+
+	completion, err := r.client.CreateCompletion(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating completion", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setCompletionComputed(ctx, r.client.OpenAIClient, &data, completion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setCompletionComputed copies the API's computed fields into data,
+// truncating generated text to cl's max_response_bytes.
+func setCompletionComputed(ctx context.Context, cl *client.OpenAIClient, data *CompletionResourceModel, completion *client.CompletionResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.ID = types.StringValue(completion.ID)
+	data.Created = types.Int64Value(int64(completion.Created))
+	data.ModelUsed = types.StringValue(completion.Model)
+
+	choices := make([]attr.Value, 0, len(completion.Choices))
+	for i, c := range completion.Choices {
+		logprobs := types.ObjectNull(completionLogprobsType.AttrTypes)
+		if c.Logprobs != nil {
+			var d diag.Diagnostics
+			logprobs, d = completionLogprobsValue(ctx, c.Logprobs)
+			diags.Append(d...)
+		}
+		choice, d := types.ObjectValue(completionChoiceType.AttrTypes, map[string]attr.Value{
+			"index":         types.Int64Value(int64(c.Index)),
+			"text":          stateString(cl, path.Root("choices").AtListIndex(i).AtName("text"), c.Text, &diags),
+			"finish_reason": types.StringValue(c.FinishReason),
+			"logprobs":      logprobs,
+		})
+		diags.Append(d...)
+		choices = append(choices, choice)
+	}
+	choiceList, d := types.ListValue(completionChoiceType, choices)
+	diags.Append(d...)
+	data.Choices = choiceList
+
+	usage, d := types.MapValueFrom(ctx, types.Int64Type, map[string]int64{
+		"prompt_tokens":     int64(completion.Usage.PromptTokens),
+		"completion_tokens": int64(completion.Usage.CompletionTokens),
+		"total_tokens":      int64(completion.Usage.TotalTokens),
+	})
+	diags.Append(d...)
+	data.Usage = usage
+	return diags
+}
+
+func completionLogprobsValue(ctx context.Context, lp *client.CompletionLogprobs) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tokens, d := types.ListValueFrom(ctx, types.StringType, lp.Tokens)
+	diags.Append(d...)
+	tokenLogprobs, d := types.ListValueFrom(ctx, types.Float64Type, lp.TokenLogprobs)
+	diags.Append(d...)
+	topLogprobs, d := types.ListValueFrom(ctx, types.MapType{ElemType: types.Float64Type}, lp.TopLogprobs)
+	diags.Append(d...)
+	textOffset, d := types.ListValueFrom(ctx, types.Int64Type, lp.TextOffset)
+	diags.Append(d...)
+
+	obj, d := types.ObjectValue(completionLogprobsType.AttrTypes, map[string]attr.Value{
+		"tokens":         tokens,
+		"token_logprobs": tokenLogprobs,
+		"top_logprobs":   topLogprobs,
+		"text_offset":    textOffset,
+	})
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (r *CompletionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Completions cannot be retrieved; the state is kept as created.
+}
+
+func (r *CompletionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Operation not supported", "Completions are immutable")
+}
+
+func (r *CompletionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCompletionResource_Create(t *testing.T) {
+	ctx := context.Background()
+
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/completions" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":      "cmpl-123",
+			"object":  "text_completion",
+			"created": 1700000000,
+			"model":   "gpt-3.5-turbo-instruct",
+			"choices": []interface{}{
+				map[string]interface{}{
+					"text":          " world",
+					"index":         0,
+					"finish_reason": "stop",
+					"logprobs": map[string]interface{}{
+						"tokens":         []string{" world"},
+						"token_logprobs": []float64{-0.25},
+						"top_logprobs":   []map[string]float64{{" world": -0.25, " there": -1.5}},
+						"text_offset":    []int{5},
+					},
+				},
+			},
+			"usage": map[string]int{"prompt_tokens": 1, "completion_tokens": 1, "total_tokens": 2},
+		})
+	}))
+	defer server.Close()
+
+	r := &CompletionResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "created", "model_used", "choices", "usage"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["model"] = tftypes.NewValue(tftypes.String, "gpt-3.5-turbo-instruct")
+	vals["prompt"] = tftypes.NewValue(tftypes.String, "Hello")
+	vals["temperature"] = tftypes.NewValue(tftypes.Number, 0)
+	vals["logprobs"] = tftypes.NewValue(tftypes.Number, 2)
+	vals["stop"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "\n")})
+
+	raw := tftypes.NewValue(objType, vals)
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: sch, Raw: raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", resp.Diagnostics)
+	}
+
+	// An explicit zero temperature is sent; unset options are omitted.
+	if sent["temperature"] != float64(0) || sent["logprobs"] != float64(2) || sent["prompt"] != "Hello" {
+		t.Errorf("request = %v", sent)
+	}
+	for _, unset := range []string{"max_tokens", "top_p", "n", "suffix"} {
+		if _, ok := sent[unset]; ok {
+			t.Errorf("request sent unset %s: %v", unset, sent)
+		}
+	}
+
+	var data CompletionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading state: %v", resp.Diagnostics)
+	}
+	if data.ID.ValueString() != "cmpl-123" || data.ModelUsed.ValueString() != "gpt-3.5-turbo-instruct" {
+		t.Errorf("id/model_used = %s/%s", data.ID, data.ModelUsed)
+	}
+	if got := data.Usage.Elements()["total_tokens"].String(); got != "2" {
+		t.Errorf("usage.total_tokens = %s, want 2", got)
+	}
+
+	choices := data.Choices.Elements()
+	if len(choices) != 1 {
+		t.Fatalf("choices = %v", data.Choices)
+	}
+	choice := choices[0].(types.Object).Attributes()
+	if choice["text"].String() != `" world"` || choice["finish_reason"].String() != `"stop"` {
+		t.Errorf("choice = %v", choice)
+	}
+	logprobs := choice["logprobs"].(types.Object).Attributes()
+	if got := logprobs["text_offset"].String(); got != "[5]" {
+		t.Errorf("logprobs.text_offset = %s, want [5]", got)
+	}
+}
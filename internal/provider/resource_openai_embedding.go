@@ -38,6 +38,7 @@ type EmbeddingResourceModel struct {
 
 	// Computed
 	Object    types.String `tfsdk:"object"`
+	Usage     types.Map    `tfsdk:"usage"`
 	Embedding types.String `tfsdk:"embedding"` // Return as string representation or maybe handle as text?
 	// The embedding vector is large, maybe we shouldn't store it in state by default?
 	// But SDKv2 probably did.
@@ -103,6 +104,11 @@ func (r *EmbeddingResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "The embedding vector",
 				Computed:    true,
 			},
+			"usage": schema.MapAttribute{
+				Description: "Token usage of the request: prompt_tokens and total_tokens",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
 		},
 	}
 }
@@ -176,6 +182,13 @@ func (r *EmbeddingResource) Create(ctx context.Context, req resource.CreateReque
 		data.Embedding = types.StringValue(string(embedBytes))
 	}
 
+	usage, diags := types.MapValueFrom(ctx, types.Int64Type, map[string]int64{
+		"prompt_tokens": int64(embedResp.Usage.PromptTokens),
+		"total_tokens":  int64(embedResp.Usage.TotalTokens),
+	})
+	resp.Diagnostics.Append(diags...)
+	data.Usage = usage
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEmbeddingCreate_Usage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/embeddings" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"model":  "text-embedding-3-small",
+			"data": []map[string]interface{}{
+				{"object": "embedding", "index": 0, "embedding": []float64{0.1, -0.2}},
+			},
+			"usage": map[string]interface{}{"prompt_tokens": 7, "total_tokens": 7},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &EmbeddingResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "object", "embedding", "usage"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["model"] = tftypes.NewValue(tftypes.String, "text-embedding-3-small")
+	vals["input"] = tftypes.NewValue(tftypes.String, "hello world")
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	var data EmbeddingResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	usage := map[string]int64{}
+	resp.Diagnostics.Append(data.Usage.ElementsAs(ctx, &usage, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading usage: %v", resp.Diagnostics)
+	}
+	if usage["prompt_tokens"] != 7 || usage["total_tokens"] != 7 {
+		t.Errorf("usage = %v, want prompt_tokens=7 total_tokens=7", usage)
+	}
+}
@@ -178,7 +178,7 @@ func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, r
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -1,21 +1,25 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ resource.Resource = &FineTuningJobResource{}
@@ -29,6 +33,19 @@ func NewFineTuningJobResource() resource.Resource {
 	return &FineTuningJobResource{}
 }
 
+// fineTuningLogSubsystem is the logging subsystem for fine-tuning job
+// operations, raised to TRACE by the resource's debug flag.
+const fineTuningLogSubsystem = "fine_tuning_job"
+
+// fineTuningDefaultPollInterval is used when wait_for_completion is set
+// without poll_interval.
+const fineTuningDefaultPollInterval = 30 * time.Second
+
+// fineTuningWaitTimeout bounds wait_for_completion when the provider has no
+// timeout configured. Jobs routinely run for tens of minutes, so this is
+// longer than the default used for other waits.
+const fineTuningWaitTimeout = 2 * time.Hour
+
 func (r *FineTuningJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_fine_tuning_job"
 }
@@ -43,6 +60,10 @@ type FineTuningJobResourceModel struct {
 	Method         *FineTuningMethodModel       `tfsdk:"method"`
 	Integrations   []FineTuningIntegrationModel `tfsdk:"integrations"`
 	Metadata       types.Map                    `tfsdk:"metadata"`
+	Debug          types.Bool                   `tfsdk:"debug"`
+
+	WaitForCompletion types.Bool  `tfsdk:"wait_for_completion"`
+	PollInterval      types.Int64 `tfsdk:"poll_interval"`
 
 	// Computed
 	Status         types.String  `tfsdk:"status"`
@@ -53,6 +74,8 @@ type FineTuningJobResourceModel struct {
 	ValidationLoss types.Float64 `tfsdk:"validation_loss"`
 	CreatedAt      types.Int64   `tfsdk:"created_at"`
 	FinishedAt     types.Int64   `tfsdk:"finished_at"`
+
+	ValidationMetrics types.Object `tfsdk:"validation_metrics"`
 }
 
 type FineTuningMethodModel struct {
@@ -105,6 +128,7 @@ func (r *FineTuningJobResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"debug": debugAttribute(),
 			"model": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The base model to fine-tune.",
@@ -146,6 +170,15 @@ func (r *FineTuningJobResource) Schema(ctx context.Context, req resource.SchemaR
 				ElementType:         types.StringType,
 				MarkdownDescription: "Metadata.",
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Wait on create until the job has `succeeded`, `failed` or been `cancelled`, so `fine_tuned_model` is known after apply. The wait is bounded by the provider `timeout`, or two hours if unset. A failed job is reported as an error with the job's error details.",
+			},
+			"poll_interval": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds between status checks while waiting for completion. Defaults to 30.",
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
 			// Computed
 			"status":           schema.StringAttribute{Computed: true},
 			"fine_tuned_model": schema.StringAttribute{Computed: true},
@@ -154,6 +187,32 @@ func (r *FineTuningJobResource) Schema(ctx context.Context, req resource.SchemaR
 			"finished_at":      schema.Int64Attribute{Computed: true},
 			"trained_tokens":   schema.Int64Attribute{Computed: true},
 			"validation_loss":  schema.Float64Attribute{Computed: true},
+			"validation_metrics": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Validation metrics of the latest training step that reported them, read from the job's `metrics` events. Comparing them with the training loss shows overfitting. Null until the job has a validation file and has reported a validation step.",
+				Attributes: map[string]schema.Attribute{
+					"step": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The training step the metrics were recorded at.",
+					},
+					"valid_loss": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Loss on a batch of the validation file.",
+					},
+					"valid_mean_token_accuracy": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Mean token accuracy on a batch of the validation file.",
+					},
+					"full_valid_loss": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Loss on the whole validation file. Only reported on the final step.",
+					},
+					"full_valid_mean_token_accuracy": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Mean token accuracy on the whole validation file. Only reported on the final step.",
+					},
+				},
+			},
 			"result_files": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.StringType,
@@ -246,6 +305,7 @@ func (r *FineTuningJobResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withResourceLogging(ctx, fineTuningLogSubsystem, data.Debug)
 
 	createRequest := FineTuningJobCreateRequest{
 		Model:        data.Model.ValueString(),
@@ -355,46 +415,192 @@ func (r *FineTuningJobResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	url := fmt.Sprintf("%s/fine_tuning/jobs", r.client.OpenAIClient.APIURL)
-	apiReq, err := http.NewRequest("POST", url, bytes.NewReader(reqBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-
-	apiReq.Header.Set("Content-Type", "application/json")
-	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	if r.client.OpenAIClient.OrganizationID != "" {
-		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
-	}
-
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	tflog.SubsystemTrace(ctx, fineTuningLogSubsystem, "Creating fine-tuning job", map[string]interface{}{
+		"body": string(reqBody),
+	})
+	respBodyBytes, err := r.client.DoRequestContext(ctx, http.MethodPost, "fine_tuning/jobs", json.RawMessage(reqBody))
 	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
-	}
-	defer apiResp.Body.Close()
-
-	if apiResp.StatusCode != http.StatusOK && apiResp.StatusCode != http.StatusCreated {
-		respBodyBytes, _ := io.ReadAll(apiResp.Body)
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes)))
+		tflog.SubsystemDebug(ctx, fineTuningLogSubsystem, "Fine-tuning job creation failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Error creating fine-tuning job: %s", err))
 		return
 	}
 
 	var ftResp FineTuningJobResponse
-	respBodyBytes, _ := io.ReadAll(apiResp.Body)
+	tflog.SubsystemTrace(ctx, fineTuningLogSubsystem, "Fine-tuning job create response", map[string]interface{}{
+		"body": string(respBodyBytes),
+	})
 	if err := json.Unmarshal(respBodyBytes, &ftResp); err != nil {
 		resp.Diagnostics.AddError("Error parsing response", err.Error())
 		return
 	}
+	tflog.SubsystemDebug(ctx, fineTuningLogSubsystem, "Created fine-tuning job", map[string]interface{}{
+		"id":     ftResp.ID,
+		"status": ftResp.Status,
+	})
 
 	data.ID = types.StringValue(ftResp.ID)
+	r.setJobComputed(ctx, &data, &ftResp)
+
+	if data.WaitForCompletion.ValueBool() {
+		interval := fineTuningDefaultPollInterval
+		if !data.PollInterval.IsNull() {
+			interval = time.Duration(data.PollInterval.ValueInt64()) * time.Second
+		}
+
+		job, err := r.waitForJob(ctx, ftResp.ID, interval)
+		if job != nil {
+			r.setJobComputed(ctx, &data, job)
+			// The metrics are informational, so failing to read them must not
+			// fail an apply whose job already exists.
+			if metrics, mErr := r.validationMetrics(ctx, job); mErr != nil {
+				resp.Diagnostics.AddWarning("Error reading fine-tuning validation metrics", mErr.Error())
+			} else {
+				data.ValidationMetrics = metrics
+			}
+		}
+		if err != nil {
+			saveAfterFailedWait(ctx, &resp.State, &resp.Diagnostics, &data, "Error waiting for fine-tuning job", err)
+			return
+		}
+		if job.Status != "succeeded" {
+			detail := "no error details"
+			if job.Error != nil {
+				detail = fmt.Sprintf("%s: %s", job.Error.Code, job.Error.Message)
+				if job.Error.Param != "" {
+					detail += fmt.Sprintf(" (param: %s)", job.Error.Param)
+				}
+			}
+			saveAfterFailedWait(ctx, &resp.State, &resp.Diagnostics, &data, "Fine-tuning job did not succeed",
+				fmt.Errorf("fine-tuning job %s finished with status %q: %s", job.ID, job.Status, detail))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setJobComputed copies the API's computed fields into data.
+func (r *FineTuningJobResource) setJobComputed(ctx context.Context, data *FineTuningJobResourceModel, ftResp *FineTuningJobResponse) {
 	data.Status = types.StringValue(ftResp.Status)
 	data.CreatedAt = types.Int64Value(ftResp.CreatedAt)
-	data.OrganizationID = types.StringValue(ftResp.OrganizationID)
 	data.FineTunedModel = types.StringValue(ftResp.FineTunedModel)
+	data.ResultFiles, _ = types.ListValueFrom(ctx, types.StringType, ftResp.ResultFiles)
+	data.TrainedTokens = types.Int64Value(ftResp.TrainedTokens)
+	data.ValidationLoss = types.Float64Value(ftResp.ValidationLoss)
+	data.OrganizationID = types.StringValue(ftResp.OrganizationID)
+	data.FinishedAt = types.Int64PointerValue(ftResp.FinishedAt)
+	if data.ValidationMetrics.IsUnknown() {
+		data.ValidationMetrics = types.ObjectNull(validationMetricsAttrTypes)
+	}
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+var validationMetricsAttrTypes = map[string]attr.Type{
+	"step":                           types.Int64Type,
+	"valid_loss":                     types.Float64Type,
+	"valid_mean_token_accuracy":      types.Float64Type,
+	"full_valid_loss":                types.Float64Type,
+	"full_valid_mean_token_accuracy": types.Float64Type,
+}
+
+// validationMetrics returns the validation metrics of the newest "metrics"
+// event of job that has them, or a null object when there is none. Jobs
+// without a validation file or that have not started training are not
+// queried.
+func (r *FineTuningJobResource) validationMetrics(ctx context.Context, job *FineTuningJobResponse) (types.Object, error) {
+	null := types.ObjectNull(validationMetricsAttrTypes)
+	switch job.Status {
+	case "validating_files", "queued":
+		return null, nil
+	}
+	if job.ValidationFile == "" {
+		return null, nil
+	}
+
+	// Events are listed newest first, so the first match is the latest.
+	after := ""
+	for {
+		page, err := r.client.ListFineTuningEvents(ctx, job.ID, after, fineTuningEventsPageSize)
+		if err != nil {
+			return null, err
+		}
+
+		for _, e := range page.Data {
+			if e.Type != "metrics" || len(e.Data) == 0 {
+				continue
+			}
+			var m FineTuningMetricsEventData
+			if err := json.Unmarshal(e.Data, &m); err != nil {
+				return null, fmt.Errorf("error parsing metrics of event %s: %w", e.ID, err)
+			}
+			if m.ValidLoss == nil {
+				continue
+			}
+			obj, diags := types.ObjectValue(validationMetricsAttrTypes, map[string]attr.Value{
+				"step":                           types.Int64Value(m.Step),
+				"valid_loss":                     types.Float64PointerValue(m.ValidLoss),
+				"valid_mean_token_accuracy":      types.Float64PointerValue(m.ValidMeanTokenAccuracy),
+				"full_valid_loss":                types.Float64PointerValue(m.FullValidLoss),
+				"full_valid_mean_token_accuracy": types.Float64PointerValue(m.FullValidMeanTokenAccuracy),
+			})
+			if diags.HasError() {
+				return null, fmt.Errorf("error building validation metrics: %v", diags)
+			}
+			return obj, nil
+		}
+
+		if !page.HasMore || len(page.Data) == 0 {
+			return null, nil
+		}
+		after = page.Data[len(page.Data)-1].ID
+	}
+}
+
+// getJob fetches a fine-tuning job. It returns nil without error when the
+// job does not exist.
+func (r *FineTuningJobResource) getJob(ctx context.Context, id string) (*FineTuningJobResponse, error) {
+	tflog.SubsystemTrace(ctx, fineTuningLogSubsystem, "Reading fine-tuning job", map[string]interface{}{"id": id})
+	respBodyBytes, err := r.client.DoRequestContext(ctx, http.MethodGet, "fine_tuning/jobs/"+id, nil)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var ftResp FineTuningJobResponse
+	tflog.SubsystemTrace(ctx, fineTuningLogSubsystem, "Fine-tuning job read response", map[string]interface{}{
+		"body": string(respBodyBytes),
+	})
+	if err := json.Unmarshal(respBodyBytes, &ftResp); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	return &ftResp, nil
+}
+
+// waitForJob polls a fine-tuning job every interval until it has succeeded,
+// failed or been cancelled, and returns the final job. The wait is bounded
+// by the provider timeout (fineTuningWaitTimeout if unset), so it stops with
+// the apply instead of outliving it. On timeout the last job seen is
+// returned alongside the error.
+func (r *FineTuningJobResource) waitForJob(ctx context.Context, id string, interval time.Duration) (*FineTuningJobResponse, error) {
+	return waitForStatus(ctx, waitTimeout(r.client, fineTuningWaitTimeout), interval, "fine-tuning job "+id, func(ctx context.Context) (*FineTuningJobResponse, string, bool, error) {
+		job, err := r.getJob(ctx, id)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if job == nil {
+			return nil, "", false, fmt.Errorf("fine-tuning job %s not found", id)
+		}
+		switch job.Status {
+		case "succeeded", "failed", "cancelled":
+			return job, job.Status, true, nil
+		}
+		return job, job.Status, false, nil
+	})
 }
 
 func (r *FineTuningJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -403,56 +609,53 @@ func (r *FineTuningJobResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withResourceLogging(ctx, fineTuningLogSubsystem, data.Debug)
 
-	url := fmt.Sprintf("%s/fine_tuning/jobs/%s", r.client.OpenAIClient.APIURL, data.ID.ValueString())
-	apiReq, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	if r.client.OpenAIClient.OrganizationID != "" {
-		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
-	}
-
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	ftResp, err := r.getJob(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
+		resp.Diagnostics.AddError("Error reading fine-tuning job", err.Error())
 		return
 	}
-	defer apiResp.Body.Close()
-
-	if apiResp.StatusCode == http.StatusNotFound {
+	if ftResp == nil {
+		tflog.SubsystemDebug(ctx, fineTuningLogSubsystem, "Fine-tuning job not found, removing from state", map[string]interface{}{"id": data.ID.ValueString()})
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	if apiResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s", apiResp.Status))
-		return
+	tflog.SubsystemDebug(ctx, fineTuningLogSubsystem, "Read fine-tuning job", map[string]interface{}{
+		"id":     ftResp.ID,
+		"status": ftResp.Status,
+	})
+
+	r.setJobComputed(ctx, &data, ftResp)
+	// Read validation_file back so a job replaced outside Terraform shows as
+	// drift.
+	data.ValidationFile = types.StringNull()
+	if ftResp.ValidationFile != "" {
+		data.ValidationFile = types.StringValue(ftResp.ValidationFile)
 	}
-
-	var ftResp FineTuningJobResponse
-	respBodyBytes, _ := io.ReadAll(apiResp.Body)
-	if err := json.Unmarshal(respBodyBytes, &ftResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
+	data.ValidationMetrics, err = r.validationMetrics(ctx, ftResp)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading fine-tuning validation metrics", err.Error())
 		return
 	}
 
-	data.Status = types.StringValue(ftResp.Status)
-	data.FineTunedModel = types.StringValue(ftResp.FineTunedModel)
-	data.ResultFiles, _ = types.ListValueFrom(ctx, types.StringType, ftResp.ResultFiles)
-	data.TrainedTokens = types.Int64Value(ftResp.TrainedTokens)
-	data.ValidationLoss = types.Float64Value(ftResp.ValidationLoss)
-	data.OrganizationID = types.StringValue(ftResp.OrganizationID)
-	if ftResp.FinishedAt != nil {
-		data.FinishedAt = types.Int64Value(*ftResp.FinishedAt)
-	}
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Update only handles the debug and waiting flags; every job argument forces
+// replacement.
 func (r *FineTuningJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Immutable
+	var plan, state FineTuningJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Debug = plan.Debug
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.PollInterval = plan.PollInterval
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -461,25 +664,73 @@ func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withResourceLogging(ctx, fineTuningLogSubsystem, data.Debug)
 
 	// Try to cancel if running
 	if data.Status.ValueString() == "running" || data.Status.ValueString() == "queued" {
-		url := fmt.Sprintf("%s/fine_tuning/jobs/%s/cancel", r.client.OpenAIClient.APIURL, data.ID.ValueString())
-		apiReq, err := http.NewRequest("POST", url, nil)
-		if err != nil {
-			return
-		}
-
-		apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-		if r.client.OpenAIClient.OrganizationID != "" {
-			apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
+		tflog.SubsystemDebug(ctx, fineTuningLogSubsystem, "Cancelling fine-tuning job", map[string]interface{}{
+			"id":     data.ID.ValueString(),
+			"status": data.Status.ValueString(),
+		})
+		// Cancelling is best effort: the job may have finished since the last
+		// refresh, and a finished job cannot be cancelled.
+		if _, err := r.client.DoRequestContext(ctx, http.MethodPost, "fine_tuning/jobs/"+data.ID.ValueString()+"/cancel", nil); err != nil {
+			tflog.SubsystemDebug(ctx, fineTuningLogSubsystem, "Could not cancel fine-tuning job", map[string]interface{}{
+				"id":    data.ID.ValueString(),
+				"error": err.Error(),
+			})
 		}
-
-		http.DefaultClient.Do(apiReq)
 	}
 	// Remove from state
 }
 
+// ImportState reads the job so its arguments land in state and a job that
+// cannot be fetched fails the import instead of producing an empty state.
 func (r *FineTuningJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	job, err := r.getJob(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing fine-tuning job", fmt.Sprintf("Could not read fine-tuning job %s: %s", req.ID, err))
+		return
+	}
+	if job == nil {
+		resp.Diagnostics.AddError("Error importing fine-tuning job", fmt.Sprintf("Fine-tuning job %s does not exist.", req.ID))
+		return
+	}
+
+	data := FineTuningJobResourceModel{
+		ID:             types.StringValue(job.ID),
+		Model:          types.StringValue(job.Model),
+		TrainingFile:   types.StringValue(job.TrainingFile),
+		ValidationFile: types.StringNull(),
+		Suffix:         types.StringNull(),
+		Seed:           types.Int64Null(),
+		Metadata:       types.MapNull(types.StringType),
+
+		ValidationMetrics: types.ObjectNull(validationMetricsAttrTypes),
+	}
+	if job.ValidationFile != "" {
+		data.ValidationFile = types.StringValue(job.ValidationFile)
+	}
+	if suffix := suffixFromFineTunedModel(job.FineTunedModel); suffix != "" {
+		data.Suffix = types.StringValue(suffix)
+	}
+	r.setJobComputed(ctx, &data, job)
+	data.ValidationMetrics, err = r.validationMetrics(ctx, job)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing fine-tuning job", fmt.Sprintf("Could not read validation metrics of fine-tuning job %s: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// suffixFromFineTunedModel returns the suffix part of a fine-tuned model
+// name such as "ft:gpt-4o-mini-2024-07-18:my-org:custom-suffix:abc123", or ""
+// when the name has none.
+func suffixFromFineTunedModel(name string) string {
+	parts := strings.Split(name, ":")
+	if len(parts) != 5 || parts[0] != "ft" {
+		return ""
+	}
+	return parts[3]
 }
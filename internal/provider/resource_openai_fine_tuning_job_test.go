@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// createFineTuningJob runs the fine-tuning job resource's Create against
+// apiURL with wait_for_completion set and every computed attribute unknown.
+func createFineTuningJob(t *testing.T, apiURL string) *resource.CreateResponse {
+	t.Helper()
+	return createFineTuningJobWithClient(t, newTestOpenAIClient(apiURL))
+}
+
+func createFineTuningJobWithClient(t *testing.T, c *OpenAIClient) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &FineTuningJobResource{client: c}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "status", "fine_tuned_model", "organization_id", "created_at", "finished_at", "trained_tokens", "validation_loss", "result_files", "validation_metrics"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["model"] = tftypes.NewValue(tftypes.String, "gpt-4o-mini-2024-07-18")
+	vals["training_file"] = tftypes.NewValue(tftypes.String, "file-train")
+	vals["wait_for_completion"] = tftypes.NewValue(tftypes.Bool, true)
+	vals["poll_interval"] = tftypes.NewValue(tftypes.Number, 1)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// mockFineTuningJob accepts a job as queued, reports it running for the next
+// pendingReads GETs and then finalStatus.
+func mockFineTuningJob(t *testing.T, pendingReads int, finalStatus string) *httptest.Server {
+	var mu sync.Mutex
+	reads := 0
+	job := func(status string) map[string]interface{} {
+		body := map[string]interface{}{
+			"id":              "ftjob-1",
+			"object":          "fine_tuning.job",
+			"model":           "gpt-4o-mini-2024-07-18",
+			"training_file":   "file-train",
+			"organization_id": "org-1",
+			"status":          status,
+			"created_at":      1700000000,
+			"result_files":    []string{},
+		}
+		switch status {
+		case "succeeded":
+			body["fine_tuned_model"] = "ft:gpt-4o-mini-2024-07-18:org::abc"
+			body["finished_at"] = 1700003600
+			body["trained_tokens"] = 12345
+			body["result_files"] = []string{"file-result"}
+		case "failed":
+			body["finished_at"] = 1700000600
+			body["error"] = map[string]interface{}{"code": "invalid_training_file", "message": "line 3 is not valid JSON", "param": "training_file"}
+		}
+		return body
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/fine_tuning/jobs":
+			writeJSON(w, http.StatusOK, job("validating_files"))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/fine_tuning/jobs/ftjob-1":
+			reads++
+			if reads <= pendingReads {
+				writeJSON(w, http.StatusOK, job("running"))
+				return
+			}
+			writeJSON(w, http.StatusOK, job(finalStatus))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestFineTuningJobCreate_WaitForCompletionSucceeded(t *testing.T) {
+	server := mockFineTuningJob(t, 1, "succeeded")
+	defer server.Close()
+
+	resp := createFineTuningJob(t, server.URL)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got FineTuningJobResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Status.ValueString() != "succeeded" {
+		t.Errorf("status = %q, want succeeded", got.Status.ValueString())
+	}
+	if got.FineTunedModel.ValueString() != "ft:gpt-4o-mini-2024-07-18:org::abc" {
+		t.Errorf("fine_tuned_model = %q", got.FineTunedModel.ValueString())
+	}
+	if got.FinishedAt.ValueInt64() != 1700003600 || got.TrainedTokens.ValueInt64() != 12345 {
+		t.Errorf("computed fields not populated: %+v", got)
+	}
+}
+
+func TestFineTuningJobCreate_WaitForCompletionFailed(t *testing.T) {
+	server := mockFineTuningJob(t, 0, "failed")
+	defer server.Close()
+
+	resp := createFineTuningJob(t, server.URL)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a failed job")
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	for _, want := range []string{"invalid_training_file", "line 3 is not valid JSON", "training_file"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("error %q does not mention %q", detail, want)
+		}
+	}
+
+	var got FineTuningJobResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "ftjob-1" || got.Status.ValueString() != "failed" {
+		t.Errorf("the failed job must stay in state, got id=%q status=%q", got.ID.ValueString(), got.Status.ValueString())
+	}
+}
+
+func TestFineTuningJobWait_StopsAtDeadline(t *testing.T) {
+	server := mockFineTuningJob(t, 1000, "succeeded")
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	c.OpenAIClient.Timeout = 20 * time.Millisecond
+	r := &FineTuningJobResource{client: c}
+
+	start := time.Now()
+	got, err := r.waitForJob(context.Background(), "ftjob-1", time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a deadline error")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("wait did not stop at the deadline: %s", time.Since(start))
+	}
+	if got == nil || got.Status != "running" {
+		t.Errorf("want the last running job returned, got %+v", got)
+	}
+}
+
+// importFineTuningJob runs the fine-tuning job resource's ImportState for id
+// against apiURL.
+func importFineTuningJob(t *testing.T, apiURL, id string) *resource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &FineTuningJobResource{client: newTestOpenAIClient(apiURL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx)
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+	return resp
+}
+
+func TestFineTuningJobImport_NonexistentJobFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "No such fine-tuning job"}})
+	}))
+	defer server.Close()
+
+	resp := importFineTuningJob(t, server.URL, "ftjob-missing")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error importing a nonexistent job")
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("no state should be written for a failed import, got %s", resp.State.Raw)
+	}
+}
+
+func TestFineTuningJobImport_APIErrorFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0") // skip the client's backoff
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{"error": map[string]interface{}{"message": "boom"}})
+	}))
+	defer server.Close()
+
+	resp := importFineTuningJob(t, server.URL, "ftjob-1")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the API error to fail the import")
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "500") {
+		t.Errorf("error should carry the API status, got %q", resp.Diagnostics.Errors()[0].Detail())
+	}
+}
+
+func TestFineTuningJobImport_ReadsRealJob(t *testing.T) {
+	server := mockFineTuningJob(t, 0, "succeeded")
+	defer server.Close()
+
+	resp := importFineTuningJob(t, server.URL, "ftjob-1")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got FineTuningJobResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Model.ValueString() != "gpt-4o-mini-2024-07-18" || got.TrainingFile.ValueString() != "file-train" {
+		t.Errorf("job arguments not imported: model=%q training_file=%q", got.Model.ValueString(), got.TrainingFile.ValueString())
+	}
+	// The mock's fine_tuned_model has an empty suffix segment.
+	if !got.Suffix.IsNull() {
+		t.Errorf("suffix = %q, want null", got.Suffix.ValueString())
+	}
+	if !got.ValidationFile.IsNull() {
+		t.Errorf("validation_file = %q, want null", got.ValidationFile.ValueString())
+	}
+}
+
+func TestFineTuningJobRead_ValidationMetricsFromLatestEvent(t *testing.T) {
+	validationFile := "file-valid"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/fine_tuning/jobs/ftjob-1":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"id":              "ftjob-1",
+				"model":           "gpt-4o-mini-2024-07-18",
+				"training_file":   "file-train",
+				"validation_file": validationFile,
+				"status":          "succeeded",
+				"created_at":      1700000000,
+				"result_files":    []string{},
+			})
+		case "/v1/fine_tuning/jobs/ftjob-1/events":
+			// Newest first: the first page has no validation metrics, so the
+			// second page must be read.
+			if r.URL.Query().Get("after") == "" {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"data": []map[string]interface{}{
+						{"id": "ftevent-4", "type": "message", "message": "The job has successfully completed"},
+						{"id": "ftevent-3", "type": "metrics", "data": map[string]interface{}{"step": 30, "train_loss": 0.4}},
+					},
+					"has_more": true,
+				})
+				return
+			}
+			if got := r.URL.Query().Get("after"); got != "ftevent-3" {
+				t.Errorf("after = %q, want ftevent-3", got)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": "ftevent-2", "type": "metrics", "data": map[string]interface{}{
+						"step": 20, "train_loss": 0.5, "valid_loss": 0.61, "valid_mean_token_accuracy": 0.82,
+						"full_valid_loss": 0.63, "full_valid_mean_token_accuracy": 0.8,
+					}},
+					{"id": "ftevent-1", "type": "metrics", "data": map[string]interface{}{"step": 10, "valid_loss": 0.9}},
+				},
+				"has_more": false,
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	imported := importFineTuningJob(t, server.URL, "ftjob-1")
+	if imported.Diagnostics.HasError() {
+		t.Fatalf("import: %v", imported.Diagnostics)
+	}
+
+	// Replace the validation file on the server so Read reports the drift.
+	validationFile = "file-valid-2"
+	r := &FineTuningJobResource{client: newTestOpenAIClient(server.URL)}
+	resp := &resource.ReadResponse{State: imported.State}
+	r.Read(ctx, resource.ReadRequest{State: imported.State}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+
+	var got FineTuningJobResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ValidationFile.ValueString() != "file-valid-2" {
+		t.Errorf("validation_file = %s, want file-valid-2", got.ValidationFile)
+	}
+	var metrics struct {
+		Step                       int64   `tfsdk:"step"`
+		ValidLoss                  float64 `tfsdk:"valid_loss"`
+		ValidMeanTokenAccuracy     float64 `tfsdk:"valid_mean_token_accuracy"`
+		FullValidLoss              float64 `tfsdk:"full_valid_loss"`
+		FullValidMeanTokenAccuracy float64 `tfsdk:"full_valid_mean_token_accuracy"`
+	}
+	resp.Diagnostics.Append(got.ValidationMetrics.As(ctx, &metrics, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("validation_metrics: %v", resp.Diagnostics)
+	}
+	if metrics.Step != 20 || metrics.ValidLoss != 0.61 || metrics.ValidMeanTokenAccuracy != 0.82 ||
+		metrics.FullValidLoss != 0.63 || metrics.FullValidMeanTokenAccuracy != 0.8 {
+		t.Errorf("validation_metrics = %+v, want the step 20 metrics", metrics)
+	}
+}
+
+func TestFineTuningJobValidationMetrics_SkipsJobsWithoutValidationFile(t *testing.T) {
+	server := mockFineTuningJob(t, 0, "succeeded")
+	defer server.Close()
+
+	// mockFineTuningJob fails the test on any events request.
+	resp := importFineTuningJob(t, server.URL, "ftjob-1")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got FineTuningJobResourceModel
+	resp.State.Get(context.Background(), &got)
+	if !got.ValidationMetrics.IsNull() {
+		t.Errorf("validation_metrics = %s, want null", got.ValidationMetrics)
+	}
+}
+
+func TestSuffixFromFineTunedModel(t *testing.T) {
+	cases := map[string]string{
+		"ft:gpt-4o-mini-2024-07-18:my-org:custom-suffix:abc123": "custom-suffix",
+		"ft:gpt-4o-mini-2024-07-18:my-org::abc123":              "",
+		"ft:gpt-4o-mini-2024-07-18:my-org:abc123":               "",
+		"":       "",
+		"gpt-4o": "",
+	}
+	for name, want := range cases {
+		if got := suffixFromFineTunedModel(name); got != want {
+			t.Errorf("suffixFromFineTunedModel(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestFineTuningJob_SendsOrganizationHeader runs create with
+// wait_for_completion and then delete of a running job, and checks every
+// request goes through the shared client with the organization header.
+func TestFineTuningJob_SendsOrganizationHeader(t *testing.T) {
+	var mu sync.Mutex
+	seen := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, r.Method+" "+r.URL.Path)
+		if got := r.Header.Get("OpenAI-Organization"); got != "org-test" {
+			t.Errorf("%s %s: OpenAI-Organization = %q, want org-test", r.Method, r.URL.Path, got)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "ftjob-1", "object": "fine_tuning.job", "model": "gpt-4o-mini-2024-07-18",
+			"training_file": "file-train", "status": "succeeded", "created_at": 1700000000,
+		})
+	}))
+	defer server.Close()
+
+	c := newTestOpenAIClient(server.URL)
+	c.OpenAIClient.OrganizationID = "org-test"
+
+	createResp := createFineTuningJobWithClient(t, c)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+
+	// Delete cancels a job that state still records as running.
+	ctx := context.Background()
+	if diags := createResp.State.SetAttribute(ctx, path.Root("status"), "running"); diags.HasError() {
+		t.Fatalf("set status: %v", diags)
+	}
+	r := &FineTuningJobResource{client: c}
+	deleteResp := &resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", deleteResp.Diagnostics)
+	}
+
+	want := []string{
+		"POST /v1/fine_tuning/jobs",
+		"GET /v1/fine_tuning/jobs/ftjob-1",
+		"POST /v1/fine_tuning/jobs/ftjob-1/cancel",
+	}
+	if strings.Join(seen, ", ") != strings.Join(want, ", ") {
+		t.Errorf("requests = %v, want %v", seen, want)
+	}
+}
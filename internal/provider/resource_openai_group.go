@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -128,8 +127,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	apiResp, err := httpClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -190,7 +188,6 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	var foundGroup *GroupResponseFramework
 	cursor := ""
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	for foundGroup == nil {
 		parsedURL, err := url.Parse(baseURL)
@@ -216,7 +213,7 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 		}
 
-		apiResp, err := httpClient.Do(apiReq)
+		apiResp, err := r.client.Do(apiReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error making request", err.Error())
 			return
@@ -312,8 +309,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	apiResp, err := httpClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -381,8 +377,7 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	apiResp, err := httpClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting group", err.Error())
 		return
@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -139,8 +138,7 @@ func (r *GroupUserResource) Create(ctx context.Context, req resource.CreateReque
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	apiResp, err := httpClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -185,7 +183,6 @@ func (r *GroupUserResource) findUserInGroup(groupID, userID string) *GroupUserRe
 		apiKey = r.client.AdminAPIKey
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 	cursor := ""
 	for {
 		parsedURL, err := url.Parse(baseURL)
@@ -209,7 +206,7 @@ func (r *GroupUserResource) findUserInGroup(groupID, userID string) *GroupUserRe
 			apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 		}
 
-		apiResp, err := httpClient.Do(apiReq)
+		apiResp, err := r.client.Do(apiReq)
 		if err != nil {
 			return nil
 		}
@@ -277,7 +274,6 @@ func (r *GroupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	var foundUser *GroupUserResponseFramework
 	cursor := ""
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	for foundUser == nil {
 		parsedURL, err := url.Parse(baseURL)
@@ -303,7 +299,7 @@ func (r *GroupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 			apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 		}
 
-		apiResp, err := httpClient.Do(apiReq)
+		apiResp, err := r.client.Do(apiReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error making request", err.Error())
 			return
@@ -408,8 +404,7 @@ func (r *GroupUserResource) Delete(ctx context.Context, req resource.DeleteReque
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	apiResp, err := httpClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting group user", err.Error())
 		return
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// imagePlan returns a plan for r with every attribute null except the
+// Optional+Computed and computed ones, which are unknown as Terraform plans
+// them, overridden by set.
+func imagePlan(t *testing.T, r resource.Resource, set map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, unknown := range []string{"id", "model", "n", "response_format", "size", "created", "data", "output_files"} {
+		vals[unknown] = tftypes.NewValue(objType.AttributeTypes[unknown], tftypes.UnknownValue)
+	}
+	for name, v := range set {
+		vals[name] = v
+	}
+	return tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+}
+
+func writeTestImage(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	p := filepath.Join(dir, name)
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestImageEditCreate_UploadsAndSavesImages(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	image := writeTestImage(t, dir, "photo.png", "photo-bytes")
+	mask := writeTestImage(t, dir, "mask.png", "mask-bytes")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/images/edits" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("parsing form: %v", err)
+		}
+		if got := r.FormValue("prompt"); got != "Add sunglasses" {
+			t.Errorf("prompt = %q", got)
+		}
+		// Options left to the API's default are unknown in the plan and
+		// must not be sent.
+		for _, unset := range []string{"model", "n", "size", "response_format"} {
+			if _, ok := r.MultipartForm.Value[unset]; ok {
+				t.Errorf("sent unset %s", unset)
+			}
+		}
+		for field, want := range map[string]string{"image": "photo-bytes", "mask": "mask-bytes"} {
+			f, _, err := r.FormFile(field)
+			if err != nil {
+				t.Fatalf("form file %s: %v", field, err)
+			}
+			got, _ := io.ReadAll(f)
+			if string(got) != want {
+				t.Errorf("%s = %q, want %q", field, got, want)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"created": 1700000000,
+			"data":    []map[string]interface{}{{"b64_json": "ZWRpdGVk"}},
+		})
+	}))
+	defer server.Close()
+
+	r := &ImageEditResource{client: newTestOpenAIClient(server.URL)}
+	outDir := filepath.Join(dir, "out")
+	plan := imagePlan(t, r, map[string]tftypes.Value{
+		"image":      tftypes.NewValue(tftypes.String, image),
+		"mask":       tftypes.NewValue(tftypes.String, mask),
+		"prompt":     tftypes.NewValue(tftypes.String, "Add sunglasses"),
+		"output_dir": tftypes.NewValue(tftypes.String, outDir),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", resp.Diagnostics)
+	}
+
+	var data ImageEditResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if !data.Model.IsNull() || !data.N.IsNull() || !data.Size.IsNull() || !data.ResponseFormat.IsNull() {
+		t.Errorf("unset options should be null after apply, got model=%s n=%s size=%s response_format=%s", data.Model, data.N, data.Size, data.ResponseFormat)
+	}
+
+	want := filepath.Join(outDir, "img-edit-1700000000-0.png")
+	if got := data.OutputFiles.String(); got != `["`+want+`"]` {
+		t.Errorf("output_files = %s, want [%s]", got, want)
+	}
+	if content, err := os.ReadFile(want); err != nil || string(content) != "edited" {
+		t.Errorf("saved image = %q, %v; want \"edited\"", content, err)
+	}
+
+	deleteResp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: resp.State}, deleteResp)
+	if _, err := os.Stat(want); !os.IsNotExist(err) {
+		t.Errorf("saved image still exists after delete: %v", err)
+	}
+}
+
+func TestImageVariationCreate_DownloadsURLImages(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	image := writeTestImage(t, dir, "logo.png", "logo-bytes")
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/images/variations":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("parsing form: %v", err)
+			}
+			if got := r.FormValue("n"); got != "2" {
+				t.Errorf("n = %q, want 2", got)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"created": 1700000000,
+				"data": []map[string]interface{}{
+					{"url": server.URL + "/files/a.png"},
+					{"url": server.URL + "/files/b.png"},
+				},
+			})
+		case "/files/a.png", "/files/b.png":
+			_, _ = w.Write([]byte(filepath.Base(r.URL.Path)))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := &ImageVariationResource{client: newTestOpenAIClient(server.URL)}
+	plan := imagePlan(t, r, map[string]tftypes.Value{
+		"image":      tftypes.NewValue(tftypes.String, image),
+		"n":          tftypes.NewValue(tftypes.Number, 2),
+		"output_dir": tftypes.NewValue(tftypes.String, dir),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", resp.Diagnostics)
+	}
+
+	for i, want := range []string{"a.png", "b.png"} {
+		p := filepath.Join(dir, fmt.Sprintf("img-var-1700000000-%d.png", i))
+		if content, err := os.ReadFile(p); err != nil || string(content) != want {
+			t.Errorf("%s = %q, %v; want %q", p, content, err, want)
+		}
+	}
+
+	// A removed file drops the resource so the variations are made again.
+	os.Remove(filepath.Join(dir, "img-var-1700000000-1.png"))
+	readResp := &resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+	if !readResp.State.Raw.IsNull() {
+		t.Error("read kept the resource although an output file is missing")
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -45,8 +50,16 @@ type ImageGenerationResourceModel struct {
 	Style          types.String `tfsdk:"style"`
 	User           types.String `tfsdk:"user"`
 
-	Created types.Int64 `tfsdk:"created"`
-	Data    types.List  `tfsdk:"data"` // List of Objects
+	Background        types.String `tfsdk:"background"`
+	Moderation        types.String `tfsdk:"moderation"`
+	OutputFormat      types.String `tfsdk:"output_format"`
+	OutputCompression types.Int64  `tfsdk:"output_compression"`
+
+	OutputDir types.String `tfsdk:"output_dir"`
+
+	Created     types.Int64 `tfsdk:"created"`
+	Data        types.List  `tfsdk:"data"` // List of Objects
+	OutputFiles types.List  `tfsdk:"output_files"`
 }
 
 func (r *ImageGenerationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -77,7 +90,7 @@ func (r *ImageGenerationResource) Schema(ctx context.Context, req resource.Schem
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
-					planmodifier.Int64(nil), // RequiresReplace? Yes ideally.
+					int64planmodifier.RequiresReplace(),
 				},
 			},
 			"quality": schema.StringAttribute{
@@ -114,6 +127,51 @@ func (r *ImageGenerationResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"background": schema.StringAttribute{
+				MarkdownDescription: "Background of the generated image: `transparent`, `opaque` or `auto`. Transparency needs `output_format` `png` or `webp`. Only supported by gpt-image models.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("transparent", "opaque", "auto"),
+					gptImageOnlyValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"moderation": schema.StringAttribute{
+				MarkdownDescription: "Content moderation level: `low` or `auto`. Only supported by gpt-image models.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("low", "auto"),
+					gptImageOnlyValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the returned image: `png`, `jpeg` or `webp`. Only supported by gpt-image models.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("png", "jpeg", "webp"),
+					gptImageOnlyValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_compression": schema.Int64Attribute{
+				MarkdownDescription: "Compression level (0-100%) for `jpeg` and `webp` output. Only supported by gpt-image models.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+					gptImageOnlyValidator{},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"output_dir": imageOutputDirAttribute(),
 			"created": schema.Int64Attribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
@@ -121,18 +179,13 @@ func (r *ImageGenerationResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"data": schema.ListAttribute{
-				Computed: true,
-				ElementType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"url":            types.StringType,
-						"b64_json":       types.StringType,
-						"revised_prompt": types.StringType,
-					},
-				},
+				Computed:    true,
+				ElementType: imageDataType(true),
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"output_files": imageOutputFilesAttribute(),
 		},
 	}
 }
@@ -176,6 +229,10 @@ func (r *ImageGenerationResource) Create(ctx context.Context, req resource.Creat
 	if !data.User.IsNull() {
 		reqStruct.User = data.User.ValueString()
 	}
+	reqStruct.Background = data.Background.ValueString()
+	reqStruct.Moderation = data.Moderation.ValueString()
+	reqStruct.OutputFormat = data.OutputFormat.ValueString()
+	reqStruct.OutputCompression = data.OutputCompression.ValueInt64Pointer()
 
 	reqBody, _ := json.Marshal(reqStruct)
 	url := fmt.Sprintf("%s/images/generations", r.client.OpenAIClient.APIURL)
@@ -191,7 +248,7 @@ func (r *ImageGenerationResource) Create(ctx context.Context, req resource.Creat
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -204,60 +261,49 @@ func (r *ImageGenerationResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	var imgResp ImageGenerationResponseFramework
 	respBodyBytes, _ := io.ReadAll(apiResp.Body)
-	if err := json.Unmarshal(respBodyBytes, &imgResp); err != nil {
+	imgResp, err := parseImageResponse(respBodyBytes)
+	if err != nil {
 		resp.Diagnostics.AddError("Error parsing response", err.Error())
 		return
 	}
 
+	data.ID = types.StringValue(fmt.Sprintf("img-%d", imgResp.Created))
 	data.Created = types.Int64Value(imgResp.Created)
+	data.Model = stringNullIfUnknown(data.Model)
+	data.N = int64NullIfUnknown(data.N)
+	data.Quality = stringNullIfUnknown(data.Quality)
+	data.ResponseFormat = stringNullIfUnknown(data.ResponseFormat)
+	data.Size = stringNullIfUnknown(data.Size)
+	data.Style = stringNullIfUnknown(data.Style)
 
-	if len(imgResp.Data) > 0 {
-		objs := []attr.Value{}
-		for _, d := range imgResp.Data {
-			obj, _ := types.ObjectValue(
-				map[string]attr.Type{
-					"url":            types.StringType,
-					"b64_json":       types.StringType,
-					"revised_prompt": types.StringType,
-				},
-				map[string]attr.Value{
-					"url":            types.StringValue(d.URL),
-					"b64_json":       types.StringValue(d.B64JSON),
-					"revised_prompt": types.StringValue(d.RevisedPrompt),
-				},
-			)
-			objs = append(objs, obj)
-		}
-		listVal, _ := types.ListValue(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"url":            types.StringType,
-				"b64_json":       types.StringType,
-				"revised_prompt": types.StringType,
-			},
-		}, objs)
-		data.Data = listVal
-	} else {
-		data.Data = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-			"url":            types.StringType,
-			"b64_json":       types.StringType,
-			"revised_prompt": types.StringType,
-		}})
+	ext := "png"
+	if v := data.OutputFormat.ValueString(); v != "" {
+		ext = v
+	}
+	var d diag.Diagnostics
+	data.Data, d = imageDataList(imgResp.Data, true)
+	resp.Diagnostics.Append(d...)
+	data.OutputFiles, d = imageOutputFiles(ctx, r.client.HTTPClient, data.OutputDir, data.ID.ValueString(), ext, imgResp.Data)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	data.ID = types.StringValue(fmt.Sprintf("img-%d", imgResp.Created))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ImageGenerationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No-op read
 	var data ImageGenerationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if !imageOutputFilesExist(ctx, data.OutputFiles) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -265,8 +311,57 @@ func (r *ImageGenerationResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *ImageGenerationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ImageGenerationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removeImageFiles(ctx, data.OutputFiles)
 }
 
 func (r *ImageGenerationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// gptImageOnlyValidator rejects an attribute that only gpt-image models
+// support when `model` is set to anything else, or left unset, in which case
+// the API uses dall-e-2.
+type gptImageOnlyValidator struct{}
+
+func (v gptImageOnlyValidator) Description(ctx context.Context) string {
+	return "only supported when model is a gpt-image model such as gpt-image-1"
+}
+
+func (v gptImageOnlyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gptImageOnlyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+	v.validate(ctx, req.Config, req.Path, &resp.Diagnostics)
+}
+
+func (v gptImageOnlyValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+	v.validate(ctx, req.Config, req.Path, &resp.Diagnostics)
+}
+
+func (v gptImageOnlyValidator) validate(ctx context.Context, config tfsdk.Config, p path.Path, diags *diag.Diagnostics) {
+	var model types.String
+	if d := config.GetAttribute(ctx, path.Root("model"), &model); d.HasError() || model.IsUnknown() {
+		return
+	}
+	if strings.HasPrefix(model.ValueString(), "gpt-image-") {
+		return
+	}
+	current := "dall-e-2 (the default)"
+	if !model.IsNull() {
+		current = model.ValueString()
+	}
+	diags.AddAttributeError(p, "Unsupported image parameter",
+		fmt.Sprintf("%s is only supported by gpt-image models such as gpt-image-1, but model is %s.", p, current))
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// imageGenerationValues returns the resource's object type and attribute
+// values for prompt and model, with every other attribute null and the
+// computed ones unknown.
+func imageGenerationValues(t *testing.T, model string) (resource.SchemaResponse, tftypes.Object, map[string]tftypes.Value) {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ImageGenerationResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "created", "data"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["prompt"] = tftypes.NewValue(tftypes.String, "A red fox logo")
+	if model != "" {
+		vals["model"] = tftypes.NewValue(tftypes.String, model)
+	}
+	return schemaResp, objType, vals
+}
+
+func TestImageGenerationCreate_GPTImageParameters(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/images/generations" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"created": 1700000000,
+			"data":    []map[string]interface{}{{"b64_json": "aW1n"}},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	schemaResp, objType, vals := imageGenerationValues(t, "gpt-image-1")
+	vals["background"] = tftypes.NewValue(tftypes.String, "transparent")
+	vals["moderation"] = tftypes.NewValue(tftypes.String, "low")
+	vals["output_format"] = tftypes.NewValue(tftypes.String, "webp")
+	vals["output_compression"] = tftypes.NewValue(tftypes.Number, 80)
+
+	r := &ImageGenerationResource{client: newTestOpenAIClient(server.URL)}
+	sch := schemaResp.Schema
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	want := map[string]interface{}{
+		"model":              "gpt-image-1",
+		"background":         "transparent",
+		"moderation":         "low",
+		"output_format":      "webp",
+		"output_compression": float64(80),
+	}
+	for k, v := range want {
+		if sent[k] != v {
+			t.Errorf("request %s = %v, want %v", k, sent[k], v)
+		}
+	}
+}
+
+func TestImageGenerationValidate_GPTImageParametersRejectedForDallE(t *testing.T) {
+	ctx := context.Background()
+
+	for _, model := range []string{"dall-e-3", ""} {
+		schemaResp, objType, vals := imageGenerationValues(t, model)
+		vals["output_format"] = tftypes.NewValue(tftypes.String, "jpeg")
+		vals["output_compression"] = tftypes.NewValue(tftypes.Number, 50)
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)}
+
+		strResp := &validator.StringResponse{}
+		gptImageOnlyValidator{}.ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("output_format"),
+			ConfigValue: types.StringValue("jpeg"),
+			Config:      config,
+		}, strResp)
+		if !strResp.Diagnostics.HasError() || !strings.Contains(strResp.Diagnostics.Errors()[0].Detail(), "gpt-image") {
+			t.Errorf("model %q: output_format diagnostics = %v, want a gpt-image-only error", model, strResp.Diagnostics)
+		}
+
+		intResp := &validator.Int64Response{}
+		gptImageOnlyValidator{}.ValidateInt64(ctx, validator.Int64Request{
+			Path:        path.Root("output_compression"),
+			ConfigValue: types.Int64Value(50),
+			Config:      config,
+		}, intResp)
+		if !intResp.Diagnostics.HasError() {
+			t.Errorf("model %q: output_compression accepted, want a gpt-image-only error", model)
+		}
+	}
+
+	schemaResp, objType, vals := imageGenerationValues(t, "gpt-image-1")
+	vals["background"] = tftypes.NewValue(tftypes.String, "opaque")
+	resp := &validator.StringResponse{}
+	gptImageOnlyValidator{}.ValidateString(ctx, validator.StringRequest{
+		Path:        path.Root("background"),
+		ConfigValue: types.StringValue("opaque"),
+		Config:      tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("gpt-image-1: background rejected: %v", resp.Diagnostics)
+	}
+}
@@ -1,17 +1,10 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/http"
-	"os"
-	"path/filepath"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -45,9 +38,11 @@ type ImageVariationResourceModel struct {
 	ResponseFormat types.String `tfsdk:"response_format"`
 	Size           types.String `tfsdk:"size"`
 	User           types.String `tfsdk:"user"`
+	OutputDir      types.String `tfsdk:"output_dir"`
 
-	Created types.Int64 `tfsdk:"created"`
-	Data    types.List  `tfsdk:"data"` // List of Objects
+	Created     types.Int64 `tfsdk:"created"`
+	Data        types.List  `tfsdk:"data"` // List of Objects
+	OutputFiles types.List  `tfsdk:"output_files"`
 }
 
 func (r *ImageVariationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -62,7 +57,8 @@ func (r *ImageVariationResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"image": schema.StringAttribute{
-				Required: true,
+				Required:            true,
+				MarkdownDescription: "Path of the local image to create variations of.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -78,7 +74,7 @@ func (r *ImageVariationResource) Schema(ctx context.Context, req resource.Schema
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
-					planmodifier.Int64(nil), // Should act as ForceNew
+					int64planmodifier.RequiresReplace(),
 				},
 			},
 			"response_format": schema.StringAttribute{
@@ -101,6 +97,7 @@ func (r *ImageVariationResource) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"output_dir": imageOutputDirAttribute(),
 			"created": schema.Int64Attribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
@@ -108,17 +105,13 @@ func (r *ImageVariationResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"data": schema.ListAttribute{
-				Computed: true,
-				ElementType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"url":      types.StringType,
-						"b64_json": types.StringType,
-					},
-				},
+				Computed:    true,
+				ElementType: imageDataType(false),
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"output_files": imageOutputFilesAttribute(),
 		},
 	}
 }
@@ -137,109 +130,52 @@ func (r *ImageVariationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Prepare multipart request
-	var requestBody bytes.Buffer
-	writer := multipart.NewWriter(&requestBody)
-
-	if !data.Model.IsNull() {
-		writer.WriteField("model", data.Model.ValueString())
+	fields := map[string]string{}
+	if v := data.Model.ValueString(); v != "" {
+		fields["model"] = v
 	}
-	if !data.N.IsNull() {
-		writer.WriteField("n", fmt.Sprintf("%d", data.N.ValueInt64()))
+	if !data.N.IsNull() && !data.N.IsUnknown() {
+		fields["n"] = fmt.Sprintf("%d", data.N.ValueInt64())
 	}
-	if !data.Size.IsNull() {
-		writer.WriteField("size", data.Size.ValueString())
+	if v := data.Size.ValueString(); v != "" {
+		fields["size"] = v
 	}
-	if !data.ResponseFormat.IsNull() {
-		writer.WriteField("response_format", data.ResponseFormat.ValueString())
+	if v := data.ResponseFormat.ValueString(); v != "" {
+		fields["response_format"] = v
 	}
-	if !data.User.IsNull() {
-		writer.WriteField("user", data.User.ValueString())
-	}
-
-	// Image
-	file, err := os.Open(data.Image.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error opening image", err.Error())
-		return
+	if v := data.User.ValueString(); v != "" {
+		fields["user"] = v
 	}
-	defer file.Close()
+	files := map[string]string{"image": data.Image.ValueString()}
 
-	part, err := writer.CreateFormFile("image", filepath.Base(data.Image.ValueString()))
+	respBody, err := r.client.PostMultipart(ctx, "images/variations", fields, files)
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding image to form", err.Error())
+		resp.Diagnostics.AddError("Error creating image variation", err.Error())
 		return
 	}
-	io.Copy(part, file)
-
-	writer.Close()
 
-	url := fmt.Sprintf("%s/images/variations", r.client.OpenAIClient.APIURL)
-
-	apiReq, err := http.NewRequest("POST", url, &requestBody)
+	imgResp, err := parseImageResponse(respBody)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	apiReq.Header.Set("Content-Type", writer.FormDataContentType())
-	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	if r.client.OpenAIClient.OrganizationID != "" {
-		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
-	}
-
-	apiResp, err := http.DefaultClient.Do(apiReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
-	}
-	defer apiResp.Body.Close()
-
-	if apiResp.StatusCode != http.StatusOK {
-		respBodyBytes, _ := io.ReadAll(apiResp.Body)
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes)))
-		return
-	}
-
-	var imgResp ImageVariationResponseFramework
-	respBodyBytes, _ := io.ReadAll(apiResp.Body)
-	if err := json.Unmarshal(respBodyBytes, &imgResp); err != nil {
 		resp.Diagnostics.AddError("Error parsing response", err.Error())
 		return
 	}
 
+	data.ID = types.StringValue(fmt.Sprintf("img-var-%d", imgResp.Created))
 	data.Created = types.Int64Value(imgResp.Created)
-
-	if len(imgResp.Data) > 0 {
-		objs := []attr.Value{}
-		for _, d := range imgResp.Data {
-			obj, _ := types.ObjectValue(
-				map[string]attr.Type{
-					"url":      types.StringType,
-					"b64_json": types.StringType,
-				},
-				map[string]attr.Value{
-					"url":      types.StringValue(d.URL),
-					"b64_json": types.StringValue(d.B64JSON),
-				},
-			)
-			objs = append(objs, obj)
-		}
-		listVal, _ := types.ListValue(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"url":      types.StringType,
-				"b64_json": types.StringType,
-			},
-		}, objs)
-		data.Data = listVal
-	} else {
-		data.Data = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-			"url":      types.StringType,
-			"b64_json": types.StringType,
-		}})
+	data.Model = stringNullIfUnknown(data.Model)
+	data.N = int64NullIfUnknown(data.N)
+	data.ResponseFormat = stringNullIfUnknown(data.ResponseFormat)
+	data.Size = stringNullIfUnknown(data.Size)
+
+	var d diag.Diagnostics
+	data.Data, d = imageDataList(imgResp.Data, false)
+	resp.Diagnostics.Append(d...)
+	data.OutputFiles, d = imageOutputFiles(ctx, r.client.HTTPClient, data.OutputDir, data.ID.ValueString(), "png", imgResp.Data)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	data.ID = types.StringValue(fmt.Sprintf("img-var-%d", imgResp.Created))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -249,6 +185,11 @@ func (r *ImageVariationResource) Read(ctx context.Context, req resource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if !imageOutputFilesExist(ctx, data.OutputFiles) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -256,6 +197,12 @@ func (r *ImageVariationResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *ImageVariationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ImageVariationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removeImageFiles(ctx, data.OutputFiles)
 }
 
 func (r *ImageVariationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
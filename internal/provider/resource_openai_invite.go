@@ -7,18 +7,23 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ resource.Resource = &InviteResource{}
 var _ resource.ResourceWithImportState = &InviteResource{}
+var _ resource.ResourceWithModifyPlan = &InviteResource{}
 
 type InviteResource struct {
 	client *OpenAIClient
@@ -41,6 +46,9 @@ type InviteResourceModel struct {
 	Status    types.String         `tfsdk:"status"`
 	CreatedAt types.Int64          `tfsdk:"created_at"`
 	ExpiresAt types.Int64          `tfsdk:"expires_at"`
+
+	AutoAssignDefaultProject types.Bool   `tfsdk:"auto_assign_default_project"`
+	DefaultProjectID         types.String `tfsdk:"default_project_id"`
 }
 
 type InviteProjectModel struct {
@@ -69,9 +77,9 @@ func (r *InviteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"role": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The role to assign to the user (owner or reader).",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				MarkdownDescription: "The role to assign to the user (owner or reader). Changing it on a pending invite replaces the invite, which sends a new email.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationRoles...),
 				},
 			},
 			"expires_at": schema.Int64Attribute{
@@ -90,11 +98,24 @@ func (r *InviteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "When the invitation was created.",
 			},
+			"auto_assign_default_project": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Also invite the user to the organization's default project, as a `member`, unless it is already listed in `projects`. Requires an admin key to look the default project up.",
+			},
+			"default_project_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The default project added by `auto_assign_default_project`. Null when nothing was added.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 
 		Blocks: map[string]schema.Block{
 			"projects": schema.ListNestedBlock{
-				Description: "The projects to invite the user to.",
+				Description: "The projects to invite the user to. Changing them on a pending invite replaces the invite, which sends a new email.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -104,12 +125,12 @@ func (r *InviteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"role": schema.StringAttribute{
 							Required:    true,
 							Description: "The role to assign to the user within the project (owner or member)",
+							Validators: []validator.String{
+								stringvalidator.OneOf(projectRoles...),
+							},
 						},
 					},
 				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
 			},
 		},
 	}
@@ -150,6 +171,19 @@ func (r *InviteResource) Create(ctx context.Context, req resource.CreateRequest,
 		createRequest.Projects = projects
 	}
 
+	data.DefaultProjectID = types.StringNull()
+	if data.AutoAssignDefaultProject.ValueBool() {
+		defaultID, err := r.defaultProjectToAssign(data.Projects)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up the default project", err.Error())
+			return
+		}
+		if defaultID != "" {
+			createRequest.Projects = append(createRequest.Projects, InviteProject{ID: defaultID, Role: "member"})
+			data.DefaultProjectID = types.StringValue(defaultID)
+		}
+	}
+
 	reqBody, err := json.Marshal(createRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error serializing request", err.Error())
@@ -175,7 +209,7 @@ func (r *InviteResource) Create(ctx context.Context, req resource.CreateRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -227,7 +261,7 @@ func (r *InviteResource) Read(ctx context.Context, req resource.ReadRequest, res
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -260,19 +294,179 @@ func (r *InviteResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if len(inviteResp.Projects) > 0 {
 		projects := []InviteProjectModel{}
 		for _, p := range inviteResp.Projects {
+			// The auto-assigned default project is not part of the
+			// configuration's projects, so keep it out of them.
+			if p.ID == data.DefaultProjectID.ValueString() {
+				continue
+			}
 			projects = append(projects, InviteProjectModel{
 				ID:   types.StringValue(p.ID),
 				Role: types.StringValue(p.Role),
 			})
 		}
-		data.Projects = projects
+		data.Projects = orderInviteProjectsLike(data.Projects, projects)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan marks the invite's identity as unknown when role or projects
+// change on a pending invite, since Update replaces it with a new one.
+func (r *InviteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state InviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !inviteChanged(plan, state) || state.Status.ValueString() == "accepted" {
+		return
+	}
+
+	plan.ID = types.StringUnknown()
+	plan.DefaultProjectID = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Update handles role and project changes. The API cannot edit invites, so a
+// pending invite is deleted and re-sent with the new assignments. An accepted
+// invite no longer controls the user's access and is left alone.
 func (r *InviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Immutable
+	var plan, state InviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.InviteID = state.InviteID
+	plan.Status = state.Status
+	plan.CreatedAt = state.CreatedAt
+	plan.ExpiresAt = state.ExpiresAt
+	plan.DefaultProjectID = state.DefaultProjectID
+
+	if !inviteChanged(plan, state) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	if state.Status.ValueString() == "accepted" {
+		resp.Diagnostics.AddWarning(
+			"Invite already accepted",
+			fmt.Sprintf("Invite %s for %s has been accepted, so changing its role or projects has no effect. Manage the user's access with openai_organization_user and openai_project_user instead.", state.ID.ValueString(), state.Email.ValueString()),
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	adminClient, err := GetOpenAIClientWithAdminKey(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
+	}
+
+	projects := make([]client.InviteProject, 0, len(plan.Projects)+1)
+	for _, p := range plan.Projects {
+		projects = append(projects, client.InviteProject{ID: p.ID.ValueString(), Role: p.Role.ValueString()})
+	}
+	plan.DefaultProjectID = types.StringNull()
+	if plan.AutoAssignDefaultProject.ValueBool() {
+		defaultID, err := r.defaultProjectToAssign(plan.Projects)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up the default project", err.Error())
+			return
+		}
+		if defaultID != "" {
+			projects = append(projects, client.InviteProject{ID: defaultID, Role: "member"})
+			plan.DefaultProjectID = types.StringValue(defaultID)
+		}
+	}
+
+	// A pending invite blocks a second one for the same email, so the old
+	// invite has to go first.
+	if err := adminClient.DeleteInvite(state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error replacing invite", fmt.Sprintf("Could not delete invite %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	inviteResp, err := adminClient.CreateInvite(plan.Email.ValueString(), plan.Role.ValueString(), projects)
+	if err != nil {
+		resp.Diagnostics.AddError("Error replacing invite", fmt.Sprintf("Invite %s was deleted but its replacement could not be created: %s", state.ID.ValueString(), err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	plan.ID = types.StringValue(inviteResp.ID)
+	plan.InviteID = types.StringValue(inviteResp.ID)
+	plan.Status = types.StringValue(inviteResp.Status)
+	plan.CreatedAt = types.Int64Value(inviteResp.CreatedAt)
+	plan.ExpiresAt = types.Int64Value(inviteResp.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// defaultProjectToAssign returns the ID of the organization's default
+// project, or "" when projects already lists it.
+func (r *InviteResource) defaultProjectToAssign(projects []InviteProjectModel) (string, error) {
+	adminClient, err := GetOpenAIClientWithAdminKey(r.client)
+	if err != nil {
+		return "", err
+	}
+	all, err := adminClient.ListAllProjects(false)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range all {
+		if p.IsDefault == nil || !*p.IsDefault {
+			continue
+		}
+		for _, listed := range projects {
+			if listed.ID.ValueString() == p.ID {
+				return "", nil
+			}
+		}
+		return p.ID, nil
+	}
+	return "", fmt.Errorf("auto_assign_default_project is set but the organization has no active default project")
+}
+
+// inviteChanged reports whether the updatable invite arguments differ. The
+// order of projects does not matter.
+func inviteChanged(plan, state InviteResourceModel) bool {
+	if !plan.Role.Equal(state.Role) || plan.AutoAssignDefaultProject.ValueBool() != state.AutoAssignDefaultProject.ValueBool() || len(plan.Projects) != len(state.Projects) {
+		return true
+	}
+	roles := make(map[string]types.String, len(state.Projects))
+	for _, p := range state.Projects {
+		roles[p.ID.ValueString()] = p.Role
+	}
+	for _, p := range plan.Projects {
+		role, ok := roles[p.ID.ValueString()]
+		if !ok || !role.Equal(p.Role) {
+			return true
+		}
+	}
+	return false
+}
+
+// orderInviteProjectsLike returns projects in the order of prior, so an API
+// that lists them differently from the configuration causes no diff.
+// Projects not in prior follow in the order the API returned them.
+func orderInviteProjectsLike(prior, projects []InviteProjectModel) []InviteProjectModel {
+	position := make(map[string]int, len(prior))
+	for i, p := range prior {
+		position[p.ID.ValueString()] = i
+	}
+	rank := func(p InviteProjectModel) int {
+		if i, ok := position[p.ID.ValueString()]; ok {
+			return i
+		}
+		return len(prior)
+	}
+	sort.SliceStable(projects, func(i, j int) bool { return rank(projects[i]) < rank(projects[j]) })
+	return projects
 }
 
 func (r *InviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -297,7 +491,7 @@ func (r *InviteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	r.client.Do(apiReq)
 }
 
 func (r *InviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccResourceOpenAIInvite_RoleChange changes the role of a pending invite
+// and checks that it is replaced in place: a new invite ID lands in state and
+// the previous invite is deleted.
+//
+// Set TF_ACC=1 to run.
+func TestAccResourceOpenAIInvite_RoleChange(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := newMockInviteServer()
+	defer srv.Close()
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOpenAIInviteRole(srv.URL, "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_invite.test", "id", "invite_1"),
+					resource.TestCheckResourceAttr("openai_invite.test", "role", "reader"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["openai_invite.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceOpenAIInviteRole(srv.URL, "owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_invite.test", "id", "invite_2"),
+					resource.TestCheckResourceAttr("openai_invite.test", "invite_id", "invite_2"),
+					resource.TestCheckResourceAttr("openai_invite.test", "role", "owner"),
+					func(s *terraform.State) error {
+						if srv.exists(firstID) {
+							return fmt.Errorf("old invite %s was not deleted after the role change", firstID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceOpenAIInviteRole(apiURL, role string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+resource "openai_invite" "test" {
+  email = "invitee@example.com"
+  role  = %q
+}
+`, apiURL, role)
+}
+
+func TestInviteCreate_AutoAssignDefaultProject(t *testing.T) {
+	srv := newMockInviteServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &InviteResource{client: newTestOpenAIClient(srv.URL)}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	projectType := objType.AttributeTypes["projects"].(tftypes.List).ElementType
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	vals["email"] = tftypes.NewValue(tftypes.String, "invitee@example.com")
+	vals["role"] = tftypes.NewValue(tftypes.String, "reader")
+	vals["auto_assign_default_project"] = tftypes.NewValue(tftypes.Bool, true)
+	vals["projects"] = tftypes.NewValue(objType.AttributeTypes["projects"], []tftypes.Value{
+		tftypes.NewValue(projectType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "proj_team"),
+			"role": tftypes.NewValue(tftypes.String, "owner"),
+		}),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	sent := srv.invites["invite_1"]["projects"]
+	want := []interface{}{
+		map[string]interface{}{"id": "proj_team", "role": "owner"},
+		map[string]interface{}{"id": "proj_default", "role": "member"},
+	}
+	if fmt.Sprint(sent) != fmt.Sprint(want) {
+		t.Errorf("invite projects = %v, want %v", sent, want)
+	}
+
+	var data InviteResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.DefaultProjectID.ValueString() != "proj_default" {
+		t.Errorf("default_project_id = %s, want proj_default", data.DefaultProjectID)
+	}
+
+	// Read must not report the auto-assigned project as drift from the
+	// configured projects.
+	readResp := &fwresource.ReadResponse{State: resp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: resp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if len(data.Projects) != 1 || data.Projects[0].ID.ValueString() != "proj_team" {
+		t.Errorf("projects after read = %v, want only proj_team", data.Projects)
+	}
+}
+
+func TestInviteProjects_StableAcrossAPIOrdering(t *testing.T) {
+	srv := newMockInviteServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &InviteResource{client: newTestOpenAIClient(srv.URL)}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	projectType := objType.AttributeTypes["projects"].(tftypes.List).ElementType
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	vals["email"] = tftypes.NewValue(tftypes.String, "invitee@example.com")
+	vals["role"] = tftypes.NewValue(tftypes.String, "reader")
+	vals["auto_assign_default_project"] = tftypes.NewValue(tftypes.Bool, false)
+	vals["projects"] = tftypes.NewValue(objType.AttributeTypes["projects"], []tftypes.Value{
+		tftypes.NewValue(projectType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "proj_team"),
+			"role": tftypes.NewValue(tftypes.String, "owner"),
+		}),
+		tftypes.NewValue(projectType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "proj_default"),
+			"role": tftypes.NewValue(tftypes.String, "member"),
+		}),
+	})
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+	sent := srv.invites["invite_1"]["projects"]
+	want := []interface{}{
+		map[string]interface{}{"id": "proj_team", "role": "owner"},
+		map[string]interface{}{"id": "proj_default", "role": "member"},
+	}
+	if fmt.Sprint(sent) != fmt.Sprint(want) {
+		t.Errorf("invite projects = %v, want %v", sent, want)
+	}
+
+	// The API lists the projects in the opposite order.
+	srv.invites["invite_1"]["projects"] = []interface{}{want[1], want[0]}
+
+	readResp := &fwresource.ReadResponse{State: resp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: resp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+
+	var planned, state InviteResourceModel
+	readResp.Diagnostics.Append(plan.Get(ctx, &planned)...)
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if len(state.Projects) != 2 || state.Projects[0].ID.ValueString() != "proj_team" || state.Projects[1].ID.ValueString() != "proj_default" {
+		t.Errorf("projects after read = %v, want proj_team then proj_default", state.Projects)
+	}
+	if inviteChanged(planned, state) {
+		t.Error("invite reported as changed after reading back the same projects")
+	}
+
+	// Reordering the configuration does not replace the invite either.
+	planned.Projects[0], planned.Projects[1] = planned.Projects[1], planned.Projects[0]
+	if inviteChanged(planned, state) {
+		t.Error("invite reported as changed after reordering projects")
+	}
+	planned.Projects[0].Role = state.Projects[0].Role
+	if !inviteChanged(planned, state) {
+		t.Error("changing a project role was not reported as a change")
+	}
+}
+
+// mockInviteServer fakes the organization invite endpoints, issuing
+// sequential IDs and rejecting a second pending invite for the same email.
+// It also lists two projects, proj_default being the default.
+type mockInviteServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	next    int
+	invites map[string]map[string]interface{}
+}
+
+func newMockInviteServer() *mockInviteServer {
+	srv := &mockInviteServer{invites: map[string]map[string]interface{}{}}
+	srv.Server = httptest.NewServer(http.HandlerFunc(srv.handle))
+	return srv
+}
+
+func (s *mockInviteServer) exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.invites[id]
+	return ok
+}
+
+func (s *mockInviteServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	const base = "/v1/organization/invites"
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, base), "/")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/organization/projects":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"object": "list",
+			"data": []map[string]interface{}{
+				{"object": "organization.project", "id": "proj_team", "name": "Team", "status": "active"},
+				{"object": "organization.project", "id": "proj_default", "name": "Default project", "status": "active", "is_default": true},
+			},
+		})
+	case r.Method == http.MethodPost && id == "":
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, inv := range s.invites {
+			if inv["email"] == body["email"] {
+				writeJSON(w, http.StatusConflict, map[string]interface{}{"error": map[string]interface{}{"message": "invite already pending"}})
+				return
+			}
+		}
+		s.next++
+		invite := map[string]interface{}{
+			"object":     "organization.invite",
+			"id":         fmt.Sprintf("invite_%d", s.next),
+			"email":      body["email"],
+			"role":       body["role"],
+			"projects":   body["projects"],
+			"status":     "pending",
+			"created_at": 1700000000 + s.next,
+			"expires_at": 1700600000 + s.next,
+		}
+		s.invites[invite["id"].(string)] = invite
+		writeJSON(w, http.StatusOK, invite)
+	case r.Method == http.MethodGet && s.invites[id] != nil:
+		writeJSON(w, http.StatusOK, s.invites[id])
+	case r.Method == http.MethodDelete && s.invites[id] != nil:
+		delete(s.invites, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": id, "object": "organization.invite.deleted", "deleted": true})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "not found"}})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -135,8 +134,7 @@ func (r *OrganizationRoleResource) Create(ctx context.Context, req resource.Crea
 	httpReq.Header.Set("Content-Type", "application/json")
 	setAdminAuthHeaders(r.client, httpReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	httpResp, err := httpClient.Do(httpReq)
+	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating organization role", err.Error())
 		return
@@ -185,7 +183,6 @@ func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRe
 
 	roleID := data.ID.ValueString()
 	rolesURL := adminBaseURL(r.client) + "/v1/organization/roles"
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	var foundRole *RoleResponseFramework
 	cursor := ""
@@ -210,7 +207,7 @@ func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRe
 		}
 		setAdminAuthHeaders(r.client, apiReq)
 
-		apiResp, err := httpClient.Do(apiReq)
+		apiResp, err := r.client.Do(apiReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error listing organization roles", err.Error())
 			return
@@ -325,8 +322,7 @@ func (r *OrganizationRoleResource) Update(ctx context.Context, req resource.Upda
 	httpReq.Header.Set("Content-Type", "application/json")
 	setAdminAuthHeaders(r.client, httpReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	httpResp, err := httpClient.Do(httpReq)
+	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating organization role", err.Error())
 		return
@@ -383,8 +379,7 @@ func (r *OrganizationRoleResource) Delete(ctx context.Context, req resource.Dele
 	}
 	setAdminAuthHeaders(r.client, deleteReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	deleteResp, err := httpClient.Do(deleteReq)
+	deleteResp, err := r.client.Do(deleteReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting organization role", err.Error())
 		return
@@ -8,11 +8,13 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -63,6 +65,9 @@ func (r *OrganizationUserResource) Schema(ctx context.Context, req resource.Sche
 			"role": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The role of the user in the organization (owner or reader).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationRoles...),
+				},
 			},
 			"email": schema.StringAttribute{
 				Computed:            true,
@@ -124,7 +129,7 @@ func (r *OrganizationUserResource) Create(ctx context.Context, req resource.Crea
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -158,7 +163,7 @@ func (r *OrganizationUserResource) Create(ctx context.Context, req resource.Crea
 			apiUpdateReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 		}
 
-		upResp, err := http.DefaultClient.Do(apiUpdateReq)
+		upResp, err := r.client.Do(apiUpdateReq)
 		if err != nil || upResp.StatusCode != http.StatusOK {
 			resp.Diagnostics.AddError("Error updating role", "Failed to update user role")
 			return
@@ -196,7 +201,7 @@ func (r *OrganizationUserResource) Read(ctx context.Context, req resource.ReadRe
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -245,7 +250,7 @@ func (r *OrganizationUserResource) Update(ctx context.Context, req resource.Upda
 		apiUpdateReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	upResp, err := http.DefaultClient.Do(apiUpdateReq)
+	upResp, err := r.client.Do(apiUpdateReq)
 	if err != nil || upResp.StatusCode != http.StatusOK {
 		resp.Diagnostics.AddError("Error updating role", "Failed to update user role")
 		return
@@ -276,7 +281,7 @@ func (r *OrganizationUserResource) Delete(ctx context.Context, req resource.Dele
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	r.client.Do(apiReq)
 }
 
 func (r *OrganizationUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
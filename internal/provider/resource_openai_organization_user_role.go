@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -107,8 +106,7 @@ func (r *OrganizationUserRoleResource) Create(ctx context.Context, req resource.
 	httpReq.Header.Set("Content-Type", "application/json")
 	setAdminAuthHeaders(r.client, httpReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	httpResp, err := httpClient.Do(httpReq)
+	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error assigning role to user", err.Error())
 		return
@@ -142,7 +140,6 @@ func (r *OrganizationUserRoleResource) Read(ctx context.Context, req resource.Re
 	roleID := idParts[1]
 
 	rolesURL := adminBaseURL(r.client) + "/v1/organization/users/" + userID + "/roles"
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	found := false
 	cursor := ""
@@ -167,7 +164,7 @@ func (r *OrganizationUserRoleResource) Read(ctx context.Context, req resource.Re
 		}
 		setAdminAuthHeaders(r.client, apiReq)
 
-		apiResp, err := httpClient.Do(apiReq)
+		apiResp, err := r.client.Do(apiReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error listing user roles", err.Error())
 			return
@@ -246,8 +243,7 @@ func (r *OrganizationUserRoleResource) Delete(ctx context.Context, req resource.
 	}
 	setAdminAuthHeaders(r.client, deleteReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	deleteResp, err := httpClient.Do(deleteReq)
+	deleteResp, err := r.client.Do(deleteReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error removing role from user", err.Error())
 		return
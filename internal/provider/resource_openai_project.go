@@ -36,6 +36,21 @@ type ProjectResourceModel struct {
 	Status     types.String `tfsdk:"status"`
 	CreatedAt  types.String `tfsdk:"created_at"`
 	ArchivedAt types.String `tfsdk:"archived_at"`
+
+	AdoptExisting     types.Bool                     `tfsdk:"adopt_existing"`
+	DefaultRateLimits []ProjectDefaultRateLimitModel `tfsdk:"default_rate_limits"`
+}
+
+// ProjectDefaultRateLimitModel is one entry of a project's
+// default_rate_limits template.
+type ProjectDefaultRateLimitModel struct {
+	Model                       types.String `tfsdk:"model"`
+	MaxRequestsPerMinute        types.Int64  `tfsdk:"max_requests_per_minute"`
+	MaxTokensPerMinute          types.Int64  `tfsdk:"max_tokens_per_minute"`
+	MaxImagesPerMinute          types.Int64  `tfsdk:"max_images_per_minute"`
+	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
+	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
+	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
 }
 
 func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -66,6 +81,46 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the project was archived.",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: adoptExistingDescription("project") + " Archived projects are never adopted.",
+			},
+			"default_rate_limits": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "A template of per-model rate limits applied when the project is created. Later changes are recorded but not applied to the existing project; use `openai_rate_limit` to manage limits over the project's lifetime. The template is not applied to a project taken over with `adopt_existing`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"model": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The model the limits apply to.",
+						},
+						"max_requests_per_minute": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Maximum number of requests per minute.",
+						},
+						"max_tokens_per_minute": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Maximum number of tokens per minute.",
+						},
+						"max_images_per_minute": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Maximum number of images per minute.",
+						},
+						"batch_1_day_max_input_tokens": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Maximum number of input tokens per day for batch processing.",
+						},
+						"max_audio_megabytes_per_1_minute": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Maximum audio megabytes per minute.",
+						},
+						"max_requests_per_1_day": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Maximum number of requests per day.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -97,10 +152,31 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	project, err := r.client.CreateProject(data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating project", err.Error())
-		return
+	var project *client.Project
+	if data.AdoptExisting.ValueBool() {
+		projects, err := r.client.ListAllProjects(false)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up existing project", err.Error())
+			return
+		}
+		project, err = findByName("project", data.Name.ValueString(), projects,
+			func(p client.Project) string { return p.Name },
+			func(p client.Project) string { return p.ID },
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error adopting existing project", err.Error())
+			return
+		}
+	}
+
+	adopted := project != nil
+	if !adopted {
+		var err error
+		project, err = r.client.CreateProject(data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating project", err.Error())
+			return
+		}
 	}
 
 	data.ID = types.StringValue(project.ID)
@@ -117,7 +193,43 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		data.ArchivedAt = types.StringNull()
 	}
 
+	// Save the project before applying the template so a failed limit does
+	// not orphan it.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() || adopted || len(data.DefaultRateLimits) == 0 {
+		return
+	}
+
+	// A new project can answer 404 for a moment after the create. Wait until
+	// it is readable so the rate limit updates do not fail on it.
+	if _, err := readWithRetry(ctx, func() (*client.Project, error) { return r.client.GetProject(project.ID) }); err != nil {
+		resp.Diagnostics.AddError("Error reading created project", err.Error())
+		return
+	}
+
+	failures := newItemErrors("Error applying default rate limit")
+	for _, rl := range data.DefaultRateLimits {
+		_, err := r.client.UpdateRateLimit(project.ID, rl.Model.ValueString(),
+			intPtrFromInt64(rl.MaxRequestsPerMinute),
+			intPtrFromInt64(rl.MaxTokensPerMinute),
+			intPtrFromInt64(rl.MaxImagesPerMinute),
+			intPtrFromInt64(rl.Batch1DayMaxInputTokens),
+			intPtrFromInt64(rl.MaxAudioMegabytesPer1Minute),
+			intPtrFromInt64(rl.MaxRequestsPer1Day),
+		)
+		failures.Add(fmt.Sprintf("model %q in project %s", rl.Model.ValueString(), project.ID), err)
+	}
+	resp.Diagnostics.Append(failures.Diagnostics()...)
+}
+
+// intPtrFromInt64 converts an optional Terraform integer into the *int the
+// client expects, mapping null to nil.
+func intPtrFromInt64(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// createProjectAdopting runs the project resource's Create against apiURL
+// for a project named name with adopt_existing set.
+func createProjectAdopting(t *testing.T, apiURL, name string) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ProjectResource{client: newTestOpenAIClient(apiURL).OpenAIClient}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "status", "created_at", "archived_at"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, name)
+	vals["adopt_existing"] = tftypes.NewValue(tftypes.Bool, true)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// mockAdoptableProjects lists an active "shared" project, two active "twin"
+// projects and creates proj_new, counting creates.
+func mockAdoptableProjects(t *testing.T, creates *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/organization/projects":
+			if r.URL.Query().Get("include_archived") != "" {
+				t.Errorf("archived projects must not be considered for adoption")
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "list",
+				"data": []map[string]interface{}{
+					{"id": "proj_shared", "object": "organization.project", "name": "shared", "status": "active", "created_at": 1600000000},
+					{"id": "proj_twin1", "object": "organization.project", "name": "twin", "status": "active", "created_at": 1600000000},
+					{"id": "proj_twin2", "object": "organization.project", "name": "twin", "status": "active", "created_at": 1600000000},
+				},
+				"has_more": false,
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/organization/projects":
+			*creates++
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"id": "proj_new", "object": "organization.project", "name": "fresh", "status": "active", "created_at": 1700000000,
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestProjectCreate_AdoptsExistingByName(t *testing.T) {
+	creates := 0
+	server := mockAdoptableProjects(t, &creates)
+	defer server.Close()
+
+	resp := createProjectAdopting(t, server.URL, "shared")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if creates != 0 {
+		t.Errorf("created %d projects, want the existing one adopted", creates)
+	}
+
+	var got ProjectResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "proj_shared" {
+		t.Errorf("id = %q, want proj_shared", got.ID.ValueString())
+	}
+}
+
+func TestProjectCreate_AdoptExistingCreatesWhenMissing(t *testing.T) {
+	creates := 0
+	server := mockAdoptableProjects(t, &creates)
+	defer server.Close()
+
+	resp := createProjectAdopting(t, server.URL, "fresh")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if creates != 1 {
+		t.Errorf("created %d projects, want 1", creates)
+	}
+}
+
+func TestProjectCreate_AdoptExistingRejectsAmbiguousName(t *testing.T) {
+	creates := 0
+	server := mockAdoptableProjects(t, &creates)
+	defer server.Close()
+
+	resp := createProjectAdopting(t, server.URL, "twin")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when several projects share the name")
+	}
+	if creates != 0 || !resp.State.Raw.IsNull() {
+		t.Error("an ambiguous name must neither create nor adopt a project")
+	}
+}
@@ -121,7 +121,7 @@ func resourceOpenAIProjectAPIKeyCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Make the request
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error making request: %s", err))
 	}
@@ -242,7 +242,7 @@ func resourceOpenAIProjectAPIKeyRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Make the request
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error making request: %s", err))
 	}
@@ -345,7 +345,7 @@ func resourceOpenAIProjectAPIKeyDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Make the request
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error making request: %s", err))
 	}
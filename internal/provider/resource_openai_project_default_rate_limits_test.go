@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// createProjectWithDefaults runs the project resource's Create against apiURL
+// with the given default_rate_limits entries, each a map of attribute values.
+func createProjectWithDefaults(t *testing.T, apiURL string, defaults []map[string]interface{}) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ProjectResource{client: newTestOpenAIClient(apiURL).OpenAIClient}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	listType := objType.AttributeTypes["default_rate_limits"].(tftypes.List)
+	entryType := listType.ElementType.(tftypes.Object)
+
+	entries := []tftypes.Value{}
+	for _, d := range defaults {
+		attrs := map[string]tftypes.Value{}
+		for name, typ := range entryType.AttributeTypes {
+			attrs[name] = tftypes.NewValue(typ, d[name])
+		}
+		entries = append(entries, tftypes.NewValue(entryType, attrs))
+	}
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "governed")
+	vals["default_rate_limits"] = tftypes.NewValue(listType, entries)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// mockProjectRateLimits creates proj_new, lists a rate limit per model and
+// records the body of every rate limit update by rate limit ID. Updates to
+// rl-broken fail. The first notFoundReads reads of proj_new return 404, as
+// the API does for a moment after a create.
+func mockProjectRateLimits(t *testing.T, updates map[string]map[string]interface{}, notFoundReads int) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/organization/projects":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"id": "proj_new", "object": "organization.project", "name": "governed", "status": "active", "created_at": 1700000000,
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/organization/projects/proj_new":
+			if notFoundReads > 0 {
+				notFoundReads--
+				writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "No such project"}})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"id": "proj_new", "object": "organization.project", "name": "governed", "status": "active", "created_at": 1700000000,
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/organization/projects/proj_new/rate_limits":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "list",
+				"data": []map[string]interface{}{
+					{"object": "project.rate_limit", "id": "rl-gpt-4o", "model": "gpt-4o", "max_requests_per_1_minute": 10000, "max_tokens_per_1_minute": 2000000},
+					{"object": "project.rate_limit", "id": "rl-gpt-4o-mini", "model": "gpt-4o-mini", "max_requests_per_1_minute": 10000, "max_tokens_per_1_minute": 10000000},
+					{"object": "project.rate_limit", "id": "rl-broken", "model": "broken", "max_requests_per_1_minute": 1, "max_tokens_per_1_minute": 1},
+				},
+				"has_more": false,
+			})
+		case r.Method == http.MethodPost && len(r.URL.Path) > len("/v1/organization/projects/proj_new/rate_limits/"):
+			id := r.URL.Path[len("/v1/organization/projects/proj_new/rate_limits/"):]
+			if id == "rl-broken" {
+				writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": map[string]interface{}{"message": "invalid limit"}})
+				return
+			}
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			updates[id] = body
+			writeJSON(w, http.StatusOK, map[string]interface{}{"object": "project.rate_limit", "id": id})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestProjectCreate_AppliesDefaultRateLimits(t *testing.T) {
+	updates := map[string]map[string]interface{}{}
+	server := mockProjectRateLimits(t, updates, 0)
+	defer server.Close()
+
+	resp := createProjectWithDefaults(t, server.URL, []map[string]interface{}{
+		{"model": "gpt-4o", "max_requests_per_minute": 500, "max_tokens_per_minute": 30000},
+		{"model": "gpt-4o-mini", "max_tokens_per_minute": 100000, "max_requests_per_1_day": 5000},
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	want := map[string]map[string]interface{}{
+		"rl-gpt-4o":      {"max_requests_per_1_minute": float64(500), "max_tokens_per_1_minute": float64(30000)},
+		"rl-gpt-4o-mini": {"max_tokens_per_1_minute": float64(100000), "max_requests_per_1_day": float64(5000)},
+	}
+	if len(updates) != len(want) {
+		t.Fatalf("updated %d rate limits, want %d: %v", len(updates), len(want), updates)
+	}
+	for id, fields := range want {
+		got := updates[id]
+		if len(got) != len(fields) {
+			t.Errorf("%s: sent %v, want %v", id, got, fields)
+			continue
+		}
+		for k, v := range fields {
+			if got[k] != v {
+				t.Errorf("%s.%s = %v, want %v", id, k, got[k], v)
+			}
+		}
+	}
+
+	var got ProjectResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "proj_new" || len(got.DefaultRateLimits) != 2 {
+		t.Errorf("state not recorded: %+v", got)
+	}
+}
+
+func TestProjectCreate_DefaultRateLimitFailureKeepsProject(t *testing.T) {
+	updates := map[string]map[string]interface{}{}
+	server := mockProjectRateLimits(t, updates, 0)
+	defer server.Close()
+
+	resp := createProjectWithDefaults(t, server.URL, []map[string]interface{}{
+		{"model": "broken", "max_requests_per_minute": 5},
+		{"model": "gpt-4o", "max_requests_per_minute": 500},
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for the failed rate limit")
+	}
+	if _, ok := updates["rl-gpt-4o"]; !ok {
+		t.Error("remaining template entries should still be applied")
+	}
+
+	var got ProjectResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "proj_new" {
+		t.Error("the created project must stay in state so it is not orphaned")
+	}
+}
+
+func TestProjectCreate_WaitsForNewProjectBeforeRateLimits(t *testing.T) {
+	defer func(d time.Duration) { readRetryBackoff = d }(readRetryBackoff)
+	readRetryBackoff = time.Millisecond
+
+	updates := map[string]map[string]interface{}{}
+	server := mockProjectRateLimits(t, updates, 2)
+	defer server.Close()
+
+	resp := createProjectWithDefaults(t, server.URL, []map[string]interface{}{
+		{"model": "gpt-4o", "max_requests_per_minute": 500},
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if _, ok := updates["rl-gpt-4o"]; !ok {
+		t.Error("rate limit not applied once the project became readable")
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -136,7 +135,6 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 	projectID := data.ProjectID.ValueString()
 	groupID := data.GroupID.ValueString()
 	roleIDs := roleIDsFromSet(data.RoleIDs)
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	if len(roleIDs) == 0 {
 		resp.Diagnostics.AddError("Invalid Configuration", "At least one role_id is required in role_ids.")
@@ -154,7 +152,7 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	addURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/groups"
-	httpResp, err := doRequestWithRetry(ctx, httpClient, r.client, "POST", addURL, body)
+	httpResp, err := doRequestWithRetry(ctx, r.client, "POST", addURL, body)
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding group to project", err.Error())
 		return
@@ -191,7 +189,7 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 			}
 			parsedURL.RawQuery = q.Encode()
 
-			listResp, lerr := doRequestWithRetry(ctx, httpClient, r.client, "GET", parsedURL.String(), nil)
+			listResp, lerr := doRequestWithRetry(ctx, r.client, "GET", parsedURL.String(), nil)
 			if lerr != nil {
 				resp.Diagnostics.AddError("Error listing project groups", lerr.Error())
 				return
@@ -232,26 +230,14 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Step 2: Assign additional roles (first one was set via membership endpoint)
+	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles"
+	failures := newItemErrors("Error assigning role to group")
 	for _, roleID := range roleIDs[1:] {
-		assignBody, err := json.Marshal(RoleAssignRequest{RoleID: roleID})
-		if err != nil {
-			resp.Diagnostics.AddError("Error marshaling role assign request", err.Error())
-			return
-		}
-
-		assignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles"
-		assignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "POST", assignURL, assignBody)
-		if err != nil {
-			resp.Diagnostics.AddError("Error assigning role to group", err.Error())
-			return
-		}
-		defer assignResp.Body.Close()
-
-		if assignResp.StatusCode != http.StatusOK && assignResp.StatusCode != http.StatusCreated {
-			assignRespBody, _ := io.ReadAll(assignResp.Body)
-			resp.Diagnostics.AddError("API error assigning role to group", fmt.Sprintf("%s - %s", assignResp.Status, string(assignRespBody)))
-			return
-		}
+		failures.Add("role "+roleID, assignRole(ctx, r.client, rolesURL, roleID))
+	}
+	resp.Diagnostics.Append(failures.Diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s", projectID, groupResp.GroupID))
@@ -275,7 +261,6 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 	}
 	projectID := idParts[0]
 	groupID := idParts[1]
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Step 1: Verify the group is still in the project
 	groupsURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/groups"
@@ -296,7 +281,7 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 		}
 		parsedURL.RawQuery = q.Encode()
 
-		apiResp, err := doRequestWithRetry(ctx, httpClient, r.client, "GET", parsedURL.String(), nil)
+		apiResp, err := doRequestWithRetry(ctx, r.client, "GET", parsedURL.String(), nil)
 		if err != nil {
 			resp.Diagnostics.AddError("Error listing project groups", err.Error())
 			return
@@ -365,7 +350,7 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 		}
 		parsedURL.RawQuery = q.Encode()
 
-		rolesResp, err := doRequestWithRetry(ctx, httpClient, r.client, "GET", parsedURL.String(), nil)
+		rolesResp, err := doRequestWithRetry(ctx, r.client, "GET", parsedURL.String(), nil)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading group roles", err.Error())
 			return
@@ -421,7 +406,6 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	projectID := idParts[0]
 	groupID := idParts[1]
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	oldRoleIDs := roleIDsFromSet(state.RoleIDs)
 	newRoleIDs := roleIDsFromSet(plan.RoleIDs)
@@ -447,53 +431,30 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		newSet[id] = true
 	}
 
+	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles"
+
 	// Roles to remove (in old but not in new)
+	unassignFailures := newItemErrors("Error unassigning role")
 	for _, id := range oldRoleIDs {
 		if !newSet[id] {
-			unassignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles/" + id
-			tflog.Info(ctx, "Unassigning role", map[string]interface{}{"url": unassignURL, "role_id": id})
-			unassignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "DELETE", unassignURL, nil)
-			if err != nil {
-				resp.Diagnostics.AddError("Error unassigning role", err.Error())
-				return
-			}
-			respBody, _ := io.ReadAll(unassignResp.Body)
-			unassignResp.Body.Close()
-			tflog.Info(ctx, "Unassign response", map[string]interface{}{"status": unassignResp.StatusCode, "body": string(respBody)})
-			if unassignResp.StatusCode != http.StatusOK && unassignResp.StatusCode != http.StatusNoContent && unassignResp.StatusCode != http.StatusNotFound {
-				resp.Diagnostics.AddError("API error unassigning role", fmt.Sprintf("%s - %s", unassignResp.Status, string(respBody)))
-				return
-			}
+			unassignFailures.Add("role "+id, unassignRole(ctx, r.client, rolesURL, id))
 		}
 	}
 
 	// Roles to add (in new but not in old)
+	assignFailures := newItemErrors("Error assigning role")
 	for _, id := range newRoleIDs {
 		if !oldSet[id] {
-			assignBody, err := json.Marshal(RoleAssignRequest{RoleID: id})
-			if err != nil {
-				resp.Diagnostics.AddError("Error marshaling role assign request", err.Error())
-				return
-			}
-
-			assignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles"
-			tflog.Info(ctx, "Assigning role", map[string]interface{}{"url": assignURL, "role_id": id})
-			assignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "POST", assignURL, assignBody)
-			if err != nil {
-				resp.Diagnostics.AddError("Error assigning role", err.Error())
-				return
-			}
-			assignRespBody, _ := io.ReadAll(assignResp.Body)
-			assignResp.Body.Close()
-			tflog.Info(ctx, "Assign response", map[string]interface{}{"status": assignResp.StatusCode, "body": string(assignRespBody)})
-
-			if assignResp.StatusCode != http.StatusOK && assignResp.StatusCode != http.StatusCreated {
-				resp.Diagnostics.AddError("API error assigning role", fmt.Sprintf("%s - %s", assignResp.Status, string(assignRespBody)))
-				return
-			}
+			assignFailures.Add("role "+id, assignRole(ctx, r.client, rolesURL, id))
 		}
 	}
 
+	resp.Diagnostics.Append(unassignFailures.Diagnostics()...)
+	resp.Diagnostics.Append(assignFailures.Diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.ID = state.ID
 	plan.GroupName = state.GroupName
 	plan.CreatedAt = state.CreatedAt
@@ -515,27 +476,21 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 	projectID := idParts[0]
 	groupID := idParts[1]
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Step 1: Unassign all roles
+	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles"
+	failures := newItemErrors("Error unassigning role from group")
 	for _, roleID := range roleIDsFromSet(data.RoleIDs) {
-		unassignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/groups/" + groupID + "/roles/" + roleID
-		unassignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "DELETE", unassignURL, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Error unassigning role from group", err.Error())
-			return
-		}
-		unassignResp.Body.Close()
-		// Ignore 404 — role may already be gone
-		if unassignResp.StatusCode != http.StatusOK && unassignResp.StatusCode != http.StatusNoContent && unassignResp.StatusCode != http.StatusNotFound {
-			resp.Diagnostics.AddError("API error unassigning role", fmt.Sprintf("API returned: %s", unassignResp.Status))
-			return
-		}
+		failures.Add("role "+roleID, unassignRole(ctx, r.client, rolesURL, roleID))
+	}
+	resp.Diagnostics.Append(failures.Diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Step 2: Remove group from project
 	removeURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/groups/" + groupID
-	removeResp, err := doRequestWithRetry(ctx, httpClient, r.client, "DELETE", removeURL, nil)
+	removeResp, err := doRequestWithRetry(ctx, r.client, "DELETE", removeURL, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error removing group from project", err.Error())
 		return
@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -146,8 +145,7 @@ func (r *ProjectRoleResource) Create(ctx context.Context, req resource.CreateReq
 	httpReq.Header.Set("Content-Type", "application/json")
 	setAdminAuthHeaders(r.client, httpReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	httpResp, err := httpClient.Do(httpReq)
+	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating project role", err.Error())
 		return
@@ -202,7 +200,6 @@ func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 	roleID := idParts[1]
 
 	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/roles"
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	var foundRole *RoleResponseFramework
 	cursor := ""
@@ -227,7 +224,7 @@ func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 		setAdminAuthHeaders(r.client, apiReq)
 
-		apiResp, err := httpClient.Do(apiReq)
+		apiResp, err := r.client.Do(apiReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error listing project roles", err.Error())
 			return
@@ -334,8 +331,7 @@ func (r *ProjectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 	httpReq.Header.Set("Content-Type", "application/json")
 	setAdminAuthHeaders(r.client, httpReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	httpResp, err := httpClient.Do(httpReq)
+	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating project role", err.Error())
 		return
@@ -397,8 +393,7 @@ func (r *ProjectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 	setAdminAuthHeaders(r.client, deleteReq)
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
-	deleteResp, err := httpClient.Do(deleteReq)
+	deleteResp, err := r.client.Do(deleteReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting project role", err.Error())
 		return
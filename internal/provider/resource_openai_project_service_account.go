@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &ProjectServiceAccountResource{}
 var _ resource.ResourceWithImportState = &ProjectServiceAccountResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectServiceAccountResource{}
 
 type ProjectServiceAccountResource struct {
 	client *OpenAIClient
@@ -41,6 +42,7 @@ type ProjectServiceAccountResourceModel struct {
 	Role             types.String `tfsdk:"role"`
 	APIKeyID         types.String `tfsdk:"api_key_id"`
 	APIKeyValue      types.String `tfsdk:"api_key_value"`
+	RotateTrigger    types.String `tfsdk:"rotate_trigger"`
 }
 
 func (r *ProjectServiceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -88,7 +90,11 @@ func (r *ProjectServiceAccountResource) Schema(ctx context.Context, req resource
 			"api_key_value": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The value of the API key associated with the service account (only available upon creation).",
+				MarkdownDescription: "The value of the API key associated with the service account (only available upon creation or rotation).",
+			},
+			"rotate_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value (e.g. a timestamp) that rotates the API key when changed. OpenAI cannot regenerate a service account's key, so a new service account with the same `name` is created, `id`, `service_account_id`, `api_key_id` and `api_key_value` are updated, and the old service account is deleted in the same apply. The create endpoint takes no role, so the replacement gets the API's default role; a warning is shown if it differs from the old one.",
 			},
 		},
 	}
@@ -147,7 +153,7 @@ func (r *ProjectServiceAccountResource) Create(ctx context.Context, req resource
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -217,7 +223,7 @@ func (r *ProjectServiceAccountResource) Read(ctx context.Context, req resource.R
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := r.client.Do(apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -256,10 +262,99 @@ func (r *ProjectServiceAccountResource) Read(ctx context.Context, req resource.R
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan marks the service account's identity and key as unknown when
+// rotate_trigger changes, since Update replaces the account in place.
+func (r *ProjectServiceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ProjectServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.RotateTrigger.Equal(state.RotateTrigger) {
+		return
+	}
+
+	plan.ID = types.StringUnknown()
+	plan.ServiceAccountID = types.StringUnknown()
+	plan.CreatedAt = types.Int64Unknown()
+	plan.Role = types.StringUnknown()
+	plan.APIKeyID = types.StringUnknown()
+	plan.APIKeyValue = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Update only handles rotate_trigger; every other argument forces replacement.
+// Service account keys cannot be regenerated, so rotation creates a new
+// account with the same name before deleting the old one.
 func (r *ProjectServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Immutable. Name update usually implies replacement for service accounts in many systems,
-	// Is update supported? SDKv2 says "ForceNew" for Name.
-	// So update is not supported.
+	var plan, state ProjectServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotateTrigger.Equal(state.RotateTrigger) {
+		plan.ID = state.ID
+		plan.ServiceAccountID = state.ServiceAccountID
+		plan.CreatedAt = state.CreatedAt
+		plan.Role = state.Role
+		plan.APIKeyID = state.APIKeyID
+		plan.APIKeyValue = state.APIKeyValue
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	adminClient, err := GetOpenAIClientWithAdminKey(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	sa, err := adminClient.CreateProjectServiceAccount(projectID, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error rotating service account", fmt.Sprintf("Could not create replacement service account: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectID, sa.ID))
+	plan.ServiceAccountID = types.StringValue(sa.ID)
+	plan.CreatedAt = types.Int64Value(sa.CreatedAt)
+	plan.Role = types.StringValue(sa.Role)
+	plan.APIKeyID = types.StringNull()
+	plan.APIKeyValue = types.StringNull()
+	if sa.APIKey != nil {
+		plan.APIKeyID = types.StringValue(sa.APIKey.ID)
+		plan.APIKeyValue = types.StringValue(sa.APIKey.Value)
+	}
+
+	// The create endpoint takes no role, so the replacement gets the API's
+	// default one.
+	if !state.Role.IsNull() && state.Role.ValueString() != sa.Role {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("role"),
+			"Service account role changed on rotation",
+			fmt.Sprintf("The previous service account %s had role %q, but its replacement %s was created with role %q. OpenAI does not let the role be set when creating a service account; change it in the OpenAI dashboard if needed.",
+				state.ServiceAccountID.ValueString(), state.Role.ValueString(), sa.ID, sa.Role),
+		)
+	}
+
+	// Persist the new account before deleting the old one, so a failed
+	// delete never loses track of the key that was just issued.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := adminClient.DeleteProjectServiceAccount(projectID, state.ServiceAccountID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Old service account not deleted",
+			fmt.Sprintf("The service account was rotated to %s, but deleting the previous account %s failed: %s. Delete it manually.", sa.ID, state.ServiceAccountID.ValueString(), err),
+		)
+	}
 }
 
 func (r *ProjectServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -291,7 +386,7 @@ func (r *ProjectServiceAccountResource) Delete(ctx context.Context, req resource
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	r.client.Do(apiReq)
 }
 
 func (r *ProjectServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1,10 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -236,3 +245,182 @@ output "api_key" {
 }
 `, projectName, serviceAccountName)
 }
+
+// TestAccResourceOpenAIProjectServiceAccount_RotateTrigger changes
+// rotate_trigger and checks that the account is replaced in place: a new ID
+// and key land in state, the name is kept, and the old account is deleted.
+//
+// Set TF_ACC=1 to run.
+func TestAccResourceOpenAIProjectServiceAccount_RotateTrigger(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := newMockServiceAccountServer()
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOpenAIProjectServiceAccountRotate(srv.URL, "2026-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_project_service_account.test", "id", "proj_1:svc_1"),
+					resource.TestCheckResourceAttr("openai_project_service_account.test", "api_key_value", "sk-svc-1"),
+				),
+			},
+			{
+				Config: testAccResourceOpenAIProjectServiceAccountRotate(srv.URL, "2026-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_project_service_account.test", "id", "proj_1:svc_2"),
+					resource.TestCheckResourceAttr("openai_project_service_account.test", "service_account_id", "svc_2"),
+					resource.TestCheckResourceAttr("openai_project_service_account.test", "api_key_value", "sk-svc-2"),
+					resource.TestCheckResourceAttr("openai_project_service_account.test", "name", "rotated-bot"),
+					func(s *terraform.State) error {
+						if srv.exists("svc_1") {
+							return fmt.Errorf("old service account svc_1 was not deleted after rotation")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceOpenAIProjectServiceAccountRotate(apiURL, trigger string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+resource "openai_project_service_account" "test" {
+  project_id     = "proj_1"
+  name           = "rotated-bot"
+  rotate_trigger = %q
+}
+`, apiURL, trigger)
+}
+
+// TestProjectServiceAccountUpdate_WarnsWhenRotationChangesRole rotates an
+// owner service account; the mock API creates every account as a member.
+func TestProjectServiceAccountUpdate_WarnsWhenRotationChangesRole(t *testing.T) {
+	srv := newMockServiceAccountServer()
+	defer srv.Close()
+	srv.accounts["svc_0"] = map[string]interface{}{"object": "organization.project.service_account", "id": "svc_0", "name": "bot", "role": "owner"}
+
+	ctx := context.Background()
+	r := &ProjectServiceAccountResource{client: newTestOpenAIClient(srv.URL)}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	stateVals := map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "proj_1:svc_0"),
+		"project_id":         tftypes.NewValue(tftypes.String, "proj_1"),
+		"name":               tftypes.NewValue(tftypes.String, "bot"),
+		"service_account_id": tftypes.NewValue(tftypes.String, "svc_0"),
+		"created_at":         tftypes.NewValue(tftypes.Number, 1700000000),
+		"role":               tftypes.NewValue(tftypes.String, "owner"),
+		"api_key_id":         tftypes.NewValue(tftypes.String, "key_0"),
+		"api_key_value":      tftypes.NewValue(tftypes.String, "sk-svc-0"),
+		"rotate_trigger":     tftypes.NewValue(tftypes.String, "2026-01"),
+	}
+	planVals := map[string]tftypes.Value{}
+	for name, v := range stateVals {
+		planVals[name] = tftypes.NewValue(objType.AttributeTypes[name], tftypes.UnknownValue)
+		if name == "project_id" || name == "name" {
+			planVals[name] = v
+		}
+	}
+	planVals["rotate_trigger"] = tftypes.NewValue(tftypes.String, "2026-02")
+
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, stateVals)}
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, planVals)},
+		State: state,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "Service account role changed on rotation" {
+		t.Fatalf("warnings = %v, want one about the role change", warnings)
+	}
+	var role string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("role"), &role)...)
+	if role != "member" {
+		t.Errorf("role = %q, want the replacement's role recorded", role)
+	}
+	if srv.exists("svc_0") {
+		t.Error("old service account svc_0 was not deleted")
+	}
+}
+
+// mockServiceAccountServer fakes the project service account endpoints,
+// issuing sequential IDs and keys.
+type mockServiceAccountServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	next     int
+	accounts map[string]map[string]interface{}
+}
+
+func newMockServiceAccountServer() *mockServiceAccountServer {
+	srv := &mockServiceAccountServer{accounts: map[string]map[string]interface{}{}}
+	srv.Server = httptest.NewServer(http.HandlerFunc(srv.handle))
+	return srv
+}
+
+func (s *mockServiceAccountServer) exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.accounts[id]
+	return ok
+}
+
+func (s *mockServiceAccountServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	const base = "/v1/organization/projects/proj_1/service_accounts"
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, base), "/")
+
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.next++
+		account := map[string]interface{}{
+			"object":     "organization.project.service_account",
+			"id":         fmt.Sprintf("svc_%d", s.next),
+			"name":       body["name"],
+			"role":       "member",
+			"created_at": 1700000000 + s.next,
+		}
+		s.accounts[account["id"].(string)] = account
+		created := map[string]interface{}{
+			"api_key": map[string]interface{}{
+				"object": "organization.project.service_account.api_key",
+				"id":     fmt.Sprintf("key_%d", s.next),
+				"value":  fmt.Sprintf("sk-svc-%d", s.next),
+			},
+		}
+		for k, v := range account {
+			created[k] = v
+		}
+		writeJSON(w, http.StatusOK, created)
+	case r.Method == http.MethodGet && s.accounts[id] != nil:
+		writeJSON(w, http.StatusOK, s.accounts[id])
+	case r.Method == http.MethodDelete && s.accounts[id] != nil:
+		delete(s.accounts, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": id, "object": "organization.project.service_account.deleted", "deleted": true})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "not found"}})
+	}
+}
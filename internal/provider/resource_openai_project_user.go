@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -117,7 +116,6 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 	projectID := data.ProjectID.ValueString()
 	userID := data.UserID.ValueString()
 	roleIDs := roleIDsFromSet(data.RoleIDs)
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	if len(roleIDs) == 0 {
 		resp.Diagnostics.AddError("Invalid Configuration", "At least one role_id is required in role_ids.")
@@ -127,7 +125,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 	// Step 1: Add user to project (membership endpoint requires a role name, not ID)
 	body, err := json.Marshal(map[string]string{
 		"user_id": userID,
-		"role":    "member",
+		"role":    roleMember,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error marshaling request", err.Error())
@@ -135,7 +133,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	addURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/users"
-	httpResp, err := doRequestWithRetry(ctx, httpClient, r.client, "POST", addURL, body)
+	httpResp, err := doRequestWithRetry(ctx, r.client, "POST", addURL, body)
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding user to project", err.Error())
 		return
@@ -153,7 +151,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Read user details from the project (works whether we just added or already existed)
 	getUserURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/users/" + userID
-	getUserResp, err := doRequestWithRetry(ctx, httpClient, r.client, "GET", getUserURL, nil)
+	getUserResp, err := doRequestWithRetry(ctx, r.client, "GET", getUserURL, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading project user", err.Error())
 		return
@@ -173,26 +171,14 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Step 2: Assign all requested roles via the roles endpoint
+	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles"
+	failures := newItemErrors("Error assigning role to user")
 	for _, roleID := range roleIDs {
-		assignBody, err := json.Marshal(RoleAssignRequest{RoleID: roleID})
-		if err != nil {
-			resp.Diagnostics.AddError("Error marshaling role assign request", err.Error())
-			return
-		}
-
-		assignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles"
-		assignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "POST", assignURL, assignBody)
-		if err != nil {
-			resp.Diagnostics.AddError("Error assigning role to user", err.Error())
-			return
-		}
-		defer assignResp.Body.Close()
-
-		if assignResp.StatusCode != http.StatusOK && assignResp.StatusCode != http.StatusCreated {
-			assignRespBody, _ := io.ReadAll(assignResp.Body)
-			resp.Diagnostics.AddError("API error assigning role to user", fmt.Sprintf("%s - %s", assignResp.Status, string(assignRespBody)))
-			return
-		}
+		failures.Add("role "+roleID, assignRole(ctx, r.client, rolesURL, roleID))
+	}
+	resp.Diagnostics.Append(failures.Diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s", projectID, userResp.ID))
@@ -216,11 +202,10 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	projectID := idParts[0]
 	userID := idParts[1]
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Step 1: Verify the user is still in the project
 	userURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/users/" + userID
-	apiResp, err := doRequestWithRetry(ctx, httpClient, r.client, "GET", userURL, nil)
+	apiResp, err := doRequestWithRetry(ctx, r.client, "GET", userURL, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading project user", err.Error())
 		return
@@ -266,7 +251,7 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 		parsedURL.RawQuery = q.Encode()
 
-		rolesResp, err := doRequestWithRetry(ctx, httpClient, r.client, "GET", parsedURL.String(), nil)
+		rolesResp, err := doRequestWithRetry(ctx, r.client, "GET", parsedURL.String(), nil)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading user roles", err.Error())
 			return
@@ -321,7 +306,6 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	projectID := idParts[0]
 	userID := idParts[1]
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	oldRoleIDs := roleIDsFromSet(state.RoleIDs)
 	newRoleIDs := roleIDsFromSet(plan.RoleIDs)
@@ -343,53 +327,30 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		newSet[id] = true
 	}
 
+	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles"
+
 	// Roles to remove (in old but not in new)
+	unassignFailures := newItemErrors("Error unassigning role")
 	for _, id := range oldRoleIDs {
 		if !newSet[id] {
-			unassignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles/" + id
-			tflog.Info(ctx, "Unassigning role from user", map[string]interface{}{"role_id": id})
-			unassignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "DELETE", unassignURL, nil)
-			if err != nil {
-				resp.Diagnostics.AddError("Error unassigning role", err.Error())
-				return
-			}
-			respBody, _ := io.ReadAll(unassignResp.Body)
-			unassignResp.Body.Close()
-			tflog.Info(ctx, "Unassign response", map[string]interface{}{"status": unassignResp.StatusCode, "body": string(respBody)})
-			if unassignResp.StatusCode != http.StatusOK && unassignResp.StatusCode != http.StatusNoContent && unassignResp.StatusCode != http.StatusNotFound {
-				resp.Diagnostics.AddError("API error unassigning role", fmt.Sprintf("%s - %s", unassignResp.Status, string(respBody)))
-				return
-			}
+			unassignFailures.Add("role "+id, unassignRole(ctx, r.client, rolesURL, id))
 		}
 	}
 
 	// Roles to add (in new but not in old)
+	assignFailures := newItemErrors("Error assigning role")
 	for _, id := range newRoleIDs {
 		if !oldSet[id] {
-			assignBody, err := json.Marshal(RoleAssignRequest{RoleID: id})
-			if err != nil {
-				resp.Diagnostics.AddError("Error marshaling role assign request", err.Error())
-				return
-			}
-
-			assignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles"
-			tflog.Info(ctx, "Assigning role to user", map[string]interface{}{"role_id": id})
-			assignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "POST", assignURL, assignBody)
-			if err != nil {
-				resp.Diagnostics.AddError("Error assigning role", err.Error())
-				return
-			}
-			assignRespBody, _ := io.ReadAll(assignResp.Body)
-			assignResp.Body.Close()
-			tflog.Info(ctx, "Assign response", map[string]interface{}{"status": assignResp.StatusCode, "body": string(assignRespBody)})
-
-			if assignResp.StatusCode != http.StatusOK && assignResp.StatusCode != http.StatusCreated {
-				resp.Diagnostics.AddError("API error assigning role", fmt.Sprintf("%s - %s", assignResp.Status, string(assignRespBody)))
-				return
-			}
+			assignFailures.Add("role "+id, assignRole(ctx, r.client, rolesURL, id))
 		}
 	}
 
+	resp.Diagnostics.Append(unassignFailures.Diagnostics()...)
+	resp.Diagnostics.Append(assignFailures.Diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.ID = state.ID
 	plan.Email = state.Email
 	plan.AddedAt = state.AddedAt
@@ -411,26 +372,21 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 	projectID := idParts[0]
 	userID := idParts[1]
-	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Step 1: Unassign all roles
+	rolesURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles"
+	failures := newItemErrors("Error unassigning role from user")
 	for _, roleID := range roleIDsFromSet(data.RoleIDs) {
-		unassignURL := adminBaseURL(r.client) + "/v1/projects/" + projectID + "/users/" + userID + "/roles/" + roleID
-		unassignResp, err := doRequestWithRetry(ctx, httpClient, r.client, "DELETE", unassignURL, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Error unassigning role from user", err.Error())
-			return
-		}
-		unassignResp.Body.Close()
-		if unassignResp.StatusCode != http.StatusOK && unassignResp.StatusCode != http.StatusNoContent && unassignResp.StatusCode != http.StatusNotFound {
-			resp.Diagnostics.AddError("API error unassigning role", fmt.Sprintf("API returned: %s", unassignResp.Status))
-			return
-		}
+		failures.Add("role "+roleID, unassignRole(ctx, r.client, rolesURL, roleID))
+	}
+	resp.Diagnostics.Append(failures.Diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Step 2: Remove user from project
 	removeURL := adminBaseURL(r.client) + "/v1/organization/projects/" + projectID + "/users/" + userID
-	removeResp, err := doRequestWithRetry(ctx, httpClient, r.client, "DELETE", removeURL, nil)
+	removeResp, err := doRequestWithRetry(ctx, r.client, "DELETE", removeURL, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error removing user from project", err.Error())
 		return
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+var _ resource.Resource = &ProjectUsersResource{}
+var _ resource.ResourceWithImportState = &ProjectUsersResource{}
+
+// ProjectUsersResource manages the full user membership of a project.
+type ProjectUsersResource struct {
+	client *client.OpenAIClient
+}
+
+func NewProjectUsersResource() resource.Resource {
+	return &ProjectUsersResource{}
+}
+
+type ProjectUsersResourceModel struct {
+	ID        types.String              `tfsdk:"id"`
+	ProjectID types.String              `tfsdk:"project_id"`
+	Users     []ProjectUsersMemberModel `tfsdk:"users"`
+	UserIDs   types.Map                 `tfsdk:"user_ids"`
+}
+
+type ProjectUsersMemberModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func (r *ProjectUsersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_users"
+}
+
+func (r *ProjectUsersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete user membership of an OpenAI project. Users missing from the project are added, roles that differ are updated, and users not listed are removed. Organization owners are never modified or removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project whose membership is managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The users that should be members of the project.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the user. Exactly one of `user_id` or `email` must be set.",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("email")),
+							},
+						},
+						"email": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The email address of an organization user, matched case-insensitively.",
+						},
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The role of the user in the project (`owner` or `member`).",
+							Validators: []validator.String{
+								stringvalidator.OneOf(projectRoles...),
+							},
+						},
+					},
+				},
+			},
+			"user_ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The resolved user IDs of the managed users, keyed by email address.",
+			},
+		},
+	}
+}
+
+func (r *ProjectUsersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerClient, ok := req.ProviderData.(*OpenAIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
+		return
+	}
+
+	// Project membership requires Admin Keys
+	cl, err := GetOpenAIClientWithAdminKey(providerClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
+		return
+	}
+
+	r.client = cl
+}
+
+func (r *ProjectUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectUsersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectUsersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	if projectID == "" {
+		// Imported by ID.
+		projectID = data.ID.ValueString()
+	}
+
+	current, err := r.client.ListAllProjectUsers(projectID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project users", err.Error())
+		return
+	}
+	orgOwners, err := r.organizationOwners()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization users", err.Error())
+		return
+	}
+
+	// Keep each member in the form it was configured in, refreshing its
+	// role; members no longer in the project drop out so the next plan
+	// adds them back. An organization owner's project role cannot be
+	// changed, so their configured role is kept rather than planning an
+	// update that could never apply.
+	matched := map[string]bool{}
+	userIDs := map[string]string{}
+	var users []ProjectUsersMemberModel
+	for _, m := range data.Users {
+		u := findProjectMember(current, m)
+		if u == nil {
+			continue
+		}
+		matched[u.ID] = true
+		userIDs[u.Email] = u.ID
+		if !orgOwners[u.ID] {
+			m.Role = types.StringValue(u.Role)
+		}
+		users = append(users, m)
+	}
+
+	// Users added outside Terraform show up by ID so the plan removes them.
+	for _, u := range current {
+		if matched[u.ID] || orgOwners[u.ID] {
+			continue
+		}
+		users = append(users, ProjectUsersMemberModel{
+			UserID: types.StringValue(u.ID),
+			Email:  types.StringNull(),
+			Role:   types.StringValue(u.Role),
+		})
+	}
+
+	data.ID = types.StringValue(projectID)
+	data.ProjectID = types.StringValue(projectID)
+	data.Users = users
+	userIDsValue, diags := types.MapValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(diags...)
+	data.UserIDs = userIDsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectUsersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectUsersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	current, err := r.client.ListAllProjectUsers(projectID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project users", err.Error())
+		return
+	}
+	orgOwners, err := r.organizationOwners()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization users", err.Error())
+		return
+	}
+
+	// Only the users this resource manages are removed.
+	failures := newItemErrors("Error removing user from project")
+	for _, m := range data.Users {
+		u := findProjectMember(current, m)
+		if u == nil || orgOwners[u.ID] {
+			continue
+		}
+		if err := r.client.RemoveProjectUser(projectID, u.ID); err != nil && !client.IsNotFound(err) {
+			failures.Add("user "+u.ID, err)
+		}
+	}
+	resp.Diagnostics.Append(failures.Diagnostics()...)
+}
+
+func (r *ProjectUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// reconcile makes the project's membership match data.Users: missing users
+// are added, differing roles updated and unlisted users removed. Organization
+// owners are left alone; with warnOwners set, each one configured with a
+// role other than their current one is reported as a warning. Every change
+// is attempted and each failure is reported on its own. On success data.ID
+// and data.UserIDs are set.
+func (r *ProjectUsersResource) reconcile(ctx context.Context, data *ProjectUsersResourceModel, warnOwners bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	projectID := data.ProjectID.ValueString()
+
+	orgUsers, err := r.client.ListAllUsers(nil)
+	if err != nil {
+		diags.AddError("Error reading organization users", err.Error())
+		return diags
+	}
+	current, err := r.client.ListAllProjectUsers(projectID)
+	if err != nil {
+		diags.AddError("Error reading project users", err.Error())
+		return diags
+	}
+
+	orgOwners := map[string]bool{}
+	emails := map[string]string{}
+	byEmail := map[string]string{}
+	for _, u := range orgUsers {
+		if u.Role == roleOwner {
+			orgOwners[u.ID] = true
+		}
+		emails[u.ID] = u.Email
+		byEmail[strings.ToLower(u.Email)] = u.ID
+	}
+	currentRoles := map[string]string{}
+	for _, u := range current {
+		currentRoles[u.ID] = u.Role
+		if emails[u.ID] == "" {
+			emails[u.ID] = u.Email
+		}
+	}
+
+	resolve := newItemErrors("Error resolving project user")
+	want := map[string]string{}
+	userIDs := map[string]string{}
+	for _, m := range data.Users {
+		id := m.UserID.ValueString()
+		label := "user " + id
+		if !m.Email.IsNull() {
+			label = "user " + m.Email.ValueString()
+			id = byEmail[strings.ToLower(m.Email.ValueString())]
+			if id == "" {
+				resolve.Add(label, fmt.Errorf("no organization user has this email address"))
+				continue
+			}
+		}
+		if _, dup := want[id]; dup {
+			resolve.Add(label, fmt.Errorf("user %s is listed more than once", id))
+			continue
+		}
+		want[id] = m.Role.ValueString()
+		if email := emails[id]; email != "" {
+			userIDs[email] = id
+		}
+	}
+	diags.Append(resolve.Diagnostics()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	failures := newItemErrors("Error updating project membership")
+	for _, id := range sortedKeys(want) {
+		role := want[id]
+		currentRole, ok := currentRoles[id]
+		switch {
+		case !ok:
+			_, err := r.client.AddProjectUser(projectID, id, role)
+			failures.Add("add user "+id, err)
+		case currentRole == role:
+		case orgOwners[id]:
+			if warnOwners {
+				diags.AddWarning("Organization owner not modified",
+					fmt.Sprintf("User %s is an organization owner; their project role stays %q instead of %q.", id, currentRole, role))
+			}
+		default:
+			_, err := r.client.UpdateProjectUser(projectID, id, role)
+			failures.Add("update user "+id, err)
+		}
+	}
+	for _, id := range sortedKeys(currentRoles) {
+		if _, ok := want[id]; ok || orgOwners[id] {
+			continue
+		}
+		err := r.client.RemoveProjectUser(projectID, id)
+		if client.IsNotFound(err) {
+			err = nil
+		}
+		failures.Add("remove user "+id, err)
+	}
+	diags.Append(failures.Diagnostics()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	userIDsValue, d := types.MapValueFrom(ctx, types.StringType, userIDs)
+	diags.Append(d...)
+	data.ID = types.StringValue(projectID)
+	data.UserIDs = userIDsValue
+	return diags
+}
+
+// organizationOwners returns the IDs of the organization's owners, whose
+// project membership is never changed.
+func (r *ProjectUsersResource) organizationOwners() (map[string]bool, error) {
+	users, err := r.client.ListAllUsers(nil)
+	if err != nil {
+		return nil, err
+	}
+	owners := map[string]bool{}
+	for _, u := range users {
+		if u.Role == roleOwner {
+			owners[u.ID] = true
+		}
+	}
+	return owners, nil
+}
+
+// findProjectMember returns the project user m refers to, by ID or by
+// case-insensitive email.
+func findProjectMember(users []client.ProjectUser, m ProjectUsersMemberModel) *client.ProjectUser {
+	for i := range users {
+		if !m.UserID.IsNull() && users[i].ID == m.UserID.ValueString() {
+			return &users[i]
+		}
+		if !m.Email.IsNull() && strings.EqualFold(users[i].Email, m.Email.ValueString()) {
+			return &users[i]
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
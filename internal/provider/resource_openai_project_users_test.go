@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+// mockProjectUsersServer serves the organization users and a mutable list
+// of proj_1's users, recording every membership change.
+type mockProjectUsersServer struct {
+	mu      sync.Mutex
+	members map[string]string // user ID -> project role
+	changes []string
+}
+
+func (m *mockProjectUsersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	orgUsers := []map[string]interface{}{
+		{"id": "u_owner", "email": "owner@example.com", "role": "owner"},
+		{"id": "u_a", "email": "a@example.com", "role": "reader"},
+		{"id": "u_b", "email": "b@example.com", "role": "reader"},
+		{"id": "u_c", "email": "c@example.com", "role": "reader"},
+		{"id": "u_e", "email": "e@example.com", "role": "reader"},
+	}
+	emails := map[string]string{}
+	for _, u := range orgUsers {
+		emails[u["id"].(string)] = u["email"].(string)
+	}
+
+	const usersPath = "/v1/organization/projects/proj_1/users"
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/organization/users":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": orgUsers, "has_more": false})
+	case r.Method == http.MethodGet && r.URL.Path == usersPath:
+		var data []map[string]interface{}
+		for id, role := range m.members {
+			data = append(data, map[string]interface{}{"id": id, "email": emails[id], "role": role})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": data, "has_more": false})
+	case r.Method == http.MethodPost && r.URL.Path == usersPath:
+		var body struct {
+			UserID string `json:"user_id"`
+			Role   string `json:"role"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		m.members[body.UserID] = body.Role
+		m.changes = append(m.changes, "add "+body.UserID+" "+body.Role)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": body.UserID, "role": body.Role})
+	case strings.HasPrefix(r.URL.Path, usersPath+"/"):
+		id := strings.TrimPrefix(r.URL.Path, usersPath+"/")
+		if r.Method == http.MethodDelete {
+			delete(m.members, id)
+			m.changes = append(m.changes, "remove "+id)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"deleted": true})
+			return
+		}
+		var body struct {
+			Role string `json:"role"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		m.members[id] = body.Role
+		m.changes = append(m.changes, "update "+id+" "+body.Role)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": id, "role": body.Role})
+	default:
+		http.Error(w, "unexpected "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+	}
+}
+
+func TestProjectUsers_ReconcilesMembership(t *testing.T) {
+	mock := &mockProjectUsersServer{members: map[string]string{
+		"u_owner": "owner",
+		"u_a":     "member",
+		"u_c":     "member",
+	}}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &ProjectUsersResource{client: client.NewClient("test-admin-key", "", server.URL+"/v1")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	userType := objType.AttributeTypes["users"].(tftypes.Set).ElementType.(tftypes.Object)
+
+	member := func(userID, email, role string) tftypes.Value {
+		vals := map[string]tftypes.Value{
+			"user_id": tftypes.NewValue(tftypes.String, nil),
+			"email":   tftypes.NewValue(tftypes.String, nil),
+			"role":    tftypes.NewValue(tftypes.String, role),
+		}
+		if userID != "" {
+			vals["user_id"] = tftypes.NewValue(tftypes.String, userID)
+		}
+		if email != "" {
+			vals["email"] = tftypes.NewValue(tftypes.String, email)
+		}
+		return tftypes.NewValue(userType, vals)
+	}
+	plan := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"project_id": tftypes.NewValue(tftypes.String, "proj_1"),
+		"users": tftypes.NewValue(objType.AttributeTypes["users"], []tftypes.Value{
+			member("", "A@Example.com", "owner"),
+			member("u_b", "", "member"),
+		}),
+		"user_ids": tftypes.NewValue(objType.AttributeTypes["user_ids"], tftypes.UnknownValue),
+	})
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: sch, Raw: plan}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", createResp.Diagnostics)
+	}
+
+	sort.Strings(mock.changes)
+	want := []string{"add u_b member", "remove u_c", "update u_a owner"}
+	if strings.Join(mock.changes, ", ") != strings.Join(want, ", ") {
+		t.Errorf("changes = %v, want %v", mock.changes, want)
+	}
+	if _, ok := mock.members["u_owner"]; !ok {
+		t.Error("organization owner was removed from the project")
+	}
+
+	var data ProjectUsersResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &data)...)
+	userIDs := map[string]string{}
+	createResp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+	if userIDs["a@example.com"] != "u_a" || userIDs["b@example.com"] != "u_b" || len(userIDs) != 2 {
+		t.Errorf("user_ids = %v, want a@example.com and b@example.com", userIDs)
+	}
+
+	// A user added outside Terraform appears in state so the next plan
+	// removes it; the organization owner does not.
+	mock.members["u_e"] = "member"
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	var refreshed ProjectUsersResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &refreshed)...)
+	var got []string
+	for _, m := range refreshed.Users {
+		got = append(got, m.UserID.ValueString()+"|"+m.Email.ValueString()+"|"+m.Role.ValueString())
+	}
+	sort.Strings(got)
+	wantUsers := []string{"u_b||member", "u_e||member", "|A@Example.com|owner"}
+	if strings.Join(got, ", ") != strings.Join(wantUsers, ", ") {
+		t.Errorf("refreshed users = %v, want %v", got, wantUsers)
+	}
+}
+
+// TestProjectUsers_OrganizationOwnerKeepsConfiguredRole configures an
+// organization owner as a member: Create warns once, and neither Read nor
+// Update turns the configured role into a diff or another warning.
+func TestProjectUsers_OrganizationOwnerKeepsConfiguredRole(t *testing.T) {
+	mock := &mockProjectUsersServer{members: map[string]string{"u_owner": "owner"}}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &ProjectUsersResource{client: client.NewClient("test-admin-key", "", server.URL+"/v1")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	userType := objType.AttributeTypes["users"].(tftypes.Set).ElementType.(tftypes.Object)
+
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"project_id": tftypes.NewValue(tftypes.String, "proj_1"),
+		"users": tftypes.NewValue(objType.AttributeTypes["users"], []tftypes.Value{
+			tftypes.NewValue(userType, map[string]tftypes.Value{
+				"user_id": tftypes.NewValue(tftypes.String, "u_owner"),
+				"email":   tftypes.NewValue(tftypes.String, nil),
+				"role":    tftypes.NewValue(tftypes.String, "member"),
+			}),
+		}),
+		"user_ids": tftypes.NewValue(objType.AttributeTypes["user_ids"], tftypes.UnknownValue),
+	})}
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", createResp.Diagnostics)
+	}
+	if n := createResp.Diagnostics.WarningsCount(); n != 1 {
+		t.Errorf("Create warnings = %d, want 1", n)
+	}
+	if len(mock.changes) != 0 {
+		t.Errorf("changes = %v, want the owner left alone", mock.changes)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	var refreshed ProjectUsersResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &refreshed)...)
+	if len(refreshed.Users) != 1 || refreshed.Users[0].Role.ValueString() != "member" {
+		t.Errorf("refreshed users = %v, want the configured member role kept", refreshed.Users)
+	}
+
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", updateResp.Diagnostics)
+	}
+	if n := updateResp.Diagnostics.WarningsCount(); n != 0 {
+		t.Errorf("Update warnings = %d, want none after create", n)
+	}
+}
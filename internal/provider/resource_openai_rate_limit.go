@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,9 +18,10 @@ import (
 
 var _ resource.Resource = &RateLimitResource{}
 var _ resource.ResourceWithImportState = &RateLimitResource{}
+var _ resource.ResourceWithUpgradeState = &RateLimitResource{}
 
 type RateLimitResource struct {
-	client *client.OpenAIClient
+	providerClient *OpenAIClient
 }
 
 func NewRateLimitResource() resource.Resource {
@@ -40,11 +43,43 @@ type RateLimitResourceModel struct {
 	Batch1DayMaxInputTokens     types.Int64  `tfsdk:"batch_1_day_max_input_tokens"`
 	MaxAudioMegabytesPer1Minute types.Int64  `tfsdk:"max_audio_megabytes_per_1_minute"`
 	MaxRequestsPer1Day          types.Int64  `tfsdk:"max_requests_per_1_day"`
+	APIKey                      types.String `tfsdk:"api_key"`
+}
+
+// rateLimitAPIKeyDescription documents the api_key override shared by
+// openai_rate_limit and data.openai_rate_limits.
+const rateLimitAPIKeyDescription = "Admin API key (sk-admin-...) to manage rate limits with, overriding the provider's keys. Rate limits are an organization admin API, so without this the provider's `admin_key` is used, falling back to its `api_key`. A project or service account key is rejected before any request is made."
+
+// rateLimitClient returns the client for rate limit operations. Rate limits
+// live under /v1/organization and always need an admin key: apiKey if set,
+// otherwise the provider's admin_key, otherwise its api_key. The client
+// rejects keys of the wrong scope with a *client.KeyScopeError.
+func rateLimitClient(c *OpenAIClient, apiKey types.String) (*client.OpenAIClient, error) {
+	if c == nil {
+		return nil, fmt.Errorf("the provider is not configured")
+	}
+	if apiKey.IsNull() || apiKey.IsUnknown() || apiKey.ValueString() == "" {
+		return GetOpenAIClientWithAdminKey(c)
+	}
+	return c.withAPIKey(apiKey.ValueString(), false), nil
+}
+
+// addRateLimitError reports err under summary, with a dedicated diagnostic
+// when the key had the wrong scope.
+func addRateLimitError(diags *diag.Diagnostics, summary string, err error) {
+	var scopeErr *client.KeyScopeError
+	if errors.As(err, &scopeErr) {
+		diags.AddAttributeError(path.Root("api_key"), "Wrong API key scope for rate limits",
+			fmt.Sprintf("%s. Set the provider's admin_key, or this block's api_key, to an admin key (sk-admin-...).", scopeErr))
+		return
+	}
+	diags.AddError(summary, err.Error())
 }
 
 func (r *RateLimitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages rate limits for an OpenAI model in a project. Note that rate limits cannot be truly deleted via the API, so this resource will reset rate limits to defaults when removed. This resource requires an admin API key with the api.management.read scope.",
+		Version:     1,
+		Description: "Manages rate limits for an OpenAI model in a project. Note that rate limits cannot be truly deleted via the API, so this resource will reset rate limits to defaults when removed. This resource requires an admin API key with the api.management.read scope; see `api_key`. Existing rate limits can be imported with an ID of the form `project_id:model` or `project_id:rate_limit_id`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -97,6 +132,11 @@ func (r *RateLimitResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "Maximum number of requests per day.",
 				Optional:    true,
 			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: rateLimitAPIKeyDescription,
+				Optional:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 }
@@ -110,14 +150,7 @@ func (r *RateLimitResource) Configure(ctx context.Context, req resource.Configur
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *provider.OpenAIClient, got: %T", req.ProviderData))
 		return
 	}
-
-	// Rate limits require Admin API Key
-	cl, err := GetOpenAIClientWithAdminKey(providerClient)
-	if err != nil {
-		resp.Diagnostics.AddError("Error getting OpenAI Client with Admin Key", err.Error())
-		return
-	}
-	r.client = cl
+	r.providerClient = providerClient
 }
 
 func (r *RateLimitResource) updateRateLimit(ctx context.Context, data *RateLimitResourceModel, resp *resource.CreateResponse) {
@@ -152,7 +185,13 @@ func (r *RateLimitResource) updateRateLimit(ctx context.Context, data *RateLimit
 		maxRequestsPer1Day = &val
 	}
 
-	_, err := r.client.UpdateRateLimit(
+	cl, err := rateLimitClient(r.providerClient, data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	_, err = cl.UpdateRateLimit(
 		data.ProjectID.ValueString(),
 		data.Model.ValueString(),
 		maxRequestsPerMinute,
@@ -178,7 +217,7 @@ func (r *RateLimitResource) updateRateLimit(ctx context.Context, data *RateLimit
 		// But usually we just fail hard.
 		// SDKv2 logic tried to Read if update failed.
 		// We can just return error.
-		resp.Diagnostics.AddError("Error updating rate limit", err.Error())
+		addRateLimitError(&resp.Diagnostics, "Error updating rate limit", err)
 		return
 	}
 }
@@ -193,12 +232,7 @@ func (r *RateLimitResource) Create(ctx context.Context, req resource.CreateReque
 	model := data.Model.ValueString()
 	projectID := data.ProjectID.ValueString()
 
-	// Generate ID
-	projectSuffix := projectID
-	if len(projectID) > 8 {
-		projectSuffix = projectID[len(projectID)-8:]
-	}
-	id := fmt.Sprintf("rl-%s-%s", model, projectSuffix)
+	id := rateLimitResourceID(projectID, model)
 	data.ID = types.StringValue(id)
 	data.RateLimitID = types.StringValue(id)
 
@@ -217,28 +251,60 @@ func (r *RateLimitResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	rl, err := r.client.GetRateLimit(data.ProjectID.ValueString(), data.Model.ValueString())
+	cl, err := rateLimitClient(r.providerClient, data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	rl, err := cl.GetRateLimit(data.ProjectID.ValueString(), data.Model.ValueString())
 	if err != nil {
 		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "rate limit not found") {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading rate limit", err.Error())
+		addRateLimitError(&resp.Diagnostics, "Error reading rate limit", err)
 		return
 	}
 
 	if rl != nil {
-		data.MaxRequestsPerMinute = types.Int64Value(int64(rl.MaxRequestsPer1Minute))
-		data.MaxTokensPerMinute = types.Int64Value(int64(rl.MaxTokensPer1Minute))
-		data.MaxImagesPerMinute = types.Int64Value(int64(rl.MaxImagesPer1Minute))
-		data.Batch1DayMaxInputTokens = types.Int64Value(int64(rl.Batch1DayMaxInputTokens))
-		data.MaxAudioMegabytesPer1Minute = types.Int64Value(int64(rl.MaxAudioMegabytesPer1Minute))
-		data.MaxRequestsPer1Day = types.Int64Value(int64(rl.MaxRequestsPer1Day))
+		setRateLimitValues(&data, rl)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// rateLimitResourceID returns the resource ID of the rate limit for model in
+// projectID: rl-<model>-<last 8 characters of the project ID>.
+func rateLimitResourceID(projectID, model string) string {
+	projectSuffix := projectID
+	if len(projectID) > 8 {
+		projectSuffix = projectID[len(projectID)-8:]
+	}
+	return fmt.Sprintf("rl-%s-%s", model, projectSuffix)
+}
+
+// setRateLimitValues copies the limits of rl into data.
+func setRateLimitValues(data *RateLimitResourceModel, rl *client.RateLimit) {
+	data.MaxRequestsPerMinute = types.Int64Value(int64(rl.MaxRequestsPer1Minute))
+	data.MaxTokensPerMinute = types.Int64Value(int64(rl.MaxTokensPer1Minute))
+	// Optional limits are only set when the API returned them; an omitted
+	// field stays null so a config with `= null` doesn't diff against 0.
+	data.MaxImagesPerMinute = int64FromIntPtr(rl.MaxImagesPer1Minute)
+	data.Batch1DayMaxInputTokens = int64FromIntPtr(rl.Batch1DayMaxInputTokens)
+	data.MaxAudioMegabytesPer1Minute = int64FromIntPtr(rl.MaxAudioMegabytesPer1Minute)
+	data.MaxRequestsPer1Day = int64FromIntPtr(rl.MaxRequestsPer1Day)
+}
+
+// int64FromIntPtr converts an optional API integer into a Terraform value,
+// mapping nil to null.
+func int64FromIntPtr(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
 func (r *RateLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data RateLimitResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -282,7 +348,13 @@ func (r *RateLimitResource) Update(ctx context.Context, req resource.UpdateReque
 		maxRequestsPer1Day = &val
 	}
 
-	_, err := r.client.UpdateRateLimit(
+	cl, err := rateLimitClient(r.providerClient, data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	_, err = cl.UpdateRateLimit(
 		data.ProjectID.ValueString(),
 		data.Model.ValueString(),
 		maxRequestsPerMinute,
@@ -300,7 +372,7 @@ func (r *RateLimitResource) Update(ctx context.Context, req resource.UpdateReque
 				fmt.Sprintf("API error: %s. The resource will be updated in Terraform state, but the actual settings in OpenAI may not match.", err.Error()),
 			)
 		} else {
-			resp.Diagnostics.AddError("Error updating rate limit", err.Error())
+			addRateLimitError(&resp.Diagnostics, "Error updating rate limit", err)
 			return
 		}
 	}
@@ -326,29 +398,85 @@ func (r *RateLimitResource) Delete(ctx context.Context, req resource.DeleteReque
 	// SDKv2 calls `UpdateRateLimit` with nil pointers on Delete? Or does it delete the resource from Terraform only?
 	// Comment says "reset rate limits to defaults when removed".
 
-	err := r.client.DeleteRateLimit(data.ProjectID.ValueString(), data.Model.ValueString())
+	cl, err := rateLimitClient(r.providerClient, data.APIKey)
 	if err != nil {
-		resp.Diagnostics.AddError("Error resetting rate limit", err.Error())
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	if err := cl.DeleteRateLimit(data.ProjectID.ValueString(), data.Model.ValueString()); err != nil {
+		addRateLimitError(&resp.Diagnostics, "Error resetting rate limit", err)
 		return
 	}
 }
 
+// ImportState imports a rate limit by `project_id:model` or
+// `project_id:rate_limit_id` (e.g. `proj_abc123:gpt-4o` or
+// `proj_abc123:rl-gpt-4o`). The limit is looked up by exact model or ID, so
+// gpt-4o never imports gpt-4o-mini's limit. The import uses the provider's
+// admin_key; api_key is not set.
 func (r *RateLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// ID format: rl-{model}-{projectSuffix}
-	// We need to extract project and model.
-	// But `projectSuffix` is 8 chars.
-	// This ID format is lossy if projectID changes?
-	// Actually, SDKv2 Import just validates the ID format?
-	// "rl-" prefix.
-	// It splits by "-".
-	// rl-gpt-3.5-turbo-abcd1234
-	// model: gpt-3.5-turbo
-	// suffix: abcd1234
-	// But we need full projectID to Read?
-	// `client.GetRateLimit` needs projectID.
-	// If we only have suffix, we can't get projectID unless we query all projects?
-	// SDKv2 `resourceOpenAIRateLimitImport` probably requires passing projectID in ID?
-	// Or maybe it fails to import if it can't deduce?
-
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	projectID, modelOrID, ok := strings.Cut(req.ID, ":")
+	if !ok || projectID == "" || modelOrID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import ID of the form project_id:model or project_id:rate_limit_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	cl, err := rateLimitClient(r.providerClient, types.StringNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting OpenAI client", err.Error())
+		return
+	}
+
+	rl, err := cl.GetRateLimit(projectID, modelOrID)
+	if err != nil {
+		addRateLimitError(&resp.Diagnostics, "Error importing rate limit", err)
+		return
+	}
+
+	id := rateLimitResourceID(projectID, rl.Model)
+	data := RateLimitResourceModel{
+		ID:          types.StringValue(id),
+		RateLimitID: types.StringValue(id),
+		ProjectID:   types.StringValue(projectID),
+		Model:       types.StringValue(rl.Model),
+		APIKey:      types.StringNull(),
+	}
+	setRateLimitValues(&data, rl)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// UpgradeState migrates state from prior schema versions.
+//
+// v0 → v1: versions before 1 stored 0 for optional limits the API omitted.
+// Those limits are now null, so a stored 0 becomes null instead of showing
+// up as a diff against an unset attribute. api_key did not exist in v0 and
+// starts out null.
+func (r *RateLimitResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: valueStateUpgrader(schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":                               schema.StringAttribute{Computed: true},
+				"rate_limit_id":                    schema.StringAttribute{Computed: true},
+				"project_id":                       schema.StringAttribute{Required: true},
+				"model":                            schema.StringAttribute{Required: true},
+				"max_requests_per_minute":          schema.Int64Attribute{Optional: true},
+				"max_tokens_per_minute":            schema.Int64Attribute{Optional: true},
+				"max_images_per_minute":            schema.Int64Attribute{Optional: true},
+				"batch_1_day_max_input_tokens":     schema.Int64Attribute{Optional: true},
+				"max_audio_megabytes_per_1_minute": schema.Int64Attribute{Optional: true},
+				"max_requests_per_1_day":           schema.Int64Attribute{Optional: true},
+			},
+		}, func(ctx context.Context, m *RateLimitResourceModel) diag.Diagnostics {
+			m.MaxImagesPerMinute = nullIfZeroInt64(m.MaxImagesPerMinute)
+			m.Batch1DayMaxInputTokens = nullIfZeroInt64(m.Batch1DayMaxInputTokens)
+			m.MaxAudioMegabytesPer1Minute = nullIfZeroInt64(m.MaxAudioMegabytesPer1Minute)
+			m.MaxRequestsPer1Day = nullIfZeroInt64(m.MaxRequestsPer1Day)
+			return nil
+		}),
+	}
 }
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
+)
+
+// TestRateLimitDecode_OmittedOptionalFieldsStayNull asserts that optional
+// limits the API omits decode as nil and surface as null in state, rather
+// than as 0 (which produced a perpetual `0 -> null` diff).
+func TestRateLimitDecode_OmittedOptionalFieldsStayNull(t *testing.T) {
+	body := `{
+		"object": "project.rate_limit",
+		"id": "rl-gpt-4o",
+		"model": "gpt-4o",
+		"max_requests_per_1_minute": 500,
+		"max_tokens_per_1_minute": 30000,
+		"max_images_per_1_minute": 0
+	}`
+
+	var rl client.RateLimit
+	if err := json.Unmarshal([]byte(body), &rl); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if rl.MaxImagesPer1Minute == nil || *rl.MaxImagesPer1Minute != 0 {
+		t.Errorf("max_images_per_1_minute: explicit 0 should decode as non-nil 0, got %v", rl.MaxImagesPer1Minute)
+	}
+	if rl.Batch1DayMaxInputTokens != nil {
+		t.Errorf("batch_1_day_max_input_tokens: expected nil, got %d", *rl.Batch1DayMaxInputTokens)
+	}
+	if rl.MaxAudioMegabytesPer1Minute != nil {
+		t.Errorf("max_audio_megabytes_per_1_minute: expected nil, got %d", *rl.MaxAudioMegabytesPer1Minute)
+	}
+	if rl.MaxRequestsPer1Day != nil {
+		t.Errorf("max_requests_per_1_day: expected nil, got %d", *rl.MaxRequestsPer1Day)
+	}
+
+	if v := int64FromIntPtr(rl.MaxAudioMegabytesPer1Minute); !v.IsNull() {
+		t.Errorf("expected null Terraform value for omitted field, got %s", v)
+	}
+	if v := int64FromIntPtr(rl.MaxImagesPer1Minute); v.IsNull() || v.ValueInt64() != 0 {
+		t.Errorf("expected 0 Terraform value for explicit zero, got %s", v)
+	}
+}
+
+func TestRateLimitImportState(t *testing.T) {
+	srv := newMockRateLimitServer(t)
+	defer srv.Close()
+	srv.limits["rl-gpt-4o-mini"] = map[string]interface{}{
+		"object":                    "project.rate_limit",
+		"id":                        "rl-gpt-4o-mini",
+		"model":                     "gpt-4o-mini",
+		"max_requests_per_1_minute": 30000,
+		"max_tokens_per_1_minute":   150000000,
+		"max_requests_per_1_day":    500,
+	}
+
+	ctx := context.Background()
+	r := &RateLimitResource{providerClient: newTestOpenAIClient(srv.URL)}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	importID := func(id string) (*fwresource.ImportStateResponse, RateLimitResourceModel) {
+		resp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, resp)
+		var data RateLimitResourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		return resp, data
+	}
+
+	for _, id := range []string{"proj_abc12345:gpt-4o", "proj_abc12345:rl-gpt-4o"} {
+		resp, data := importID(id)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("import %s: %v", id, resp.Diagnostics)
+		}
+		if data.Model.ValueString() != "gpt-4o" || data.ProjectID.ValueString() != "proj_abc12345" {
+			t.Errorf("import %s: model=%s project=%s, want gpt-4o in proj_abc12345", id, data.Model, data.ProjectID)
+		}
+		if data.ID.ValueString() != "rl-gpt-4o-abc12345" || data.MaxRequestsPerMinute.ValueInt64() != 10000 || !data.MaxRequestsPer1Day.IsNull() {
+			t.Errorf("import %s: id=%s rpm=%d rpd=%s, want rl-gpt-4o-abc12345, 10000 and null", id, data.ID, data.MaxRequestsPerMinute.ValueInt64(), data.MaxRequestsPer1Day)
+		}
+	}
+
+	if _, data := importID("proj_abc12345:gpt-4o-mini"); data.MaxRequestsPer1Day.ValueInt64() != 500 {
+		t.Errorf("gpt-4o-mini max_requests_per_1_day = %s, want 500", data.MaxRequestsPer1Day)
+	}
+
+	for _, id := range []string{"gpt-4o", "proj_abc12345:", "proj_abc12345:gpt-4"} {
+		if resp, _ := importID(id); !resp.Diagnostics.HasError() {
+			t.Errorf("import %q succeeded, want an error", id)
+		}
+	}
+}
+
+// TestAccResourceOpenAIRateLimit_ExplicitNulls applies a config that sets the
+// optional limits to null against a mock API that omits them, then re-plans
+// the same config. The SDK test harness fails the step if either plan after
+// apply is non-empty.
+//
+// Set TF_ACC=1 to run.
+func TestAccResourceOpenAIRateLimit_ExplicitNulls(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	srv := newMockRateLimitServer(t)
+	defer srv.Close()
+
+	config := testAccResourceOpenAIRateLimitExplicitNulls(srv.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_rate_limit.test", "max_requests_per_minute", "500"),
+					resource.TestCheckResourceAttr("openai_rate_limit.test", "max_tokens_per_minute", "30000"),
+					resource.TestCheckNoResourceAttr("openai_rate_limit.test", "max_audio_megabytes_per_1_minute"),
+					resource.TestCheckNoResourceAttr("openai_rate_limit.test", "max_requests_per_1_day"),
+				),
+			},
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccResourceOpenAIRateLimitExplicitNulls(apiURL string) string {
+	return fmt.Sprintf(`
+provider "openai" {
+  api_url   = "%s/v1"
+  admin_key = "acc-test-admin-key"
+  api_key   = "acc-test-api-key"
+}
+
+resource "openai_rate_limit" "test" {
+  project_id                       = "proj_acc_test"
+  model                            = "gpt-4o"
+  max_requests_per_minute          = 500
+  max_tokens_per_minute            = 30000
+  max_images_per_minute            = null
+  batch_1_day_max_input_tokens     = null
+  max_audio_megabytes_per_1_minute = null
+  max_requests_per_1_day           = null
+}
+`, apiURL)
+}
+
+// mockRateLimitServer fakes the project rate limit endpoints. Only the
+// per-minute request and token limits are ever returned, mirroring how the
+// real API omits limits that don't apply to a model.
+type mockRateLimitServer struct {
+	*httptest.Server
+	mu     sync.Mutex
+	limits map[string]map[string]interface{} // key: rate limit ID
+}
+
+var reRateLimitByID = regexp.MustCompile(`^/v1/organization/projects/([^/]+)/rate_limits/([^/]+)$`)
+
+func newMockRateLimitServer(t *testing.T) *mockRateLimitServer {
+	t.Helper()
+	srv := &mockRateLimitServer{
+		limits: map[string]map[string]interface{}{
+			"rl-gpt-4o": {
+				"object":                    "project.rate_limit",
+				"id":                        "rl-gpt-4o",
+				"model":                     "gpt-4o",
+				"max_requests_per_1_minute": 10000,
+				"max_tokens_per_1_minute":   2000000,
+			},
+		},
+	}
+	srv.Server = httptest.NewServer(http.HandlerFunc(srv.handle))
+	return srv
+}
+
+func (s *mockRateLimitServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method == http.MethodGet && regexp.MustCompile(`/rate_limits$`).MatchString(r.URL.Path) {
+		data := make([]map[string]interface{}, 0, len(s.limits))
+		for _, rl := range s.limits {
+			data = append(data, rl)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"object": "list", "data": data, "has_more": false})
+		return
+	}
+
+	if m := reRateLimitByID.FindStringSubmatch(r.URL.Path); m != nil && r.Method == http.MethodPost {
+		rl, ok := s.limits[m[2]]
+		if !ok {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, k := range []string{"max_requests_per_1_minute", "max_tokens_per_1_minute"} {
+			if v, ok := body[k]; ok {
+				rl[k] = v
+			}
+		}
+		writeJSON(w, http.StatusOK, rl)
+		return
+	}
+
+	http.Error(w, "not found: "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+}
@@ -5,21 +5,29 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ resource.Resource = &ResponseResource{}
 var _ resource.ResourceWithConfigure = &ResponseResource{}
+var _ resource.ResourceWithUpgradeState = &ResponseResource{}
 
 type ResponseResource struct {
 	client *OpenAIClient
@@ -34,29 +42,42 @@ func (r *ResponseResource) Metadata(ctx context.Context, req resource.MetadataRe
 }
 
 type ResponseResourceModel struct {
-	Model              types.String  `tfsdk:"model"`
-	Input              types.String  `tfsdk:"input"`
-	ID                 types.String  `tfsdk:"id"`
-	CreatedAt          types.Int64   `tfsdk:"created_at"`
-	Output             types.List    `tfsdk:"output"`
-	ReasoningEffort    types.String  `tfsdk:"reasoning_effort"`
-	Metadata           types.Map     `tfsdk:"metadata"`
-	Temperature        types.Float64 `tfsdk:"temperature"`
-	TopP               types.Float64 `tfsdk:"top_p"`
-	TopLogprobs        types.Int64   `tfsdk:"top_logprobs"`
-	MaxOutputTokens    types.Int64   `tfsdk:"max_output_tokens"`
-	MaxToolCalls       types.Int64   `tfsdk:"max_tool_calls"`
-	ParallelToolCalls  types.Bool    `tfsdk:"parallel_tool_calls"`
-	Truncation         types.String  `tfsdk:"truncation"`
-	Tools              types.List    `tfsdk:"tools"`
-	ToolChoice         types.String  `tfsdk:"tool_choice"`
-	ResponseFormat     types.String  `tfsdk:"response_format"`
-	Instructions       types.String  `tfsdk:"instructions"`
-	PreviousResponseID types.String  `tfsdk:"previous_response_id"`
-	Include            types.List    `tfsdk:"include"`
-	Prompt             *PromptModel  `tfsdk:"prompt"`
-	ConversationID     types.String  `tfsdk:"conversation_id"`
-	Content            types.String  `tfsdk:"content"`
+	Model              types.String         `tfsdk:"model"`
+	Input              types.String         `tfsdk:"input"`
+	ID                 types.String         `tfsdk:"id"`
+	CreatedAt          types.Int64          `tfsdk:"created_at"`
+	Output             types.List           `tfsdk:"output"`
+	ReasoningEffort    types.String         `tfsdk:"reasoning_effort"`
+	Metadata           types.Map            `tfsdk:"metadata"`
+	Temperature        types.Float64        `tfsdk:"temperature"`
+	TopP               types.Float64        `tfsdk:"top_p"`
+	TopLogprobs        types.Int64          `tfsdk:"top_logprobs"`
+	MaxOutputTokens    types.Int64          `tfsdk:"max_output_tokens"`
+	MaxToolCalls       types.Int64          `tfsdk:"max_tool_calls"`
+	ParallelToolCalls  types.Bool           `tfsdk:"parallel_tool_calls"`
+	Truncation         types.String         `tfsdk:"truncation"`
+	SafetyIdentifier   types.String         `tfsdk:"safety_identifier"`
+	PromptCacheKey     types.String         `tfsdk:"prompt_cache_key"`
+	Tools              types.List           `tfsdk:"tools"`
+	ToolChoice         types.String         `tfsdk:"tool_choice"`
+	ResponseFormat     *ResponseFormatModel `tfsdk:"response_format"`
+	Instructions       types.String         `tfsdk:"instructions"`
+	PreviousResponseID types.String         `tfsdk:"previous_response_id"`
+	Include            types.List           `tfsdk:"include"`
+	Prompt             *PromptModel         `tfsdk:"prompt"`
+	ConversationID     types.String         `tfsdk:"conversation_id"`
+	Background         types.Bool           `tfsdk:"background"`
+	Stream             types.Bool           `tfsdk:"stream"`
+	Status             types.String         `tfsdk:"status"`
+	Content            types.String         `tfsdk:"content"`
+	Usage              types.Object         `tfsdk:"usage"`
+}
+
+// responseUsageAttrTypes describes the usage attribute of openai_response.
+var responseUsageAttrTypes = map[string]attr.Type{
+	"input_tokens":  types.Int64Type,
+	"output_tokens": types.Int64Type,
+	"total_tokens":  types.Int64Type,
 }
 
 type PromptModel struct {
@@ -83,6 +104,7 @@ type ResponseOutputItem struct {
 
 func (r *ResponseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Generates a response using the OpenAI Responses API.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
@@ -135,11 +157,28 @@ func (r *ResponseResource) Schema(ctx context.Context, req resource.SchemaReques
 				Default:             booldefault.StaticBool(true),
 			},
 			"truncation": schema.StringAttribute{
-				MarkdownDescription: "Controls how the model truncates the context if it exceeds the maximum token limit. Valid values: `auto`, `disabled`.",
+				MarkdownDescription: "Controls how the model truncates the context if it exceeds the maximum token limit. Valid values: `auto`, `disabled`. With `auto`, input items are dropped from the middle of the conversation to fit the context window; with `disabled` (the API default) an oversized request fails.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("auto", "disabled"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"safety_identifier": schema.StringAttribute{
+				MarkdownDescription: "A stable identifier for the end-user, used by OpenAI to detect abuse. Hash usernames or emails rather than sending them in the clear.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt_cache_key": schema.StringAttribute{
+				MarkdownDescription: "A key that groups requests sharing a long common prefix so they are routed to the same prompt cache, improving cache hit rates.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the generated response.",
@@ -152,10 +191,49 @@ func (r *ResponseResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "The Unix timestamp (in seconds) of when the response was created.",
 				Computed:            true,
 			},
+			"background": schema.BoolAttribute{
+				MarkdownDescription: "Create the response in background mode and poll until it finishes, for long-running reasoning tasks that would otherwise exceed the HTTP timeout. The wait is bounded by the provider `timeout`. Destroying a background response that is still queued or in progress cancels it.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"stream": schema.BoolAttribute{
+				MarkdownDescription: "Stream the response over server-sent events instead of waiting for a single reply, which keeps long generations from hitting idle connection timeouts. The text deltas are concatenated into `content`; the stored result is the same as without streaming. Cannot be combined with `background`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("background")),
+				},
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the response: `completed`, `failed`, `incomplete`, `cancelled`, or, while a background response runs, `queued` or `in_progress`.",
+				Computed:            true,
+			},
 			"content": schema.StringAttribute{
 				MarkdownDescription: "The concatenated text content of the response. This is a convenience attribute for easy access to the generated text.",
 				Computed:            true,
 			},
+			"usage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Token usage reported for the response.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"input_tokens": schema.Int64Attribute{
+						MarkdownDescription: "The number of input tokens.",
+						Computed:            true,
+					},
+					"output_tokens": schema.Int64Attribute{
+						MarkdownDescription: "The number of output tokens, including reasoning tokens.",
+						Computed:            true,
+					},
+					"total_tokens": schema.Int64Attribute{
+						MarkdownDescription: "The total number of tokens used.",
+						Computed:            true,
+					},
+				},
+			},
 			"output": schema.ListNestedAttribute{
 				MarkdownDescription: "The generated output items.",
 				Computed:            true,
@@ -205,10 +283,7 @@ func (r *ResponseResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "Controls which (if any) tool is called by the model. Can be `none`, `auto`, `required`, or a specific function name.",
 				Optional:            true,
 			},
-			"response_format": schema.StringAttribute{
-				MarkdownDescription: "Specifies the format that the model must output. Compatible with `json_object`, `json_schema`, `text`.",
-				Optional:            true,
-			},
+			"response_format": responseFormatAttribute(),
 			"instructions": schema.StringAttribute{
 				MarkdownDescription: "A system (or developer) message inserted into the model's context.",
 				Optional:            true,
@@ -314,6 +389,14 @@ func (r *ResponseResource) Create(ctx context.Context, req resource.CreateReques
 		apiReqData.Truncation = &v
 	}
 
+	if !data.SafetyIdentifier.IsNull() {
+		apiReqData.SafetyIdentifier = data.SafetyIdentifier.ValueStringPointer()
+	}
+
+	if !data.PromptCacheKey.IsNull() {
+		apiReqData.PromptCacheKey = data.PromptCacheKey.ValueStringPointer()
+	}
+
 	if !data.Tools.IsNull() {
 		var tools []ResponseToolModel
 		data.Tools.ElementsAs(ctx, &tools, false)
@@ -343,25 +426,8 @@ func (r *ResponseResource) Create(ctx context.Context, req resource.CreateReques
 		apiReqData.ToolChoice = data.ToolChoice.ValueString()
 	}
 
-	if !data.ResponseFormat.IsNull() {
-		// Maps to `text: { format: ... }` in API
-		rfVal := data.ResponseFormat.ValueString()
-		// If input is simple string (not JSON), wrap it in {"type": "..."}
-		if !strings.HasPrefix(strings.TrimSpace(rfVal), "{") {
-			apiReqData.Text = &client.TextConfig{Format: map[string]interface{}{"type": rfVal}}
-		} else {
-			// If it is JSON (e.g. for json_schema), pass as RawMessage ?
-			// Wait, Format is interface{}. RawMessage needs to be unmarshalled or passed as object.
-			// Better: Unmarshal to map or RawMessage.
-			// For simplicity: If it parses as JSON, use it. Else wrap it.
-			var js map[string]interface{}
-			if err := json.Unmarshal([]byte(rfVal), &js); err == nil {
-				apiReqData.Text = &client.TextConfig{Format: js}
-			} else {
-				// Fallback to type wrapper
-				apiReqData.Text = &client.TextConfig{Format: map[string]interface{}{"type": rfVal}}
-			}
-		}
+	if data.ResponseFormat != nil {
+		apiReqData.Text = &client.TextConfig{Format: data.ResponseFormat.textFormat()}
 	}
 
 	if !data.Instructions.IsNull() {
@@ -396,33 +462,132 @@ func (r *ResponseResource) Create(ctx context.Context, req resource.CreateReques
 		apiReqData.Include = inc
 	}
 
+	if data.Background.ValueBool() {
+		apiReqData.Background = data.Background.ValueBoolPointer()
+	}
+
 	// Call the API using client
-	respData, err := r.client.CreateResponse(apiReqData)
+	var respData *client.ResponseResponse
+	var err error
+	if data.Stream.ValueBool() {
+		respData, err = r.client.CreateResponseStream(ctx, apiReqData)
+	} else {
+		respData, err = r.client.CreateResponse(apiReqData)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating response", err.Error())
 		return
 	}
 
-	// Update state
 	data.ID = types.StringValue(respData.ID)
+
+	if data.Stream.ValueBool() && respData.Status == "failed" {
+		msg := "no error details"
+		if respData.Error != nil {
+			msg = fmt.Sprintf("%s: %s", respData.Error.Code, respData.Error.Message)
+		}
+		resp.Diagnostics.Append(r.setResponseComputed(ctx, &data, respData)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Streamed response failed", fmt.Sprintf("Response %s finished with status \"failed\" (%s)", respData.ID, msg))
+		return
+	}
+
+	if data.Background.ValueBool() {
+		finished, err := r.waitForResponse(ctx, respData.ID)
+		if finished != nil {
+			respData = finished
+		}
+		if err != nil {
+			resp.Diagnostics.Append(r.setResponseComputed(ctx, &data, respData)...)
+			saveAfterFailedWait(ctx, &resp.State, &resp.Diagnostics, &data, "Error waiting for background response", err)
+			return
+		}
+	}
+
+	// An incomplete response is kept, with a warning. A stream that ended
+	// early is reported with the client's stream_ended reason.
+	if respData.Status == "incomplete" {
+		reason := "unknown reason"
+		if respData.IncompleteDetails != nil && respData.IncompleteDetails.Reason != "" {
+			reason = respData.IncompleteDetails.Reason
+		}
+		resp.Diagnostics.AddWarning("Response is incomplete", fmt.Sprintf("Response %s finished as incomplete (%s); its output may be truncated.", respData.ID, reason))
+	}
+
+	resp.Diagnostics.Append(r.setResponseComputed(ctx, &data, respData)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// responsePollInterval is how often a background response is checked.
+var responsePollInterval = 2 * time.Second
+
+// waitForResponse polls a background response until it leaves the queued
+// and in_progress states, using the provider timeout (five minutes if
+// unset) as the overall deadline. A failed response is returned alongside an
+// error; an incomplete one is returned without error for the caller to
+// report.
+func (r *ResponseResource) waitForResponse(ctx context.Context, id string) (*client.ResponseResponse, error) {
+	respData, err := waitForStatus(ctx, waitTimeout(r.client, 5*time.Minute), responsePollInterval, "background response "+id, func(ctx context.Context) (*client.ResponseResponse, string, bool, error) {
+		got, err := r.client.RetrieveResponse(id)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return got, got.Status, got.Status != "queued" && got.Status != "in_progress", nil
+	})
+	if err != nil {
+		return respData, err
+	}
+	if respData.Status == "failed" || respData.Status == "cancelled" {
+		msg := "no error details"
+		if respData.Error != nil {
+			msg = fmt.Sprintf("%s: %s", respData.Error.Code, respData.Error.Message)
+		}
+		return respData, fmt.Errorf("response %s finished with status %q (%s)", id, respData.Status, msg)
+	}
+	return respData, nil
+}
+
+// setResponseComputed copies the API's computed fields into data.
+func (r *ResponseResource) setResponseComputed(ctx context.Context, data *ResponseResourceModel, respData *client.ResponseResponse) diag.Diagnostics {
 	data.CreatedAt = types.Int64Value(respData.CreatedAt)
-	outputList, diags := types.ListValueFrom(ctx, types.ObjectType{
+	data.Status = types.StringValue(respData.Status)
+
+	var diags diag.Diagnostics
+	outputs := r.mapAPIOutputToModel(respData.Output, &diags)
+	outputList, outputDiags := types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"type":    types.StringType,
 			"content": types.StringType,
 		},
-	}, r.mapAPIOutputToModel(respData.Output))
-	resp.Diagnostics.Append(diags...)
+	}, outputs)
+	diags.Append(outputDiags...)
 	data.Output = outputList
 
 	// Populate convenience 'content' field
 	var allContent string
-	for _, item := range r.mapAPIOutputToModel(respData.Output) {
-		allContent += item.Content.ValueString()
+	for _, item := range respData.Output {
+		allContent += responseOutputText(item)
 	}
-	data.Content = types.StringValue(allContent)
+	data.Content = stateString(r.client.OpenAIClient, path.Root("content"), allContent, &diags)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	usage, usageDiags := responseUsageValue(respData.Usage)
+	diags.Append(usageDiags...)
+	data.Usage = usage
+
+	return diags
+}
+
+// responseUsageValue converts the API's usage into the usage attribute, null
+// when the API reports none.
+func responseUsageValue(u *client.ResponseUsage) (types.Object, diag.Diagnostics) {
+	if u == nil {
+		return types.ObjectNull(responseUsageAttrTypes), nil
+	}
+	return types.ObjectValue(responseUsageAttrTypes, map[string]attr.Value{
+		"input_tokens":  types.Int64Value(u.InputTokens),
+		"output_tokens": types.Int64Value(u.OutputTokens),
+		"total_tokens":  types.Int64Value(u.TotalTokens),
+	})
 }
 
 func (r *ResponseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -434,7 +599,7 @@ func (r *ResponseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	respData, err := r.client.RetrieveResponse(data.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -442,24 +607,7 @@ func (r *ResponseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	data.CreatedAt = types.Int64Value(respData.CreatedAt)
-
-	outputList, diags := types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"type":    types.StringType,
-			"content": types.StringType,
-		},
-	}, r.mapAPIOutputToModel(respData.Output))
-	resp.Diagnostics.Append(diags...)
-	data.Output = outputList
-
-	// Populate convenience 'content' field
-	var allContent string
-	for _, item := range r.mapAPIOutputToModel(respData.Output) {
-		allContent += item.Content.ValueString()
-	}
-	data.Content = types.StringValue(allContent)
-
+	resp.Diagnostics.Append(r.setResponseComputed(ctx, &data, respData)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -469,77 +617,173 @@ func (r *ResponseResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *ResponseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No-op: API deletion not strictly required or supported.
-	// Removing from state is sufficient.
+	var data ResponseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A background response that is still running keeps generating (and
+	// billing) output, so cancel it. Finished responses need no API call;
+	// removing them from state is sufficient.
+	if data.Background.ValueBool() {
+		id := data.ID.ValueString()
+		respData, err := r.client.RetrieveResponse(id)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error reading response", err.Error())
+			return
+		}
+		if err == nil && (respData.Status == "queued" || respData.Status == "in_progress") {
+			tflog.Debug(ctx, "Cancelling background response", map[string]interface{}{
+				"id":     id,
+				"status": respData.Status,
+			})
+			if _, err := r.client.CancelResponse(id); err != nil {
+				resp.Diagnostics.AddError("Error cancelling response", fmt.Sprintf("Could not cancel background response %s: %s", id, err))
+				return
+			}
+		}
+	}
+
 	resp.State.RemoveResource(ctx)
 }
 
+// UpgradeState migrates state from prior schema versions.
+//
+// v0 → v1: `response_format` changes from a string (a format type such as
+// "json_object", or a JSON-encoded `text.format` object) to a nested
+// attribute. Every other attribute is unchanged, so the upgrader rewrites the
+// raw state JSON rather than decoding the whole prior schema.
+func (r *ResponseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Unable to upgrade openai_response state", "The prior state is not in JSON format.")
+					return
+				}
+
+				var state map[string]interface{}
+				if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade openai_response state", fmt.Sprintf("Could not parse the prior state: %s", err))
+					return
+				}
+				state["response_format"] = upgradeResponseFormatV0(state["response_format"])
+
+				upgraded, err := json.Marshal(state)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade openai_response state", err.Error())
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// upgradeResponseFormatV0 converts a v0 response_format string into the v1
+// object, mirroring how v0 built the request: a JSON object was sent as the
+// format as-is, anything else as its `type`. Attributes left out are null.
+func upgradeResponseFormatV0(v interface{}) map[string]interface{} {
+	raw, ok := v.(string)
+	if !ok || raw == "" {
+		return nil
+	}
+
+	var format struct {
+		Type        string          `json:"type"`
+		Name        *string         `json:"name"`
+		Description *string         `json:"description"`
+		Schema      json.RawMessage `json:"schema"`
+		Strict      *bool           `json:"strict"`
+	}
+	if !strings.HasPrefix(strings.TrimSpace(raw), "{") || json.Unmarshal([]byte(raw), &format) != nil {
+		return map[string]interface{}{"type": raw}
+	}
+
+	out := map[string]interface{}{
+		"type":        format.Type,
+		"name":        format.Name,
+		"description": format.Description,
+		"strict":      format.Strict,
+	}
+	if len(format.Schema) > 0 {
+		out["schema"] = string(format.Schema)
+	}
+	return out
+}
+
 // API structs (reused from previous attempt but kept here for self-containment)
 type ResponseOutputModel struct {
 	Type    types.String `tfsdk:"type"`
 	Content types.String `tfsdk:"content"`
 }
 
-func (r *ResponseResource) mapAPIOutputToModel(items []client.APIOutputItem) []ResponseOutputModel {
+func (r *ResponseResource) mapAPIOutputToModel(items []client.APIOutputItem, diags *diag.Diagnostics) []ResponseOutputModel {
 	var models []ResponseOutputModel
-	for _, item := range items {
-		contentStr := ""
-		switch v := item.Content.(type) {
-		case nil:
-			// Do nothing
+	for i, item := range items {
+		models = append(models, ResponseOutputModel{
+			Type:    types.StringValue(item.Type),
+			Content: stateString(r.client.OpenAIClient, path.Root("output").AtListIndex(i).AtName("content"), responseOutputText(item), diags),
+		})
+	}
+	return models
+}
+
+// responseOutputText extracts the text of an output item, shared by the
+// openai_response resource and data source.
+func responseOutputText(item client.APIOutputItem) string {
+	contentStr := ""
+	switch v := item.Content.(type) {
+	case nil:
+		// Do nothing
+	case string:
+		contentStr = v
+	case []interface{}:
+		// Handle list of content parts, e.g. [{"type":"text", "text":"..."}]
+		for _, part := range v {
+			if partMap, ok := part.(map[string]interface{}); ok {
+				if typeVal, ok := partMap["type"].(string); ok && (typeVal == "text" || typeVal == "output_text") {
+					if textVal, ok := partMap["text"].(string); ok {
+						contentStr += textVal
+					}
+				}
+			}
+		}
+	case map[string]interface{}:
+		// Handle single object if applicable, though usually string or list
+		if typeVal, ok := v["type"].(string); ok && typeVal == "text" {
+			if textVal, ok := v["text"].(string); ok {
+				contentStr = textVal
+			}
+		}
+	default:
+		// Fallback: JSON stringify or empty
+		if b, err := json.Marshal(v); err == nil {
+			contentStr = string(b)
+		}
+	}
+
+	if item.Message != nil && item.Message.Content != nil {
+		// If message content exists, it might override or be the primary content
+		switch v := item.Message.Content.(type) {
 		case string:
 			contentStr = v
 		case []interface{}:
-			// Handle list of content parts, e.g. [{"type":"text", "text":"..."}]
 			for _, part := range v {
 				if partMap, ok := part.(map[string]interface{}); ok {
-					if typeVal, ok := partMap["type"].(string); ok && (typeVal == "text" || typeVal == "output_text") {
+					if typeVal, ok := partMap["type"].(string); ok && typeVal == "text" {
 						if textVal, ok := partMap["text"].(string); ok {
 							contentStr += textVal
 						}
 					}
 				}
 			}
-		case map[string]interface{}:
-			// Handle single object if applicable, though usually string or list
-			if typeVal, ok := v["type"].(string); ok && typeVal == "text" {
-				if textVal, ok := v["text"].(string); ok {
-					contentStr = textVal
-				}
-			}
 		default:
-			// Fallback: JSON stringify or empty
 			if b, err := json.Marshal(v); err == nil {
 				contentStr = string(b)
 			}
 		}
-
-		if item.Message != nil && item.Message.Content != nil {
-			// If message content exists, it might override or be the primary content
-			switch v := item.Message.Content.(type) {
-			case string:
-				contentStr = v
-			case []interface{}:
-				for _, part := range v {
-					if partMap, ok := part.(map[string]interface{}); ok {
-						if typeVal, ok := partMap["type"].(string); ok && typeVal == "text" {
-							if textVal, ok := partMap["text"].(string); ok {
-								contentStr += textVal
-							}
-						}
-					}
-				}
-			default:
-				if b, err := json.Marshal(v); err == nil {
-					contentStr = string(b)
-				}
-			}
-		}
-
-		models = append(models, ResponseOutputModel{
-			Type:    types.StringValue(item.Type),
-			Content: types.StringValue(contentStr),
-		})
 	}
-	return models
+	return contentStr
 }
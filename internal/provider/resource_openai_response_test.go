@@ -0,0 +1,477 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// createResponse runs the response resource's Create against apiURL with a
+// plan that sets model, input and background and leaves every computed
+// attribute unknown.
+func createResponse(t *testing.T, apiURL string, background bool) *resource.CreateResponse {
+	t.Helper()
+	return createResponseWith(t, apiURL, func(vals map[string]tftypes.Value) {
+		vals["background"] = tftypes.NewValue(tftypes.Bool, background)
+	})
+}
+
+// createResponseWith is createResponse with the optional attributes set by
+// configure instead.
+func createResponseWith(t *testing.T, apiURL string, configure func(vals map[string]tftypes.Value)) *resource.CreateResponse {
+	t.Helper()
+	return createResponseWithClient(t, newTestOpenAIClient(apiURL), configure)
+}
+
+func createResponseWithClient(t *testing.T, c *OpenAIClient, configure func(vals map[string]tftypes.Value)) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ResponseResource{client: c}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "created_at", "status", "output", "content", "usage"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["model"] = tftypes.NewValue(tftypes.String, "o3")
+	vals["input"] = tftypes.NewValue(tftypes.String, "Prove it.")
+	configure(vals)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// mockBackgroundResponse accepts a background response as queued, reports
+// it in_progress for the next pendingReads GETs and then finalStatus.
+func mockBackgroundResponse(t *testing.T, pendingReads int, finalStatus string) *httptest.Server {
+	var mu sync.Mutex
+	reads := 0
+	response := func(status string) map[string]interface{} {
+		body := map[string]interface{}{
+			"id":         "resp_bg",
+			"object":     "response",
+			"created_at": 1700000000,
+			"status":     status,
+			"output":     []interface{}{},
+		}
+		switch status {
+		case "completed":
+			body["output"] = []map[string]interface{}{
+				{"type": "message", "content": []map[string]interface{}{{"type": "output_text", "text": "QED"}}},
+			}
+		case "failed":
+			body["error"] = map[string]interface{}{"code": "server_error", "message": "boom"}
+		}
+		return body
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/responses":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["background"] != true {
+				t.Errorf("background was not sent: %v", body)
+			}
+			writeJSON(w, http.StatusOK, response("queued"))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/responses/resp_bg":
+			reads++
+			if reads <= pendingReads {
+				writeJSON(w, http.StatusOK, response("in_progress"))
+				return
+			}
+			writeJSON(w, http.StatusOK, response(finalStatus))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestResponseCreate_BackgroundPollsUntilCompleted(t *testing.T) {
+	defer func(d time.Duration) { responsePollInterval = d }(responsePollInterval)
+	responsePollInterval = time.Millisecond
+
+	server := mockBackgroundResponse(t, 2, "completed")
+	defer server.Close()
+
+	resp := createResponse(t, server.URL, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got ResponseResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Status.ValueString() != "completed" {
+		t.Errorf("status = %q, want completed", got.Status.ValueString())
+	}
+	if got.Content.ValueString() != "QED" {
+		t.Errorf("content = %q, want QED", got.Content.ValueString())
+	}
+}
+
+func TestResponseCreate_BackgroundFailed(t *testing.T) {
+	defer func(d time.Duration) { responsePollInterval = d }(responsePollInterval)
+	responsePollInterval = time.Millisecond
+
+	server := mockBackgroundResponse(t, 1, "failed")
+	defer server.Close()
+
+	resp := createResponse(t, server.URL, true)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a failed background response")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("the created response must stay in state so it is not orphaned")
+	}
+}
+
+func TestResponseCreate_BackgroundRespectsContext(t *testing.T) {
+	defer func(d time.Duration) { responsePollInterval = d }(responsePollInterval)
+	responsePollInterval = time.Hour
+
+	server := mockBackgroundResponse(t, 1000, "completed")
+	defer server.Close()
+
+	r := &ResponseResource{client: newTestOpenAIClient(server.URL)}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := r.waitForResponse(ctx, "resp_bg")
+	if err != context.Canceled {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if got == nil || got.Status != "in_progress" {
+		t.Errorf("want the last in_progress response returned, got %+v", got)
+	}
+}
+
+// deleteResponse runs the response resource's Delete against apiURL for a
+// response in state with the given background flag and status.
+func deleteResponse(t *testing.T, apiURL string, background bool, status string) *resource.DeleteResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ResponseResource{client: newTestOpenAIClient(apiURL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["id"] = tftypes.NewValue(tftypes.String, "resp_bg")
+	vals["model"] = tftypes.NewValue(tftypes.String, "o3")
+	vals["input"] = tftypes.NewValue(tftypes.String, "Prove it.")
+	vals["background"] = tftypes.NewValue(tftypes.Bool, background)
+	vals["status"] = tftypes.NewValue(tftypes.String, status)
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	return resp
+}
+
+// mockCancellableResponse reports resp_bg with the given status and counts
+// the cancel calls it receives.
+func mockCancellableResponse(t *testing.T, status string, cancels *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/responses/resp_bg":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": "resp_bg", "object": "response", "status": status})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/responses/resp_bg/cancel":
+			*cancels++
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": "resp_bg", "object": "response", "status": "cancelled"})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestResponseDelete_CancelsInProgressBackgroundResponse(t *testing.T) {
+	cancels := 0
+	server := mockCancellableResponse(t, "in_progress", &cancels)
+	defer server.Close()
+
+	resp := deleteResponse(t, server.URL, true, "in_progress")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if cancels != 1 {
+		t.Errorf("cancel called %d times, want 1", cancels)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("state was not cleared")
+	}
+}
+
+func TestResponseDelete_TerminalResponseIsNotCancelled(t *testing.T) {
+	for _, status := range []string{"completed", "failed", "cancelled", "incomplete"} {
+		t.Run(status, func(t *testing.T) {
+			cancels := 0
+			server := mockCancellableResponse(t, status, &cancels)
+			defer server.Close()
+
+			resp := deleteResponse(t, server.URL, true, status)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if cancels != 0 {
+				t.Errorf("cancel called %d times for a %s response", cancels, status)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Error("state was not cleared")
+			}
+		})
+	}
+}
+
+func TestResponseDelete_MissingResponseIsRemoved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"error": map[string]interface{}{"message": "No response found with id 'resp_bg'.", "type": "invalid_request_error"},
+		})
+	}))
+	defer server.Close()
+
+	resp := deleteResponse(t, server.URL, true, "in_progress")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("state was not cleared")
+	}
+}
+
+// mockStreamedResponse answers POST /v1/responses with an event stream that
+// sends the text in two deltas and then a terminal event with finalStatus.
+func mockStreamedResponse(t *testing.T, finalStatus string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/responses" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["stream"] != true {
+			t.Errorf("stream was not sent: %v", body)
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: response.created\n")
+		fmt.Fprint(w, `data: {"type":"response.created","response":{"id":"resp_stream","created_at":1700000000,"status":"in_progress","output":[]}}`+"\n\n")
+		for _, delta := range []string{"Q", "ED"} {
+			fmt.Fprintf(w, `data: {"type":"response.output_text.delta","delta":%q}`+"\n\n", delta)
+		}
+		fmt.Fprintf(w, `data: {"type":"response.%s","response":{"id":"resp_stream","created_at":1700000000,"status":%q,"output":[{"type":"message","content":[{"type":"output_text","text":"QED"}]}],"error":{"code":"server_error","message":"boom"},"usage":{"input_tokens":4,"output_tokens":2,"total_tokens":6}}}`+"\n\n", finalStatus, finalStatus)
+	}))
+}
+
+func TestResponseCreate_StreamConcatenatesDeltasAndRecordsUsage(t *testing.T) {
+	server := mockStreamedResponse(t, "completed")
+	defer server.Close()
+
+	resp := createResponseWith(t, server.URL, func(vals map[string]tftypes.Value) {
+		vals["stream"] = tftypes.NewValue(tftypes.Bool, true)
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got ResponseResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "resp_stream" || got.Status.ValueString() != "completed" {
+		t.Errorf("id/status = %q/%q", got.ID.ValueString(), got.Status.ValueString())
+	}
+	if got.Content.ValueString() != "QED" {
+		t.Errorf("content = %q, want QED", got.Content.ValueString())
+	}
+	if total := got.Usage.Attributes()["total_tokens"]; total == nil || total.String() != "6" {
+		t.Errorf("usage = %v, want 6 total tokens", got.Usage)
+	}
+}
+
+func TestResponseCreate_StreamFailed(t *testing.T) {
+	server := mockStreamedResponse(t, "failed")
+	defer server.Close()
+
+	resp := createResponseWith(t, server.URL, func(vals map[string]tftypes.Value) {
+		vals["stream"] = tftypes.NewValue(tftypes.Bool, true)
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a failed streamed response")
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "boom") {
+		t.Errorf("error = %v, want the API error message", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("the created response must stay in state so it is not orphaned")
+	}
+}
+
+func TestResponseCreate_SendsResponseFormat(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "resp_json", "object": "response", "created_at": 1700000000, "status": "completed",
+			"output": []map[string]interface{}{{"type": "message", "content": []map[string]interface{}{{"type": "output_text", "text": `{"ok":true}`}}}},
+		})
+	}))
+	defer server.Close()
+
+	resp := createResponseWith(t, server.URL, func(vals map[string]tftypes.Value) {
+		rfType := vals["response_format"].Type().(tftypes.Object)
+		vals["response_format"] = responseFormatValue(rfType, map[string]interface{}{
+			"type":   "json_schema",
+			"name":   "result",
+			"schema": `{"type":"object"}`,
+		})
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	got, _ := json.Marshal(sent["text"])
+	if want := `{"format":{"name":"result","schema":{"type":"object"},"type":"json_schema"}}`; string(got) != want {
+		t.Errorf("text sent = %s, want %s", got, want)
+	}
+}
+
+func TestResponseUpgradeState_V0ResponseFormat(t *testing.T) {
+	ctx := context.Background()
+	r := &ResponseResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	tests := []struct {
+		name  string
+		prior interface{}
+		want  *ResponseFormatModel
+	}{
+		{"null", nil, nil},
+		{"type only", "json_object", &ResponseFormatModel{
+			Type: types.StringValue("json_object"), Name: types.StringNull(), Description: types.StringNull(),
+			Schema: types.StringNull(), Strict: types.BoolNull(),
+		}},
+		{"json_schema object", `{"type":"json_schema","name":"result","schema":{"type":"object"},"strict":true}`, &ResponseFormatModel{
+			Type: types.StringValue("json_schema"), Name: types.StringValue("result"), Description: types.StringNull(),
+			Schema: types.StringValue(`{"type":"object"}`), Strict: types.BoolValue(true),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// v0 state as written before `stream` and `usage` existed.
+			prior, _ := json.Marshal(map[string]interface{}{
+				"id": "resp_1", "model": "o3", "input": "Prove it.", "status": "completed",
+				"response_format": tt.prior,
+			})
+
+			resp := &resource.UpgradeStateResponse{}
+			r.UpgradeState(ctx)[0].StateUpgrader(ctx, resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: prior},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("upgrade failed: %v", resp.Diagnostics)
+			}
+
+			raw, err := resp.DynamicValue.Unmarshal(objType)
+			if err != nil {
+				t.Fatalf("upgraded state does not match the schema: %v", err)
+			}
+			var got ResponseResourceModel
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+			if diags := state.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("reading upgraded state: %v", diags)
+			}
+			if got.ID.ValueString() != "resp_1" {
+				t.Errorf("id = %q, want resp_1", got.ID.ValueString())
+			}
+			if !reflect.DeepEqual(got.ResponseFormat, tt.want) {
+				t.Errorf("response_format = %+v, want %+v", got.ResponseFormat, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponseCreate_SendsTruncation(t *testing.T) {
+	for _, truncation := range []string{"auto", "disabled"} {
+		t.Run(truncation, func(t *testing.T) {
+			var sent map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&sent)
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"id": "resp_trunc", "object": "response", "created_at": 1700000000, "status": "completed", "output": []interface{}{},
+				})
+			}))
+			defer server.Close()
+
+			resp := createResponseWith(t, server.URL, func(vals map[string]tftypes.Value) {
+				vals["truncation"] = tftypes.NewValue(tftypes.String, truncation)
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if sent["truncation"] != truncation {
+				t.Errorf("truncation sent = %v, want %q", sent["truncation"], truncation)
+			}
+		})
+	}
+
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "resp_plain", "status": "completed", "output": []interface{}{}})
+	}))
+	defer server.Close()
+
+	createResponseWith(t, server.URL, func(map[string]tftypes.Value) {})
+	if _, ok := sent["truncation"]; ok {
+		t.Errorf("truncation sent when unset: %v", sent["truncation"])
+	}
+}
+
+func TestResponseCreate_SendsSafetyIdentifierAndPromptCacheKey(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "resp_ids", "status": "completed", "output": []interface{}{}})
+	}))
+	defer server.Close()
+
+	resp := createResponseWith(t, server.URL, func(vals map[string]tftypes.Value) {
+		vals["safety_identifier"] = tftypes.NewValue(tftypes.String, "user-7f3a")
+		vals["prompt_cache_key"] = tftypes.NewValue(tftypes.String, "support-bot-v2")
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if sent["safety_identifier"] != "user-7f3a" || sent["prompt_cache_key"] != "support-bot-v2" {
+		t.Errorf("safety_identifier/prompt_cache_key sent = %v/%v", sent["safety_identifier"], sent["prompt_cache_key"])
+	}
+}
@@ -7,13 +7,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mkdev-me/terraform-provider-openai/internal/client"
 )
 
 var _ resource.Resource = &VectorStoreResource{}
@@ -32,19 +39,24 @@ func (r *VectorStoreResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 type VectorStoreResourceModel struct {
-	ID               types.String             `tfsdk:"id"`
-	Name             types.String             `tfsdk:"name"`
-	FileIDs          []types.String           `tfsdk:"file_ids"`
-	Metadata         types.Map                `tfsdk:"metadata"`
-	ExpiresAfter     *VSExpiresAfterModel     `tfsdk:"expires_after"`
-	ChunkingStrategy *VSChunkingStrategyModel `tfsdk:"chunking_strategy"`
+	ID                types.String             `tfsdk:"id"`
+	Name              types.String             `tfsdk:"name"`
+	FileIDs           []types.String           `tfsdk:"file_ids"`
+	Metadata          types.Map                `tfsdk:"metadata"`
+	ExpiresAfter      *VSExpiresAfterModel     `tfsdk:"expires_after"`
+	ChunkingStrategy  *VSChunkingStrategyModel `tfsdk:"chunking_strategy"`
+	WaitForProcessing types.Bool               `tfsdk:"wait_for_processing"`
+	AdoptExisting     types.Bool               `tfsdk:"adopt_existing"`
 
 	// Computed
-	Object     types.String       `tfsdk:"object"`
-	Status     types.String       `tfsdk:"status"`
-	CreatedAt  types.Int64        `tfsdk:"created_at"`
-	UsageBytes types.Int64        `tfsdk:"usage_bytes"`
-	FileCounts *VSFileCountsModel `tfsdk:"file_counts"`
+	Object               types.String  `tfsdk:"object"`
+	Status               types.String  `tfsdk:"status"`
+	CreatedAt            types.Int64   `tfsdk:"created_at"`
+	ExpiresAt            types.Int64   `tfsdk:"expires_at"`
+	LastActiveAt         types.Int64   `tfsdk:"last_active_at"`
+	UsageBytes           types.Int64   `tfsdk:"usage_bytes"`
+	EstimatedMonthlyCost types.Float64 `tfsdk:"estimated_monthly_cost"`
+	FileCounts           types.Object  `tfsdk:"file_counts"`
 }
 
 type VSExpiresAfterModel struct {
@@ -66,6 +78,17 @@ type VSFileCountsModel struct {
 	Total      types.Int64 `tfsdk:"total"`
 }
 
+// vsFileCountsAttrTypes is the object type of file_counts. The attribute is
+// held as a types.Object because it is unknown in the plan until the API
+// reports it.
+var vsFileCountsAttrTypes = map[string]attr.Type{
+	"in_progress": types.Int64Type,
+	"completed":   types.Int64Type,
+	"failed":      types.Int64Type,
+	"cancelled":   types.Int64Type,
+	"total":       types.Int64Type,
+}
+
 func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an OpenAI Vector Store.",
@@ -85,7 +108,7 @@ func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 			"file_ids": schema.ListAttribute{
 				Optional:            true,
 				ElementType:         types.StringType,
-				MarkdownDescription: "A list of file IDs to add to the vector store.",
+				MarkdownDescription: "A list of file IDs to add to the vector store when it is created. Changing it later does not add or remove files; manage those with `openai_vector_store_file`.",
 			},
 			"metadata": schema.MapAttribute{
 				Optional:            true,
@@ -93,14 +116,19 @@ func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Metadata.",
 			},
 			"expires_after": schema.SingleNestedAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "The expiration policy of the store. Removing it clears the policy, so the store never expires.",
 				Attributes: map[string]schema.Attribute{
 					"anchor": schema.StringAttribute{Required: true},
 					"days":   schema.Int64Attribute{Required: true},
 				},
 			},
 			"chunking_strategy": schema.SingleNestedAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "How files added with the store are split into chunks. The API cannot change it on an existing store, so changing it replaces the store.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{Required: true},
 					"max_chunk_size_tokens": schema.Int64Attribute{
@@ -113,13 +141,40 @@ func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 					},
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: adoptExistingDescription("vector store") + " Requires `name`. An adopted store keeps its own files and chunking; `file_ids` and `chunking_strategy` are only used when a store is created, while `metadata` and `expires_after` are reconciled on the next apply.",
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+			},
+			"wait_for_processing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Wait after create and update until the store's `status` is `completed`, so dependents do not search a store whose files are still being indexed. Fails if the store ends up `expired` or `failed`, or is still processing when the provider `timeout` elapses.",
+			},
 			// Computed
-			"object":      schema.StringAttribute{Computed: true},
-			"status":      schema.StringAttribute{Computed: true},
-			"created_at":  schema.Int64Attribute{Computed: true},
-			"usage_bytes": schema.Int64Attribute{Computed: true},
+			"object":     schema.StringAttribute{Computed: true},
+			"status":     schema.StringAttribute{Computed: true},
+			"created_at": schema.Int64Attribute{Computed: true},
+			"expires_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The Unix timestamp when the store expires under `expires_after`, or null if it never expires.",
+			},
+			"last_active_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The Unix timestamp when the store was last active, which `expires_after` counts from.",
+			},
+			"usage_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total number of bytes used by the files in the vector store.",
+			},
+			"estimated_monthly_cost": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Estimated storage cost in USD for 30 days at the current `usage_bytes`, using the list price of $0.10 per GB per day. The organization's free first GB is not subtracted.",
+			},
 			"file_counts": schema.SingleNestedAttribute{
-				Computed: true,
+				Computed:            true,
+				MarkdownDescription: "Counts of the store's files by processing status.",
 				Attributes: map[string]schema.Attribute{
 					"in_progress": schema.Int64Attribute{Computed: true},
 					"completed":   schema.Int64Attribute{Computed: true},
@@ -151,6 +206,53 @@ func (r *VectorStoreResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	var vsResp *VectorStoreResponse
+	if data.AdoptExisting.ValueBool() {
+		stores, err := r.listVectorStores()
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up existing vector store", err.Error())
+			return
+		}
+		vsResp, err = findByName("vector store", data.Name.ValueString(), stores,
+			func(vs VectorStoreResponse) string { return vs.Name },
+			func(vs VectorStoreResponse) string { return vs.ID },
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error adopting existing vector store", err.Error())
+			return
+		}
+	}
+
+	if vsResp == nil {
+		var diags diag.Diagnostics
+		vsResp, diags = r.createVectorStore(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.ID = types.StringValue(vsResp.ID)
+	setVectorStoreComputed(&data, vsResp)
+
+	if data.WaitForProcessing.ValueBool() {
+		processed, err := r.waitForProcessing(ctx, vsResp.ID)
+		if processed != nil {
+			setVectorStoreComputed(&data, processed)
+		}
+		if err != nil {
+			saveAfterFailedWait(ctx, &resp.State, &resp.Diagnostics, &data, "Error waiting for vector store processing", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// createVectorStore creates a vector store from the planned configuration.
+func (r *VectorStoreResource) createVectorStore(ctx context.Context, data *VectorStoreResourceModel) (*VectorStoreResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	createRequest := VectorStoreCreateRequest{}
 	if !data.Name.IsNull() {
 		createRequest.Name = data.Name.ValueString()
@@ -201,120 +303,244 @@ func (r *VectorStoreResource) Create(ctx context.Context, req resource.CreateReq
 
 	reqBody, err := json.Marshal(createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error serializing request", err.Error())
-		return
+		diags.AddError("Error serializing request", err.Error())
+		return nil, diags
 	}
 
 	url := fmt.Sprintf("%s/vector_stores", r.client.OpenAIClient.APIURL)
 	apiReq, err := http.NewRequest("POST", url, bytes.NewReader(reqBody))
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
+		diags.AddError("Error creating request", err.Error())
+		return nil, diags
 	}
 
 	apiReq.Header.Set("Content-Type", "application/json")
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
+		diags.AddError("Error making request", err.Error())
+		return nil, diags
 	}
 	defer apiResp.Body.Close()
 
 	if apiResp.StatusCode != http.StatusOK && apiResp.StatusCode != http.StatusCreated {
 		respBodyBytes, _ := io.ReadAll(apiResp.Body)
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes)))
-		return
+		diags.AddError("API error", fmt.Sprintf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes)))
+		return nil, diags
 	}
 
 	var vsResp VectorStoreResponse
 	respBodyBytes, _ := io.ReadAll(apiResp.Body)
 	if err := json.Unmarshal(respBodyBytes, &vsResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
+		diags.AddError("Error parsing response", err.Error())
+		return nil, diags
 	}
+	return &vsResp, diags
+}
 
-	data.ID = types.StringValue(vsResp.ID)
-	data.Object = types.StringValue(vsResp.Object)
-	data.CreatedAt = types.Int64Value(vsResp.CreatedAt)
-	data.Status = types.StringValue(vsResp.Status)
-	data.UsageBytes = types.Int64Value(vsResp.UsageBytes)
+// listVectorStores lists every vector store visible to the API key,
+// following the after cursor across pages.
+func (r *VectorStoreResource) listVectorStores() ([]VectorStoreResponse, error) {
+	var stores []VectorStoreResponse
+	cursor := ""
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	for {
+		url := fmt.Sprintf("%s/vector_stores?limit=100", r.client.OpenAIClient.APIURL)
+		if cursor != "" {
+			url += "&after=" + cursor
+		}
+		apiReq, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %w", err)
+		}
+		apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
+		if r.client.OpenAIClient.OrganizationID != "" {
+			apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
+		}
 
-func (r *VectorStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data VectorStoreResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+		apiResp, err := doAssistantsRequest(r.client, apiReq)
+		if err != nil {
+			return nil, fmt.Errorf("error making request: %w", err)
+		}
+		respBodyBytes, _ := io.ReadAll(apiResp.Body)
+		apiResp.Body.Close()
+		if apiResp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes))
+		}
+
+		var page ListVectorStoresResponse
+		if err := json.Unmarshal(respBodyBytes, &page); err != nil {
+			return nil, fmt.Errorf("error parsing response: %w", err)
+		}
+		stores = append(stores, page.Data...)
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		cursor = page.LastID
+		if cursor == "" {
+			cursor = page.Data[len(page.Data)-1].ID
+		}
 	}
 
-	url := fmt.Sprintf("%s/vector_stores/%s", r.client.OpenAIClient.APIURL, data.ID.ValueString())
+	return stores, nil
+}
+
+// vectorStorePollInterval is how often wait_for_processing and
+// wait_for_completion check the API.
+var vectorStorePollInterval = 2 * time.Second
+
+// getVectorStore fetches a vector store. It returns nil without error if the
+// store does not exist.
+func (r *VectorStoreResource) getVectorStore(id string) (*VectorStoreResponse, error) {
+	url := fmt.Sprintf("%s/vector_stores/%s", r.client.OpenAIClient.APIURL, id)
 	apiReq, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 	defer apiResp.Body.Close()
 
 	if apiResp.StatusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
-		return
+		return nil, nil
 	}
 	if apiResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s", apiResp.Status))
-		return
+		return nil, fmt.Errorf("API returned error: %s", apiResp.Status)
 	}
 
 	var vsResp VectorStoreResponse
 	respBodyBytes, _ := io.ReadAll(apiResp.Body)
 	if err := json.Unmarshal(respBodyBytes, &vsResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
+	return &vsResp, nil
+}
 
-	data.Status = types.StringValue(vsResp.Status)
-	data.CreatedAt = types.Int64Value(vsResp.CreatedAt)
-	data.Name = types.StringValue(vsResp.Name)
-	data.UsageBytes = types.Int64Value(vsResp.UsageBytes)
-
-	if vsResp.FileCounts != nil {
-		data.FileCounts = &VSFileCountsModel{
-			InProgress: types.Int64Value(int64(vsResp.FileCounts.InProgress)),
-			Completed:  types.Int64Value(int64(vsResp.FileCounts.Completed)),
-			Failed:     types.Int64Value(int64(vsResp.FileCounts.Failed)),
-			Cancelled:  types.Int64Value(int64(vsResp.FileCounts.Cancelled)),
-			Total:      types.Int64Value(int64(vsResp.FileCounts.Total)),
+// waitForProcessing polls the store until its status is completed, using the
+// provider timeout (five minutes if unset) as the overall deadline. The last
+// response read is returned alongside any error so file counts can still be
+// recorded. A store created moments ago can still answer 404, so not-found
+// reads are retried briefly before giving up.
+func (r *VectorStoreResource) waitForProcessing(ctx context.Context, id string) (*VectorStoreResponse, error) {
+	vs, err := waitForStatus(ctx, waitTimeout(r.client, 5*time.Minute), vectorStorePollInterval, "vector store "+id, func(ctx context.Context) (*VectorStoreResponse, string, bool, error) {
+		got, err := readWithRetry(ctx, func() (*VectorStoreResponse, error) {
+			got, err := r.getVectorStore(id)
+			if err == nil && got == nil {
+				return nil, &client.APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("vector store %s was not found", id)}
+			}
+			return got, err
+		})
+		if client.IsNotFound(err) {
+			return nil, "", false, fmt.Errorf("vector store %s was not found while waiting for it to finish processing", id)
+		}
+		if err != nil {
+			return nil, "", false, err
+		}
+		switch got.Status {
+		case "completed", "expired", "failed":
+			return got, got.Status, true, nil
 		}
+		return got, got.Status, false, nil
+	})
+	if err != nil {
+		return vs, err
+	}
+	if vs.Status != "completed" {
+		return vs, fmt.Errorf("vector store %s finished with status %q (%s)", id, vs.Status, describeFileCounts(vs.FileCounts))
 	}
+	return vs, nil
+}
 
-	// Metadata
-	if len(vsResp.Metadata) > 0 {
+// describeFileCounts renders file counts for error messages.
+func describeFileCounts(fc *FileCounts) string {
+	if fc == nil {
+		return "no file counts reported"
+	}
+	return fmt.Sprintf("files: %d completed, %d in progress, %d failed, %d cancelled, %d total",
+		fc.Completed, fc.InProgress, fc.Failed, fc.Cancelled, fc.Total)
+}
+
+// setVectorStoreComputed copies the API's computed fields into data.
+func setVectorStoreComputed(data *VectorStoreResourceModel, vs *VectorStoreResponse) {
+	data.Object = types.StringValue(vs.Object)
+	data.CreatedAt = types.Int64Value(vs.CreatedAt)
+	data.ExpiresAt = types.Int64PointerValue(vs.ExpiresAt)
+	data.LastActiveAt = types.Int64PointerValue(vs.LastActiveAt)
+	data.Status = types.StringValue(vs.Status)
+	data.UsageBytes = types.Int64Value(vs.UsageBytes)
+	data.EstimatedMonthlyCost = types.Float64Value(estimateVectorStoreMonthlyCost(vs.UsageBytes))
+	data.FileCounts = types.ObjectNull(vsFileCountsAttrTypes)
+	if vs.FileCounts != nil {
+		data.FileCounts = types.ObjectValueMust(vsFileCountsAttrTypes, map[string]attr.Value{
+			"in_progress": types.Int64Value(int64(vs.FileCounts.InProgress)),
+			"completed":   types.Int64Value(int64(vs.FileCounts.Completed)),
+			"failed":      types.Int64Value(int64(vs.FileCounts.Failed)),
+			"cancelled":   types.Int64Value(int64(vs.FileCounts.Cancelled)),
+			"total":       types.Int64Value(int64(vs.FileCounts.Total)),
+		})
+	}
+}
+
+func (r *VectorStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VectorStoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vsResp, err := r.getVectorStore(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading vector store", err.Error())
+		return
+	}
+	if vsResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// An expired store keeps its ID but its files are no longer searchable.
+	// Drop it from state so the next apply recreates it instead of leaving
+	// dependents pointed at a dead store.
+	if vsResp.Status == "expired" {
+		resp.Diagnostics.AddWarning(
+			"Vector store expired",
+			fmt.Sprintf("Vector store %s has expired under its expires_after policy and its files are no longer accessible. It has been removed from state and will be recreated on the next apply.", vsResp.ID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setVectorStoreComputed(&data, vsResp)
+	data.Name = types.StringValue(vsResp.Name)
+
+	// The API returns {} for a store without metadata; that stays null
+	// unless state had metadata, so removing it outside Terraform shows up
+	// as drift.
+	if len(vsResp.Metadata) > 0 || !data.Metadata.IsNull() {
 		metadata := make(map[string]string)
 		for k, v := range vsResp.Metadata {
 			metadata[k] = fmt.Sprintf("%v", v)
 		}
-		data.Metadata, _ = types.MapValueFrom(ctx, types.StringType, metadata)
+		var diags diag.Diagnostics
+		data.Metadata, diags = types.MapValueFrom(ctx, types.StringType, metadata)
+		resp.Diagnostics.Append(diags...)
 	}
 
-	// Expires After
+	// A store that never expires has no policy, so expires_after is
+	// cleared rather than left as it was in state.
+	data.ExpiresAfter = nil
 	if vsResp.ExpiresAfter != nil {
 		data.ExpiresAfter = &VSExpiresAfterModel{
 			Anchor: types.StringValue(vsResp.ExpiresAfter.Anchor),
@@ -328,8 +554,9 @@ func (r *VectorStoreResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *VectorStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VectorStoreResourceModel
+	var data, state VectorStoreResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -347,12 +574,17 @@ func (r *VectorStoreResource) Update(ctx context.Context, req resource.UpdateReq
 			metadata[k] = v
 		}
 		updateRequest["metadata"] = metadata
+	} else if !state.Metadata.IsNull() {
+		updateRequest["metadata"] = map[string]interface{}{}
 	}
 	if data.ExpiresAfter != nil {
 		updateRequest["expires_after"] = map[string]interface{}{
 			"anchor": data.ExpiresAfter.Anchor.ValueString(),
 			"days":   data.ExpiresAfter.Days.ValueInt64(),
 		}
+	} else if state.ExpiresAfter != nil {
+		// An explicit null removes the policy; omitting it would keep it.
+		updateRequest["expires_after"] = nil
 	}
 
 	reqBody, _ := json.Marshal(updateRequest)
@@ -364,12 +596,11 @@ func (r *VectorStoreResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	apiReq.Header.Set("Content-Type", "application/json")
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -381,6 +612,26 @@ func (r *VectorStoreResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	var vsResp VectorStoreResponse
+	respBodyBytes, _ := io.ReadAll(apiResp.Body)
+	if err := json.Unmarshal(respBodyBytes, &vsResp); err != nil {
+		resp.Diagnostics.AddError("Error parsing response", err.Error())
+		return
+	}
+	setVectorStoreComputed(&data, &vsResp)
+
+	if data.WaitForProcessing.ValueBool() {
+		processed, err := r.waitForProcessing(ctx, data.ID.ValueString())
+		if processed != nil {
+			setVectorStoreComputed(&data, processed)
+		}
+		if err != nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Error waiting for vector store processing", err.Error())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -398,12 +649,11 @@ func (r *VectorStoreResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	doAssistantsRequest(r.client, apiReq)
 }
 
 func (r *VectorStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
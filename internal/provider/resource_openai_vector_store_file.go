@@ -9,11 +9,15 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -37,6 +41,7 @@ type VectorStoreFileResourceModel struct {
 	VectorStoreID    types.String             `tfsdk:"vector_store_id"`
 	FileID           types.String             `tfsdk:"file_id"`
 	ChunkingStrategy *VSChunkingStrategyModel `tfsdk:"chunking_strategy"` // Reusing from vector store
+	Attributes       types.Map                `tfsdk:"attributes"`
 
 	// Computed
 	Object     types.String      `tfsdk:"object"`
@@ -77,6 +82,16 @@ func (r *VectorStoreFileResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"attributes": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Up to 16 key-value pairs attached to the file, which `data.openai_vector_store_search` filters can match on. Keys are at most 64 characters and values at most 512. Changing them updates the file in place without re-indexing it.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(16),
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 64)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(512)),
+				},
+			},
 
 			// Computed
 			"object":      schema.StringAttribute{Computed: true},
@@ -92,20 +107,7 @@ func (r *VectorStoreFileResource) Schema(ctx context.Context, req resource.Schem
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"chunking_strategy": schema.SingleNestedBlock{
-				MarkdownDescription: "The chunking strategy used to chunk the file(s).",
-				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{Required: true},
-					"max_chunk_size_tokens": schema.Int64Attribute{
-						Optional:            true,
-						MarkdownDescription: "The maximum number of tokens in each chunk. The default is 800. The minimum is 100 and the maximum is 4096.",
-					},
-					"chunk_overlap_tokens": schema.Int64Attribute{
-						Optional:            true,
-						MarkdownDescription: "The number of tokens that overlap between chunks. The default is 400. The maximum is half of max_chunk_size_tokens.",
-					},
-				},
-			},
+			"chunking_strategy": chunkingStrategyBlock("The chunking strategy used to chunk the file."),
 		},
 	}
 }
@@ -133,21 +135,12 @@ func (r *VectorStoreFileResource) Create(ctx context.Context, req resource.Creat
 		FileID: data.FileID.ValueString(),
 	}
 
-	if data.ChunkingStrategy != nil {
-		cs := &ChunkingStrategy{
-			Type: data.ChunkingStrategy.Type.ValueString(),
-		}
-		if cs.Type == "static" {
-			sc := &StaticChunking{}
-			if !data.ChunkingStrategy.MaxChunkSizeTokens.IsNull() {
-				sc.MaxChunkSizeTokens = int(data.ChunkingStrategy.MaxChunkSizeTokens.ValueInt64())
-			}
-			if !data.ChunkingStrategy.ChunkOverlapTokens.IsNull() {
-				sc.ChunkOverlapTokens = int(data.ChunkingStrategy.ChunkOverlapTokens.ValueInt64())
-			}
-			cs.Static = sc
+	createRequest.ChunkingStrategy = chunkingStrategyRequest(data.ChunkingStrategy)
+	if !data.Attributes.IsNull() {
+		resp.Diagnostics.Append(data.Attributes.ElementsAs(ctx, &createRequest.Attributes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		createRequest.ChunkingStrategy = cs
 	}
 
 	reqBody, err := json.Marshal(createRequest)
@@ -165,12 +158,11 @@ func (r *VectorStoreFileResource) Create(ctx context.Context, req resource.Creat
 
 	apiReq.Header.Set("Content-Type", "application/json")
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -213,12 +205,11 @@ func (r *VectorStoreFileResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -247,6 +238,9 @@ func (r *VectorStoreFileResource) Read(ctx context.Context, req resource.ReadReq
 	data.FileID = types.StringValue(vsFileResp.ID) // Note: The ID of the vector store file object is usually the same as file ID? No, wait.
 	// Actually, in vector stores, the returned object has ID = file_id. "The ID of the file."
 
+	data.ChunkingStrategy = chunkingStrategyFromAPI(data.ChunkingStrategy, vsFileResp.ChunkingStrategy)
+	resp.Diagnostics.Append(setVectorStoreFileAttributes(ctx, &data, vsFileResp.Attributes)...)
+
 	if vsFileResp.LastError != nil {
 		data.LastError = &VSLastErrorModel{
 			Code:    types.StringValue(vsFileResp.LastError.Code),
@@ -257,16 +251,85 @@ func (r *VectorStoreFileResource) Read(ctx context.Context, req resource.ReadReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Update changes the file's attributes, the only field the API can modify in
+// place. Everything else forces replacement.
 func (r *VectorStoreFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Vector Store Files are immutable mostly, no update endpoint in API usually?
-	// Wait, typically metadata?
-	// API docs: No update endpoint for vector store file. Only List, Create (add), Retrieive, Delete.
-	// So Update should just trigger replacement or return error?
-	// Terraform framework handles "RequiresReplace" attributes.
-	// non-RequiresReplace attributes that change would trigger Update.
-	// But we have no attributes that are updatable.
-	// So Update shouldn't be called unless we added attributes that are not ForceNew.
-	// Just return.
+	// Start from state so the computed fields, unknown in the plan, keep
+	// their values.
+	var data VectorStoreFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attributes"), &data.Attributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty map clears the attributes; omitting it would keep them.
+	attributes := map[string]string{}
+	if !data.Attributes.IsNull() {
+		resp.Diagnostics.Append(data.Attributes.ElementsAs(ctx, &attributes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	reqBody, err := json.Marshal(map[string]interface{}{"attributes": attributes})
+	if err != nil {
+		resp.Diagnostics.AddError("Error serializing request", err.Error())
+		return
+	}
+
+	url := fmt.Sprintf("%s/vector_stores/%s/files/%s", r.client.OpenAIClient.APIURL, data.VectorStoreID.ValueString(), data.ID.ValueString())
+	apiReq, err := http.NewRequest("POST", url, bytes.NewReader(reqBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+
+	apiReq.Header.Set("Content-Type", "application/json")
+	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
+	if r.client.OpenAIClient.OrganizationID != "" {
+		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
+	}
+
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error making request", err.Error())
+		return
+	}
+	defer apiResp.Body.Close()
+
+	respBodyBytes, _ := io.ReadAll(apiResp.Body)
+	if apiResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes)))
+		return
+	}
+
+	var vsFileResp VectorStoreFileResponse
+	if err := json.Unmarshal(respBodyBytes, &vsFileResp); err != nil {
+		resp.Diagnostics.AddError("Error parsing response", err.Error())
+		return
+	}
+	data.Status = types.StringValue(vsFileResp.Status)
+	data.UsageBytes = types.Int64Value(vsFileResp.UsageBytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setVectorStoreFileAttributes stores the attributes the API returned. The
+// API reports a file without attributes as {} or omits them; that stays null
+// unless state had attributes, so removing them outside Terraform shows up as
+// drift. Numbers and booleans are rendered as strings.
+func setVectorStoreFileAttributes(ctx context.Context, data *VectorStoreFileResourceModel, attributes map[string]interface{}) diag.Diagnostics {
+	if len(attributes) == 0 && data.Attributes.IsNull() {
+		return nil
+	}
+	values := make(map[string]string, len(attributes))
+	for k, v := range attributes {
+		values[k] = fmt.Sprintf("%v", v)
+	}
+	var diags diag.Diagnostics
+	data.Attributes, diags = types.MapValueFrom(ctx, types.StringType, values)
+	return diags
 }
 
 func (r *VectorStoreFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -283,12 +346,11 @@ func (r *VectorStoreFileResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	http.DefaultClient.Do(apiReq)
+	doAssistantsRequest(r.client, apiReq)
 }
 
 func (r *VectorStoreFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -8,8 +8,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -34,16 +35,17 @@ func (r *VectorStoreFileBatchResource) Metadata(ctx context.Context, req resourc
 }
 
 type VectorStoreFileBatchResourceModel struct {
-	ID               types.String             `tfsdk:"id"`
-	VectorStoreID    types.String             `tfsdk:"vector_store_id"`
-	FileIDs          []types.String           `tfsdk:"file_ids"`
-	ChunkingStrategy *VSChunkingStrategyModel `tfsdk:"chunking_strategy"` // Reusing from vector store
+	ID                types.String             `tfsdk:"id"`
+	VectorStoreID     types.String             `tfsdk:"vector_store_id"`
+	FileIDs           []types.String           `tfsdk:"file_ids"`
+	ChunkingStrategy  *VSChunkingStrategyModel `tfsdk:"chunking_strategy"` // Reusing from vector store
+	WaitForCompletion types.Bool               `tfsdk:"wait_for_completion"`
 
 	// Computed
-	Object     types.String       `tfsdk:"object"`
-	Status     types.String       `tfsdk:"status"`
-	CreatedAt  types.Int64        `tfsdk:"created_at"`
-	FileCounts *VSFileCountsModel `tfsdk:"file_counts"`
+	Object     types.String `tfsdk:"object"`
+	Status     types.String `tfsdk:"status"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+	FileCounts types.Object `tfsdk:"file_counts"`
 }
 
 func (r *VectorStoreFileBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -73,13 +75,18 @@ func (r *VectorStoreFileBatchResource) Schema(ctx context.Context, req resource.
 					listplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Wait after create until the batch's `status` is `completed`, so dependents do not search a store whose files are still being indexed. Fails if the batch ends up `failed` or `cancelled`, or is still processing when the provider `timeout` elapses, and warns when some of its files failed to process.",
+			},
 
 			// Computed
 			"object":     schema.StringAttribute{Computed: true},
 			"status":     schema.StringAttribute{Computed: true},
 			"created_at": schema.Int64Attribute{Computed: true},
 			"file_counts": schema.SingleNestedAttribute{
-				Computed: true,
+				Computed:            true,
+				MarkdownDescription: "Counts of the batch's files by processing status.",
 				Attributes: map[string]schema.Attribute{
 					"in_progress": schema.Int64Attribute{Computed: true},
 					"completed":   schema.Int64Attribute{Computed: true},
@@ -90,20 +97,7 @@ func (r *VectorStoreFileBatchResource) Schema(ctx context.Context, req resource.
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"chunking_strategy": schema.SingleNestedBlock{
-				MarkdownDescription: "The chunking strategy used to chunk the files.",
-				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{Required: true},
-					"max_chunk_size_tokens": schema.Int64Attribute{
-						Optional:            true,
-						MarkdownDescription: "The maximum number of tokens in each chunk. The default is 800. The minimum is 100 and the maximum is 4096.",
-					},
-					"chunk_overlap_tokens": schema.Int64Attribute{
-						Optional:            true,
-						MarkdownDescription: "The number of tokens that overlap between chunks. The default is 400. The maximum is half of max_chunk_size_tokens.",
-					},
-				},
-			},
+			"chunking_strategy": chunkingStrategyBlock("The chunking strategy used to chunk the files."),
 		},
 	}
 }
@@ -137,22 +131,7 @@ func (r *VectorStoreFileBatchResource) Create(ctx context.Context, req resource.
 		createRequest.FileIDs = ids
 	}
 
-	if data.ChunkingStrategy != nil {
-		cs := &ChunkingStrategy{
-			Type: data.ChunkingStrategy.Type.ValueString(),
-		}
-		if cs.Type == "static" {
-			sc := &StaticChunking{}
-			if !data.ChunkingStrategy.MaxChunkSizeTokens.IsNull() {
-				sc.MaxChunkSizeTokens = int(data.ChunkingStrategy.MaxChunkSizeTokens.ValueInt64())
-			}
-			if !data.ChunkingStrategy.ChunkOverlapTokens.IsNull() {
-				sc.ChunkOverlapTokens = int(data.ChunkingStrategy.ChunkOverlapTokens.ValueInt64())
-			}
-			cs.Static = sc
-		}
-		createRequest.ChunkingStrategy = cs
-	}
+	createRequest.ChunkingStrategy = chunkingStrategyRequest(data.ChunkingStrategy)
 
 	reqBody, err := json.Marshal(createRequest)
 	if err != nil {
@@ -169,12 +148,11 @@ func (r *VectorStoreFileBatchResource) Create(ctx context.Context, req resource.
 
 	apiReq.Header.Set("Content-Type", "application/json")
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making request", err.Error())
 		return
@@ -195,77 +173,157 @@ func (r *VectorStoreFileBatchResource) Create(ctx context.Context, req resource.
 	}
 
 	data.ID = types.StringValue(vsBatchResp.ID)
-	data.Object = types.StringValue(vsBatchResp.Object)
-	data.CreatedAt = types.Int64Value(vsBatchResp.CreatedAt)
-	data.Status = types.StringValue(vsBatchResp.Status)
+	setFileBatchComputed(&data, &vsBatchResp)
+
+	if data.WaitForCompletion.ValueBool() {
+		batch, err := r.waitForCompletion(ctx, data.VectorStoreID.ValueString(), vsBatchResp.ID)
+		if batch != nil {
+			setFileBatchComputed(&data, batch)
+		}
+		if err != nil {
+			saveAfterFailedWait(ctx, &resp.State, &resp.Diagnostics, &data, "Error waiting for vector store file batch", err)
+			return
+		}
+		if batch.FileCounts != nil && batch.FileCounts.Failed > 0 {
+			resp.Diagnostics.AddWarning(
+				"Some files in the batch failed to process",
+				fmt.Sprintf("Vector store file batch %s completed, but %d of %d files failed to process and will not be searchable.",
+					batch.ID, batch.FileCounts.Failed, batch.FileCounts.Total),
+			)
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *VectorStoreFileBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data VectorStoreFileBatchResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	url := fmt.Sprintf("%s/vector_stores/%s/file_batches/%s", r.client.OpenAIClient.APIURL, data.VectorStoreID.ValueString(), data.ID.ValueString())
+// getFileBatch fetches a vector store file batch. It returns nil without
+// error if the batch does not exist.
+func (r *VectorStoreFileBatchResource) getFileBatch(vectorStoreID, batchID string) (*VectorStoreFileBatchResponse, error) {
+	url := fmt.Sprintf("%s/vector_stores/%s/file_batches/%s", r.client.OpenAIClient.APIURL, vectorStoreID, batchID)
 	apiReq, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 	apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
-	apiReq.Header.Set("OpenAI-Beta", "assistants=v2")
 	if r.client.OpenAIClient.OrganizationID != "" {
 		apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
 	}
 
-	apiResp, err := http.DefaultClient.Do(apiReq)
+	apiResp, err := doAssistantsRequest(r.client, apiReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error making request", err.Error())
-		return
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 	defer apiResp.Body.Close()
 
 	if apiResp.StatusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
-		return
+		return nil, nil
 	}
 	if apiResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("API returned error: %s", apiResp.Status))
-		return
+		return nil, fmt.Errorf("API returned error: %s", apiResp.Status)
 	}
 
-	var vsBatchResp VectorStoreFileBatchResponse
+	var batch VectorStoreFileBatchResponse
 	respBodyBytes, _ := io.ReadAll(apiResp.Body)
-	if err := json.Unmarshal(respBodyBytes, &vsBatchResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
+	if err := json.Unmarshal(respBodyBytes, &batch); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
+	return &batch, nil
+}
 
-	data.Status = types.StringValue(vsBatchResp.Status)
-	data.CreatedAt = types.Int64Value(vsBatchResp.CreatedAt)
-
-	if vsBatchResp.FileCounts != nil {
-		data.FileCounts = &VSFileCountsModel{
-			InProgress: types.Int64Value(int64(vsBatchResp.FileCounts.InProgress)),
-			Completed:  types.Int64Value(int64(vsBatchResp.FileCounts.Completed)),
-			Failed:     types.Int64Value(int64(vsBatchResp.FileCounts.Failed)),
-			Cancelled:  types.Int64Value(int64(vsBatchResp.FileCounts.Cancelled)),
-			Total:      types.Int64Value(int64(vsBatchResp.FileCounts.Total)),
+// waitForCompletion polls the batch until it leaves in_progress, using the
+// provider timeout (five minutes if unset) as the overall deadline. The last
+// response read is returned alongside any error so file counts can still be
+// recorded.
+func (r *VectorStoreFileBatchResource) waitForCompletion(ctx context.Context, vectorStoreID, batchID string) (*VectorStoreFileBatchResponse, error) {
+	batch, err := waitForStatus(ctx, waitTimeout(r.client, 5*time.Minute), vectorStorePollInterval, "vector store file batch "+batchID, func(ctx context.Context) (*VectorStoreFileBatchResponse, string, bool, error) {
+		got, err := r.getFileBatch(vectorStoreID, batchID)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if got == nil {
+			return nil, "", false, fmt.Errorf("vector store file batch %s was not found while waiting for it to complete", batchID)
+		}
+		switch got.Status {
+		case "completed", "failed", "cancelled":
+			return got, got.Status, true, nil
 		}
+		return got, got.Status, false, nil
+	})
+	if err != nil {
+		return batch, err
+	}
+	if batch.Status != "completed" {
+		return batch, fmt.Errorf("vector store file batch %s finished with status %q (%s)", batchID, batch.Status, describeFileCounts(batch.FileCounts))
+	}
+	return batch, nil
+}
+
+// setFileBatchComputed copies the API's computed fields into data.
+func setFileBatchComputed(data *VectorStoreFileBatchResourceModel, batch *VectorStoreFileBatchResponse) {
+	data.Object = types.StringValue(batch.Object)
+	data.CreatedAt = types.Int64Value(batch.CreatedAt)
+	data.Status = types.StringValue(batch.Status)
+	data.FileCounts = types.ObjectNull(vsFileCountsAttrTypes)
+	if batch.FileCounts != nil {
+		data.FileCounts = types.ObjectValueMust(vsFileCountsAttrTypes, map[string]attr.Value{
+			"in_progress": types.Int64Value(int64(batch.FileCounts.InProgress)),
+			"completed":   types.Int64Value(int64(batch.FileCounts.Completed)),
+			"failed":      types.Int64Value(int64(batch.FileCounts.Failed)),
+			"cancelled":   types.Int64Value(int64(batch.FileCounts.Cancelled)),
+			"total":       types.Int64Value(int64(batch.FileCounts.Total)),
+		})
+	}
+}
+
+func (r *VectorStoreFileBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VectorStoreFileBatchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	batch, err := r.getFileBatch(data.VectorStoreID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading vector store file batch", err.Error())
+		return
 	}
+	if batch == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setFileBatchComputed(&data, batch)
 
 	// Note: file_ids might not be returned in the batch object GET response, or they might be.
 	// The API ref says the response object has "file_counts" but not "file_ids".
 	// So we rely on what's in state for file_ids, as they are immutable.
 
+	// Neither is the chunking strategy, but every file in the batch is
+	// chunked the same way, so one file is enough to compare against.
+	files, err := r.listFileBatchFiles(data.VectorStoreID.ValueString(), data.ID.ValueString(), 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing vector store file batch files", err.Error())
+		return
+	}
+	if len(files) > 0 {
+		data.ChunkingStrategy = chunkingStrategyFromAPI(data.ChunkingStrategy, files[0].ChunkingStrategy)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VectorStoreFileBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Immutable
+	// Everything but wait_for_completion forces replacement, so an update
+	// only records the new flag alongside the existing computed values.
+	var plan, state VectorStoreFileBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForCompletion = plan.WaitForCompletion
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *VectorStoreFileBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -285,7 +343,98 @@ func (r *VectorStoreFileBatchResource) ImportState(ctx context.Context, req reso
 		)
 		return
 	}
+	vectorStoreID, batchID := idParts[0], idParts[1]
+
+	batch, err := r.getFileBatch(vectorStoreID, batchID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading vector store file batch", err.Error())
+		return
+	}
+	if batch == nil {
+		resp.Diagnostics.AddError(
+			"Vector Store File Batch Not Found",
+			fmt.Sprintf("No file batch %s was found in vector store %s.", batchID, vectorStoreID),
+		)
+		return
+	}
+
+	// The batch object does not carry its file IDs, so list them; without
+	// them the required file_ids would force a replacement after import.
+	files, err := r.listFileBatchFiles(vectorStoreID, batchID, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing vector store file batch files", err.Error())
+		return
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vector_store_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	data := VectorStoreFileBatchResourceModel{
+		ID:            types.StringValue(batch.ID),
+		VectorStoreID: types.StringValue(vectorStoreID),
+		FileIDs:       make([]types.String, 0, len(files)),
+	}
+	for _, f := range files {
+		data.FileIDs = append(data.FileIDs, types.StringValue(f.ID))
+	}
+	if len(files) > 0 {
+		data.ChunkingStrategy = chunkingStrategyFromAPI(nil, files[0].ChunkingStrategy)
+	}
+	setFileBatchComputed(&data, batch)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listFileBatchFiles returns the files in a batch, oldest first, following
+// the after cursor across pages until limit files are found, or all of them
+// when limit is 0.
+func (r *VectorStoreFileBatchResource) listFileBatchFiles(vectorStoreID, batchID string, limit int) ([]VectorStoreFileResponse, error) {
+	pageSize := 100
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+	var files []VectorStoreFileResponse
+	after := ""
+	for {
+		url := fmt.Sprintf("%s/vector_stores/%s/file_batches/%s/files?limit=%d&order=asc", r.client.OpenAIClient.APIURL, vectorStoreID, batchID, pageSize)
+		if after != "" {
+			url += "&after=" + after
+		}
+		apiReq, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %w", err)
+		}
+		apiReq.Header.Set("Authorization", "Bearer "+r.client.OpenAIClient.APIKey)
+		if r.client.OpenAIClient.OrganizationID != "" {
+			apiReq.Header.Set("OpenAI-Organization", r.client.OpenAIClient.OrganizationID)
+		}
+
+		apiResp, err := doAssistantsRequest(r.client, apiReq)
+		if err != nil {
+			return nil, fmt.Errorf("error making request: %w", err)
+		}
+		respBodyBytes, _ := io.ReadAll(apiResp.Body)
+		apiResp.Body.Close()
+		if apiResp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("API returned error: %s - %s", apiResp.Status, string(respBodyBytes))
+		}
+
+		var page struct {
+			Data    []VectorStoreFileResponse `json:"data"`
+			LastID  string                    `json:"last_id"`
+			HasMore bool                      `json:"has_more"`
+		}
+		if err := json.Unmarshal(respBodyBytes, &page); err != nil {
+			return nil, fmt.Errorf("error parsing response: %w", err)
+		}
+		files = append(files, page.Data...)
+
+		if limit > 0 && len(files) >= limit {
+			return files[:limit], nil
+		}
+		if !page.HasMore || len(page.Data) == 0 {
+			return files, nil
+		}
+		after = page.LastID
+		if after == "" {
+			after = page.Data[len(page.Data)-1].ID
+		}
+	}
 }
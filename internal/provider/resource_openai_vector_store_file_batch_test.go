@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// createFileBatch runs the file batch resource's Create against apiURL with a
+// plan that adds two files and leaves every computed attribute unknown.
+func createFileBatch(t *testing.T, apiURL string, wait bool) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &VectorStoreFileBatchResource{client: newTestOpenAIClient(apiURL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "object", "status", "created_at", "file_counts"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["vector_store_id"] = tftypes.NewValue(tftypes.String, "vs_1")
+	vals["file_ids"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "file-a"),
+		tftypes.NewValue(tftypes.String, "file-b"),
+	})
+	vals["wait_for_completion"] = tftypes.NewValue(tftypes.Bool, wait)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// mockProcessingFileBatch serves a two-file batch that reports in_progress
+// for the first pendingReads GETs and then finalStatus with failed files.
+func mockProcessingFileBatch(t *testing.T, pendingReads int, finalStatus string, failed int) *httptest.Server {
+	var mu sync.Mutex
+	reads := 0
+	batch := func(status string, inProgress, completed, failed int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":              "vsfb_1",
+			"object":          "vector_store.file_batch",
+			"vector_store_id": "vs_1",
+			"status":          status,
+			"created_at":      1700000000,
+			"file_counts":     map[string]interface{}{"in_progress": inProgress, "completed": completed, "failed": failed, "cancelled": 0, "total": 2},
+		}
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/vector_stores/vs_1/file_batches":
+			writeJSON(w, http.StatusOK, batch("in_progress", 2, 0, 0))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/vector_stores/vs_1/file_batches/vsfb_1"):
+			reads++
+			if reads <= pendingReads {
+				writeJSON(w, http.StatusOK, batch("in_progress", 1, 1, 0))
+				return
+			}
+			writeJSON(w, http.StatusOK, batch(finalStatus, 0, 2-failed, failed))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func fileBatchFileCounts(t *testing.T, resp *resource.CreateResponse) (VectorStoreFileBatchResourceModel, VSFileCountsModel) {
+	t.Helper()
+	var got VectorStoreFileBatchResourceModel
+	resp.State.Get(context.Background(), &got)
+	var counts VSFileCountsModel
+	got.FileCounts.As(context.Background(), &counts, basetypes.ObjectAsOptions{})
+	return got, counts
+}
+
+func TestVectorStoreFileBatchCreate_WaitForCompletion(t *testing.T) {
+	defer func(d time.Duration) { vectorStorePollInterval = d }(vectorStorePollInterval)
+	vectorStorePollInterval = time.Millisecond
+
+	server := mockProcessingFileBatch(t, 2, "completed", 0)
+	defer server.Close()
+
+	resp := createFileBatch(t, server.URL, true)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	got, counts := fileBatchFileCounts(t, resp)
+	if got.Status.ValueString() != "completed" {
+		t.Errorf("status = %q, want completed", got.Status.ValueString())
+	}
+	if counts.Completed.ValueInt64() != 2 || counts.InProgress.ValueInt64() != 0 {
+		t.Errorf("file_counts = %+v, want 2 completed", counts)
+	}
+}
+
+func TestVectorStoreFileBatchCreate_NoWaitKeepsInitialStatus(t *testing.T) {
+	server := mockProcessingFileBatch(t, 0, "completed", 0)
+	defer server.Close()
+
+	resp := createFileBatch(t, server.URL, false)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	got, counts := fileBatchFileCounts(t, resp)
+	if got.Status.ValueString() != "in_progress" {
+		t.Errorf("status = %q, want the create response's in_progress", got.Status.ValueString())
+	}
+	if counts.InProgress.ValueInt64() != 2 {
+		t.Errorf("file_counts = %+v, want 2 in progress", counts)
+	}
+}
+
+func TestVectorStoreFileBatchCreate_CompletedWithFailuresWarns(t *testing.T) {
+	defer func(d time.Duration) { vectorStorePollInterval = d }(vectorStorePollInterval)
+	vectorStorePollInterval = time.Millisecond
+
+	server := mockProcessingFileBatch(t, 1, "completed", 1)
+	defer server.Close()
+
+	resp := createFileBatch(t, server.URL, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "1 of 2 files failed") {
+		t.Errorf("warnings = %v, want one reporting 1 of 2 files failed", diag.Diagnostics(warnings))
+	}
+
+	_, counts := fileBatchFileCounts(t, resp)
+	if counts.Failed.ValueInt64() != 1 {
+		t.Errorf("file_counts.failed = %d, want 1", counts.Failed.ValueInt64())
+	}
+}
+
+func TestVectorStoreFileBatchCreate_WaitForCompletionCancelled(t *testing.T) {
+	defer func(d time.Duration) { vectorStorePollInterval = d }(vectorStorePollInterval)
+	vectorStorePollInterval = time.Millisecond
+
+	server := mockProcessingFileBatch(t, 1, "cancelled", 0)
+	defer server.Close()
+
+	resp := createFileBatch(t, server.URL, true)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a cancelled batch")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("the created batch must stay in state so it is not orphaned")
+	}
+}
+
+func TestVectorStoreFileBatchImportState(t *testing.T) {
+	var batchReads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/vector_stores/vs_1/file_batches/vsfb_1":
+			batchReads++
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"id": "vsfb_1", "object": "vector_store.file_batch", "vector_store_id": "vs_1",
+				"status": "completed", "created_at": 1700000000,
+				"file_counts": map[string]int{"in_progress": 0, "completed": 3, "failed": 0, "cancelled": 0, "total": 3},
+			})
+		case "/v1/vector_stores/vs_1/file_batches/vsfb_1/files":
+			if r.URL.Query().Get("after") == "" {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"data":     []map[string]string{{"id": "file-a"}, {"id": "file-b"}},
+					"last_id":  "file-b",
+					"has_more": true,
+				})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"data":     []map[string]string{{"id": "file-c"}},
+				"has_more": false,
+			})
+		default:
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]string{"message": "not found"}})
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VectorStoreFileBatchResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	importID := func(id string) (*resource.ImportStateResponse, VectorStoreFileBatchResourceModel) {
+		resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+		var data VectorStoreFileBatchResourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		return resp, data
+	}
+
+	resp, data := importID("vs_1:vsfb_1")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("import: %v", resp.Diagnostics)
+	}
+	if data.ID.ValueString() != "vsfb_1" || data.VectorStoreID.ValueString() != "vs_1" || data.Status.ValueString() != "completed" {
+		t.Errorf("id=%s vector_store_id=%s status=%s, want vsfb_1 vs_1 completed", data.ID, data.VectorStoreID, data.Status)
+	}
+	if got := data.FileCounts.Attributes()["total"]; got.String() != "3" {
+		t.Errorf("file_counts.total = %s, want 3", got)
+	}
+	var fileIDs []string
+	for _, id := range data.FileIDs {
+		fileIDs = append(fileIDs, id.ValueString())
+	}
+	if strings.Join(fileIDs, ",") != "file-a,file-b,file-c" {
+		t.Errorf("file_ids = %v, want file-a, file-b and file-c from both pages", fileIDs)
+	}
+
+	if resp, _ := importID("vs_1:vsfb_missing"); !resp.Diagnostics.HasError() {
+		t.Error("importing a missing batch succeeded, want a not-found error")
+	}
+
+	for _, id := range []string{"vsfb_1", "vs_1:", ":vsfb_1", "vs_1:vsfb_1:extra"} {
+		before := batchReads
+		resp, _ := importID(id)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unexpected Import Identifier" {
+			t.Errorf("import %q diagnostics = %v, want Unexpected Import Identifier", id, resp.Diagnostics)
+		}
+		if batchReads != before {
+			t.Errorf("import %q called the API despite the malformed ID", id)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// vectorStoreFileState returns a prior state for a processed file.
+func vectorStoreFileState(attributes types.Map) VectorStoreFileResourceModel {
+	return VectorStoreFileResourceModel{
+		ID:            types.StringValue("file-abc"),
+		VectorStoreID: types.StringValue("vs_live"),
+		FileID:        types.StringValue("file-abc"),
+		Attributes:    attributes,
+		Object:        types.StringValue("vector_store.file"),
+		Status:        types.StringValue("completed"),
+		CreatedAt:     types.Int64Value(1700000000),
+		UsageBytes:    types.Int64Value(2048),
+	}
+}
+
+func TestVectorStoreFileUpdate_ChangesAttributesInPlace(t *testing.T) {
+	var method, reqPath string
+	var sent map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, reqPath = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "file-abc", "object": "vector_store.file", "status": "completed", "created_at": 1700000000,
+			"usage_bytes": 2048, "vector_store_id": "vs_live", "attributes": map[string]string{"team": "docs", "tier": "gold"},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VectorStoreFileResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}
+	prior := vectorStoreFileState(types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("docs")}))
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Fatalf("setting prior state: %v", diags)
+	}
+
+	// The plan carries the new attributes and unknown computed fields.
+	mapType := objType.AttributeTypes["attributes"]
+	planVals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		planVals[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	planVals["id"] = tftypes.NewValue(tftypes.String, "file-abc")
+	planVals["vector_store_id"] = tftypes.NewValue(tftypes.String, "vs_live")
+	planVals["file_id"] = tftypes.NewValue(tftypes.String, "file-abc")
+	planVals["chunking_strategy"] = tftypes.NewValue(objType.AttributeTypes["chunking_strategy"], nil)
+	planVals["attributes"] = tftypes.NewValue(mapType, map[string]tftypes.Value{
+		"team": tftypes.NewValue(tftypes.String, "docs"),
+		"tier": tftypes.NewValue(tftypes.String, "gold"),
+	})
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, planVals)}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if method != http.MethodPost || reqPath != "/v1/vector_stores/vs_live/files/file-abc" {
+		t.Errorf("request = %s %s, want POST /v1/vector_stores/vs_live/files/file-abc", method, reqPath)
+	}
+	if got := string(sent["attributes"]); got != `{"team":"docs","tier":"gold"}` {
+		t.Errorf("attributes sent = %s", got)
+	}
+
+	var got VectorStoreFileResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueString() != "file-abc" || got.CreatedAt.ValueInt64() != 1700000000 {
+		t.Errorf("id/created_at = %s/%s, want the file kept in place", got.ID, got.CreatedAt)
+	}
+	if tier := got.Attributes.Elements()["tier"]; tier == nil || tier.(types.String).ValueString() != "gold" {
+		t.Errorf("attributes = %v", got.Attributes)
+	}
+}
+
+func TestVectorStoreFileRead_Attributes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		api   interface{}
+		prior types.Map
+		want  types.Map
+	}{
+		"numbers and booleans become strings": {
+			api:   map[string]interface{}{"team": "docs", "version": 2, "public": true},
+			prior: types.MapNull(types.StringType),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team": types.StringValue("docs"), "version": types.StringValue("2"), "public": types.StringValue("true"),
+			}),
+		},
+		"none stays null": {
+			api:   map[string]interface{}{},
+			prior: types.MapNull(types.StringType),
+			want:  types.MapNull(types.StringType),
+		},
+		"removed outside Terraform is drift": {
+			api:   nil,
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("docs")}),
+			want:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"id": "file-abc", "object": "vector_store.file", "status": "completed", "created_at": 1700000000,
+					"usage_bytes": 2048, "vector_store_id": "vs_live", "attributes": tc.api,
+				})
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			r := &VectorStoreFileResource{client: newTestOpenAIClient(server.URL)}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			sch := schemaResp.Schema
+
+			state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+			prior := vectorStoreFileState(tc.prior)
+			if diags := state.Set(ctx, &prior); diags.HasError() {
+				t.Fatalf("setting prior state: %v", diags)
+			}
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got VectorStoreFileResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if !got.Attributes.Equal(tc.want) {
+				t.Errorf("attributes = %v, want %v", got.Attributes, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,549 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readVectorStore runs the vector store resource's Read against apiURL with
+// prior state holding only the given ID.
+func readVectorStore(t *testing.T, apiURL, id string) *resource.ReadResponse {
+	t.Helper()
+	return readVectorStoreWithState(t, apiURL, vectorStoreState(id))
+}
+
+// vectorStoreState returns a minimal prior state for a completed store.
+func vectorStoreState(id string) VectorStoreResourceModel {
+	return VectorStoreResourceModel{
+		ID:                   types.StringValue(id),
+		Name:                 types.StringValue("docs"),
+		Metadata:             types.MapNull(types.StringType),
+		Object:               types.StringValue("vector_store"),
+		Status:               types.StringValue("completed"),
+		CreatedAt:            types.Int64Value(1700000000),
+		UsageBytes:           types.Int64Value(0),
+		FileCounts:           types.ObjectNull(vsFileCountsAttrTypes),
+		EstimatedMonthlyCost: types.Float64Value(0),
+	}
+}
+
+func readVectorStoreWithState(t *testing.T, apiURL string, prior VectorStoreResourceModel) *resource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &VectorStoreResource{client: newTestOpenAIClient(apiURL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Fatalf("setting prior state: %v", diags)
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+func TestVectorStoreRead_ExpiredIsRemovedFromState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/vector_stores/vs_expired" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":            "vs_expired",
+			"object":        "vector_store",
+			"name":          "docs",
+			"status":        "expired",
+			"created_at":    1700000000,
+			"usage_bytes":   0,
+			"expires_after": map[string]interface{}{"anchor": "last_active_at", "days": 7},
+			"expires_at":    1700604800,
+			"file_counts":   map[string]interface{}{"in_progress": 0, "completed": 3, "failed": 0, "cancelled": 0, "total": 3},
+		})
+	}))
+	defer server.Close()
+
+	resp := readVectorStore(t, server.URL, "vs_expired")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Vector store expired" {
+		t.Errorf("expected a single \"Vector store expired\" warning, got %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the expired store to be removed from state so it is recreated")
+	}
+}
+
+func TestVectorStoreRead_CompletedIsKept(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":          "vs_live",
+			"object":      "vector_store",
+			"name":        "docs",
+			"status":      "completed",
+			"created_at":  1700000000,
+			"usage_bytes": 1024,
+		})
+	}))
+	defer server.Close()
+
+	resp := readVectorStore(t, server.URL, "vs_live")
+
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		t.Fatal("a healthy store must stay in state")
+	}
+}
+
+// createVectorStore runs the vector store resource's Create against apiURL
+// with a plan that sets name and wait_for_processing and leaves every
+// computed attribute unknown, as Terraform does.
+func createVectorStore(t *testing.T, apiURL string, wait bool) *resource.CreateResponse {
+	t.Helper()
+	return createVectorStoreWithClient(t, newTestOpenAIClient(apiURL), wait)
+}
+
+func createVectorStoreWithClient(t *testing.T, c *OpenAIClient, wait bool) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &VectorStoreResource{client: c}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "object", "status", "created_at", "expires_at", "last_active_at", "usage_bytes", "estimated_monthly_cost", "file_counts"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "docs")
+	vals["wait_for_processing"] = tftypes.NewValue(tftypes.Bool, wait)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp
+}
+
+// mockProcessingVectorStore serves a store that reports in_progress for the
+// first pendingReads GETs and then finalStatus.
+func mockProcessingVectorStore(t *testing.T, pendingReads int, finalStatus string) *httptest.Server {
+	var mu sync.Mutex
+	reads := 0
+	store := func(status string, done int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":          "vs_new",
+			"object":      "vector_store",
+			"name":        "docs",
+			"status":      status,
+			"created_at":  1700000000,
+			"usage_bytes": 2048,
+			"file_counts": map[string]interface{}{"in_progress": 2 - done, "completed": done, "failed": 0, "cancelled": 0, "total": 2},
+		}
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/vector_stores":
+			writeJSON(w, http.StatusOK, store("in_progress", 0))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/vector_stores/vs_new"):
+			reads++
+			if reads <= pendingReads {
+				writeJSON(w, http.StatusOK, store("in_progress", 1))
+				return
+			}
+			writeJSON(w, http.StatusOK, store(finalStatus, 2))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestVectorStoreCreate_WaitForProcessing(t *testing.T) {
+	defer func(d time.Duration) { vectorStorePollInterval = d }(vectorStorePollInterval)
+	vectorStorePollInterval = time.Millisecond
+
+	server := mockProcessingVectorStore(t, 2, "completed")
+	defer server.Close()
+
+	resp := createVectorStore(t, server.URL, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got VectorStoreResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Status.ValueString() != "completed" {
+		t.Errorf("status = %q, want completed", got.Status.ValueString())
+	}
+	var counts VSFileCountsModel
+	got.FileCounts.As(context.Background(), &counts, basetypes.ObjectAsOptions{})
+	if counts.Completed.ValueInt64() != 2 || counts.InProgress.ValueInt64() != 0 || counts.Total.ValueInt64() != 2 {
+		t.Errorf("file_counts = %+v, want 2 completed of 2", counts)
+	}
+}
+
+func TestVectorStoreCreate_WaitRetriesNotFoundAfterCreate(t *testing.T) {
+	defer func(d time.Duration) { vectorStorePollInterval = d }(vectorStorePollInterval)
+	defer func(d time.Duration) { readRetryBackoff = d }(readRetryBackoff)
+	vectorStorePollInterval = time.Millisecond
+	readRetryBackoff = time.Millisecond
+
+	// The new store answers 404 twice before it becomes readable.
+	var mu sync.Mutex
+	notFound := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		status := "in_progress"
+		if r.Method == http.MethodGet {
+			if notFound > 0 {
+				notFound--
+				writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"message": "No vector store found"}})
+				return
+			}
+			status = "completed"
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "vs_new", "object": "vector_store", "name": "docs", "status": status, "created_at": 1700000000, "usage_bytes": 0,
+		})
+	}))
+	defer server.Close()
+
+	resp := createVectorStore(t, server.URL, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var got VectorStoreResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Status.ValueString() != "completed" {
+		t.Errorf("status = %q, want completed", got.Status.ValueString())
+	}
+}
+
+func TestVectorStoreCreate_NoWaitKeepsInitialStatus(t *testing.T) {
+	server := mockProcessingVectorStore(t, 0, "completed")
+	defer server.Close()
+
+	resp := createVectorStore(t, server.URL, false)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got VectorStoreResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.Status.ValueString() != "in_progress" {
+		t.Errorf("status = %q, want the create response's in_progress", got.Status.ValueString())
+	}
+}
+
+func TestVectorStoreCreate_WaitForProcessingFailed(t *testing.T) {
+	defer func(d time.Duration) { vectorStorePollInterval = d }(vectorStorePollInterval)
+	vectorStorePollInterval = time.Millisecond
+
+	server := mockProcessingVectorStore(t, 1, "failed")
+	defer server.Close()
+
+	resp := createVectorStore(t, server.URL, true)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a store that failed processing")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("the created store must stay in state so it is not orphaned")
+	}
+}
+
+func TestVectorStoreCreate_AdoptsExistingByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/vector_stores" && r.URL.Query().Get("after") == "":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object":   "list",
+				"data":     []map[string]interface{}{{"id": "vs_other", "object": "vector_store", "name": "other", "status": "completed"}},
+				"last_id":  "vs_other",
+				"has_more": true,
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/vector_stores":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"object": "list",
+				"data": []map[string]interface{}{{
+					"id": "vs_docs", "object": "vector_store", "name": "docs", "status": "completed", "created_at": 1600000000, "usage_bytes": 4096,
+				}},
+				"has_more": false,
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VectorStoreResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, computed := range []string{"id", "object", "status", "created_at", "expires_at", "last_active_at", "usage_bytes", "estimated_monthly_cost", "file_counts"} {
+		vals[computed] = tftypes.NewValue(objType.AttributeTypes[computed], tftypes.UnknownValue)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "docs")
+	vals["adopt_existing"] = tftypes.NewValue(tftypes.Bool, true)
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(objType, vals)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got VectorStoreResourceModel
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "vs_docs" || got.UsageBytes.ValueInt64() != 4096 {
+		t.Errorf("state = %s/%d, want the adopted vs_docs", got.ID.ValueString(), got.UsageBytes.ValueInt64())
+	}
+}
+
+func TestVectorStoreRead_MetadataAndExpiryRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":             "vs_live",
+			"object":         "vector_store",
+			"name":           "docs",
+			"status":         "completed",
+			"created_at":     1700000000,
+			"usage_bytes":    1024,
+			"metadata":       map[string]interface{}{"team": "search", "env": "prod"},
+			"expires_after":  map[string]interface{}{"anchor": "last_active_at", "days": 7},
+			"expires_at":     1700604800,
+			"last_active_at": 1700000000,
+		})
+	}))
+	defer server.Close()
+
+	// Prior state as left by an import: no metadata and no policy.
+	resp := readVectorStore(t, server.URL, "vs_live")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	ctx := context.Background()
+	var got VectorStoreResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+
+	metadata := map[string]string{}
+	resp.Diagnostics.Append(got.Metadata.ElementsAs(ctx, &metadata, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading state: %v", resp.Diagnostics)
+	}
+	if len(metadata) != 2 || metadata["team"] != "search" || metadata["env"] != "prod" {
+		t.Errorf("metadata = %v, want team and env read back", metadata)
+	}
+	if got.ExpiresAfter == nil || got.ExpiresAfter.Anchor.ValueString() != "last_active_at" || got.ExpiresAfter.Days.ValueInt64() != 7 {
+		t.Errorf("expires_after = %+v, want last_active_at/7 read back", got.ExpiresAfter)
+	}
+	if got.ExpiresAt.ValueInt64() != 1700604800 || got.LastActiveAt.ValueInt64() != 1700000000 {
+		t.Errorf("expires_at=%s last_active_at=%s, want both from the API", got.ExpiresAt, got.LastActiveAt)
+	}
+}
+
+func TestVectorStoreRead_NeverExpiresClearsPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":            "vs_live",
+			"object":        "vector_store",
+			"name":          "docs",
+			"status":        "completed",
+			"created_at":    1700000000,
+			"usage_bytes":   1024,
+			"metadata":      map[string]interface{}{},
+			"expires_after": nil,
+			"expires_at":    nil,
+		})
+	}))
+	defer server.Close()
+
+	prior := vectorStoreState("vs_live")
+	prior.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("search")})
+	prior.ExpiresAfter = &VSExpiresAfterModel{Anchor: types.StringValue("last_active_at"), Days: types.Int64Value(7)}
+	prior.ExpiresAt = types.Int64Value(1700604800)
+
+	resp := readVectorStoreWithState(t, server.URL, prior)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got VectorStoreResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.ExpiresAfter != nil {
+		t.Errorf("expires_after = %+v, want it cleared for a store that never expires", got.ExpiresAfter)
+	}
+	if !got.ExpiresAt.IsNull() {
+		t.Errorf("expires_at = %s, want null", got.ExpiresAt)
+	}
+	if got.Metadata.IsNull() || len(got.Metadata.Elements()) != 0 {
+		t.Errorf("metadata = %s, want an empty map so removed metadata shows as drift", got.Metadata)
+	}
+}
+
+func TestVectorStoreUpdate_RemovingPolicySendsNull(t *testing.T) {
+	var sent map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":          "vs_live",
+			"object":      "vector_store",
+			"name":        "docs",
+			"status":      "completed",
+			"created_at":  1700000000,
+			"usage_bytes": 1024,
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VectorStoreResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	prior := vectorStoreState("vs_live")
+	prior.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("search")})
+	prior.ExpiresAfter = &VSExpiresAfterModel{Anchor: types.StringValue("last_active_at"), Days: types.Int64Value(7)}
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+	resp := &resource.UpdateResponse{State: state}
+	resp.Diagnostics.Append(state.Set(ctx, &prior)...)
+	resp.Diagnostics.Append(plan.Set(ctx, vectorStoreState("vs_live"))...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("building plan and state: %v", resp.Diagnostics)
+	}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if v, ok := sent["expires_after"]; !ok || string(v) != "null" {
+		t.Errorf("expires_after sent = %s (present %v), want an explicit null", v, ok)
+	}
+	if v := string(sent["metadata"]); v != "{}" {
+		t.Errorf("metadata sent = %s, want {} to clear it", v)
+	}
+}
+
+func TestVectorStoreUpdate_MetadataChangeKeepsStore(t *testing.T) {
+	var method, reqPath string
+	var sent map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, reqPath = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":          "vs_live",
+			"object":      "vector_store",
+			"name":        "docs-v2",
+			"status":      "completed",
+			"created_at":  1700000000,
+			"usage_bytes": 1024,
+			"metadata":    map[string]string{"team": "search", "tier": "gold"},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VectorStoreResource{client: newTestOpenAIClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	prior := vectorStoreState("vs_live")
+	prior.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("search")})
+	planned := vectorStoreState("vs_live")
+	planned.Name = types.StringValue("docs-v2")
+	planned.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("search"),
+		"tier": types.StringValue("gold"),
+	})
+	state := tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+	plan := tfsdk.Plan{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)}
+	resp := &resource.UpdateResponse{State: state}
+	resp.Diagnostics.Append(state.Set(ctx, &prior)...)
+	resp.Diagnostics.Append(plan.Set(ctx, &planned)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("building plan and state: %v", resp.Diagnostics)
+	}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if method != http.MethodPost || reqPath != "/v1/vector_stores/vs_live" {
+		t.Errorf("request = %s %s, want POST /v1/vector_stores/vs_live", method, reqPath)
+	}
+	if got := string(sent["metadata"]); got != `{"team":"search","tier":"gold"}` {
+		t.Errorf("metadata sent = %s", got)
+	}
+	if got := string(sent["name"]); got != `"docs-v2"` {
+		t.Errorf("name sent = %s", got)
+	}
+
+	var got VectorStoreResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueString() != "vs_live" {
+		t.Errorf("id = %s, want the store to be updated in place", got.ID)
+	}
+}
+
+func TestVectorStoreSchema_OnlyChunkingStrategyForcesReplacement(t *testing.T) {
+	schemaResp := &resource.SchemaResponse{}
+	(&VectorStoreResource{}).Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	attrs := schemaResp.Schema.Attributes
+
+	for _, name := range []string{"name", "metadata", "expires_after"} {
+		var n int
+		switch a := attrs[name].(type) {
+		case interface{ StringPlanModifiers() []planmodifier.String }:
+			n = len(a.StringPlanModifiers())
+		case interface{ MapPlanModifiers() []planmodifier.Map }:
+			n = len(a.MapPlanModifiers())
+		case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+			n = len(a.ObjectPlanModifiers())
+		}
+		if n != 0 {
+			t.Errorf("%s has plan modifiers; it is updated in place and must not force replacement", name)
+		}
+	}
+
+	cs := attrs["chunking_strategy"].(interface{ ObjectPlanModifiers() []planmodifier.Object })
+	if len(cs.ObjectPlanModifiers()) == 0 {
+		t.Error("chunking_strategy cannot be changed on an existing store and should force replacement")
+	}
+}
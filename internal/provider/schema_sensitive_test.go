@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// TestSchemas_SecretAttributesAreSensitive scans the provider, resource and
+// data source schemas, nested attributes and blocks included, and requires
+// every attribute whose name ends in key, secret, token or value (api_key,
+// admin_key, api_key_value, ...) to be marked sensitive so it is redacted
+// from plan output.
+func TestSchemas_SecretAttributesAreSensitive(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(NewFrameworkProvider("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("GetProviderSchema: %v", resp.Diagnostics)
+	}
+
+	secrets := 0
+	check := func(name string, s *tfprotov6.Schema) {
+		walkSchemaBlock(name, s.Block, func(path string, attr *tfprotov6.SchemaAttribute) {
+			if !isSecretAttributeName(attr.Name) {
+				return
+			}
+			secrets++
+			if !attr.Sensitive {
+				t.Errorf("%s looks like a secret but is not marked Sensitive", path)
+			}
+		})
+	}
+
+	check("provider", resp.Provider)
+	for name, s := range resp.ResourceSchemas {
+		check(name, s)
+	}
+	for name, s := range resp.DataSourceSchemas {
+		check("data."+name, s)
+	}
+	if secrets == 0 {
+		t.Fatal("no secret attributes found; the schema walk is broken")
+	}
+}
+
+// isSecretAttributeName reports whether an attribute name ends in a word
+// that marks it as holding a credential. Names such as api_key_id or
+// max_tokens only refer to one and are not matched, and prompt_cache_key is a
+// caching hint the API names that way, not a credential.
+func isSecretAttributeName(name string) bool {
+	if name == "prompt_cache_key" {
+		return false
+	}
+	words := strings.Split(name, "_")
+	switch words[len(words)-1] {
+	case "key", "secret", "token", "value":
+		return true
+	}
+	return false
+}
+
+func walkSchemaBlock(path string, block *tfprotov6.SchemaBlock, fn func(string, *tfprotov6.SchemaAttribute)) {
+	for _, attr := range block.Attributes {
+		walkSchemaAttribute(path+"."+attr.Name, attr, fn)
+	}
+	for _, nested := range block.BlockTypes {
+		walkSchemaBlock(path+"."+nested.TypeName, nested.Block, fn)
+	}
+}
+
+func walkSchemaAttribute(path string, attr *tfprotov6.SchemaAttribute, fn func(string, *tfprotov6.SchemaAttribute)) {
+	fn(path, attr)
+	if attr.NestedType != nil {
+		for _, nested := range attr.NestedType.Attributes {
+			walkSchemaAttribute(path+"."+nested.Name, nested, fn)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// valueStateUpgrader builds a StateUpgrader for a schema version bump that
+// keeps the existing attributes but changes how values are stored, e.g. 0
+// becoming null for an omitted optional field.
+//
+// priorSchema describes the state as the old provider wrote it. Every one of
+// its attributes must still exist in the current schema with the same type,
+// since the prior state is decoded into the current model M. Attributes
+// added since start out null. migrate rewrites the decoded values in place
+// and the result is saved as the upgraded state.
+//
+// Upgrades that rename, retype or drop attributes need a hand-written
+// upgrader with its own prior model instead; see
+// ProjectUserResource.UpgradeState.
+func valueStateUpgrader[M any](priorSchema schema.Schema, migrate func(ctx context.Context, m *M) diag.Diagnostics) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var values map[string]tftypes.Value
+			if err := req.State.Raw.As(&values); err != nil {
+				resp.Diagnostics.AddError("Error reading prior state", err.Error())
+				return
+			}
+			currentType := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			for name := range values {
+				if _, ok := currentType.AttributeTypes[name]; !ok {
+					resp.Diagnostics.AddError("Error upgrading state",
+						fmt.Sprintf("attribute %q of the prior schema is missing from the current schema", name))
+					return
+				}
+			}
+			for name, typ := range currentType.AttributeTypes {
+				if _, ok := values[name]; !ok {
+					values[name] = tftypes.NewValue(typ, nil)
+				}
+			}
+			prior := tfsdk.State{Schema: resp.State.Schema, Raw: tftypes.NewValue(currentType, values)}
+
+			var m M
+			resp.Diagnostics.Append(prior.Get(ctx, &m)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(migrate(ctx, &m)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+		},
+	}
+}
+
+// nullIfZeroInt64 maps a stored 0 to null, for optional integers that older
+// provider versions wrote as 0 when the API omitted them.
+func nullIfZeroInt64(v types.Int64) types.Int64 {
+	if !v.IsNull() && !v.IsUnknown() && v.ValueInt64() == 0 {
+		return types.Int64Null()
+	}
+	return v
+}
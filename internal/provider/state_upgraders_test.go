@@ -294,3 +294,91 @@ func TestProjectUserUpgradeState_CacheCollapsesAcrossResources(t *testing.T) {
 
 // Sanity: types.Set can be empty without panicking — guards against future regressions.
 var _ = types.Set{}
+
+// ---------- rate_limit upgrader tests ----------
+
+func TestRateLimitUpgradeState_V0ToV1_ZeroOptionalLimitsBecomeNull(t *testing.T) {
+	r := &RateLimitResource{}
+	upgraders := r.UpgradeState(context.Background())
+	currentSch := currentSchema(t, r)
+
+	// v0 state as written for a model whose API entry omitted the image,
+	// batch, audio and daily limits.
+	resp := runUpgrader(t, upgraders, 0, currentSch, map[string]tftypes.Value{
+		"id":                               tftypes.NewValue(tftypes.String, "rl-gpt-4o"),
+		"rate_limit_id":                    tftypes.NewValue(tftypes.String, "rl-gpt-4o"),
+		"project_id":                       tftypes.NewValue(tftypes.String, "proj_xxx"),
+		"model":                            tftypes.NewValue(tftypes.String, "gpt-4o"),
+		"max_requests_per_minute":          tftypes.NewValue(tftypes.Number, 500),
+		"max_tokens_per_minute":            tftypes.NewValue(tftypes.Number, 30000),
+		"max_images_per_minute":            tftypes.NewValue(tftypes.Number, 0),
+		"batch_1_day_max_input_tokens":     tftypes.NewValue(tftypes.Number, 0),
+		"max_audio_megabytes_per_1_minute": tftypes.NewValue(tftypes.Number, 0),
+		"max_requests_per_1_day":           tftypes.NewValue(tftypes.Number, 10000),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrader produced errors: %v", resp.Diagnostics)
+	}
+
+	var got RateLimitResourceModel
+	if d := resp.State.Get(context.Background(), &got); d.HasError() {
+		t.Fatalf("could not read upgraded state: %v", d)
+	}
+
+	if got.ID.ValueString() != "rl-gpt-4o" || got.ProjectID.ValueString() != "proj_xxx" || got.Model.ValueString() != "gpt-4o" {
+		t.Errorf("identity changed: %+v", got)
+	}
+	if got.MaxRequestsPerMinute.ValueInt64() != 500 {
+		t.Errorf("MaxRequestsPerMinute: got %v, want 500", got.MaxRequestsPerMinute)
+	}
+	if got.MaxTokensPerMinute.ValueInt64() != 30000 {
+		t.Errorf("MaxTokensPerMinute: got %v, want 30000", got.MaxTokensPerMinute)
+	}
+	if got.MaxRequestsPer1Day.ValueInt64() != 10000 {
+		t.Errorf("MaxRequestsPer1Day: got %v, want 10000", got.MaxRequestsPer1Day)
+	}
+	for name, v := range map[string]types.Int64{
+		"max_images_per_minute":            got.MaxImagesPerMinute,
+		"batch_1_day_max_input_tokens":     got.Batch1DayMaxInputTokens,
+		"max_audio_megabytes_per_1_minute": got.MaxAudioMegabytesPer1Minute,
+	} {
+		if !v.IsNull() {
+			t.Errorf("%s: got %v, want null", name, v)
+		}
+	}
+	if !got.APIKey.IsNull() {
+		t.Errorf("api_key: got %v, want null since v0 had no such attribute", got.APIKey)
+	}
+}
+
+func TestRateLimitUpgradeState_V0ToV1_NullStaysNull(t *testing.T) {
+	r := &RateLimitResource{}
+	upgraders := r.UpgradeState(context.Background())
+	currentSch := currentSchema(t, r)
+
+	resp := runUpgrader(t, upgraders, 0, currentSch, map[string]tftypes.Value{
+		"id":                               tftypes.NewValue(tftypes.String, "rl-gpt-4o"),
+		"rate_limit_id":                    tftypes.NewValue(tftypes.String, "rl-gpt-4o"),
+		"project_id":                       tftypes.NewValue(tftypes.String, "proj_xxx"),
+		"model":                            tftypes.NewValue(tftypes.String, "gpt-4o"),
+		"max_requests_per_minute":          tftypes.NewValue(tftypes.Number, nil),
+		"max_tokens_per_minute":            tftypes.NewValue(tftypes.Number, nil),
+		"max_images_per_minute":            tftypes.NewValue(tftypes.Number, nil),
+		"batch_1_day_max_input_tokens":     tftypes.NewValue(tftypes.Number, nil),
+		"max_audio_megabytes_per_1_minute": tftypes.NewValue(tftypes.Number, nil),
+		"max_requests_per_1_day":           tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrader produced errors: %v", resp.Diagnostics)
+	}
+
+	var got RateLimitResourceModel
+	if d := resp.State.Get(context.Background(), &got); d.HasError() {
+		t.Fatalf("could not read upgraded state: %v", d)
+	}
+	if !got.MaxRequestsPerMinute.IsNull() || !got.MaxImagesPerMinute.IsNull() {
+		t.Errorf("null limits should stay null: %+v", got)
+	}
+}
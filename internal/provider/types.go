@@ -15,8 +15,11 @@ type FileResponse struct {
 
 // ListFilesResponse represents the API response for listing OpenAI files
 type ListFilesResponse struct {
-	Data   []FileResponse `json:"data"`
-	Object string         `json:"object"`
+	Data    []FileResponse `json:"data"`
+	Object  string         `json:"object"`
+	FirstID string         `json:"first_id"`
+	LastID  string         `json:"last_id"`
+	HasMore bool           `json:"has_more"`
 }
 
 // ErrorResponse represents an error response from the OpenAI API.
@@ -41,14 +41,16 @@ type TextToSpeechRequest struct {
 	Instructions   string  `json:"instructions,omitempty"`
 }
 
-// ImageGenerationResponseFramework represents the API response for image generation.
-type ImageGenerationResponseFramework struct {
-	Created int64                          `json:"created"`
-	Data    []ImageGenerationDataFramework `json:"data"`
+// ImageResponseFramework represents the API response for image generation,
+// edits and variations.
+type ImageResponseFramework struct {
+	Created int64                `json:"created"`
+	Data    []ImageDataFramework `json:"data"`
 }
 
-// ImageGenerationDataFramework represents a single generated image.
-type ImageGenerationDataFramework struct {
+// ImageDataFramework represents a single returned image. RevisedPrompt is
+// only set by image generation.
+type ImageDataFramework struct {
 	URL           string `json:"url,omitempty"`
 	B64JSON       string `json:"b64_json,omitempty"`
 	RevisedPrompt string `json:"revised_prompt,omitempty"`
@@ -64,28 +66,10 @@ type ImageGenerationRequest struct {
 	Size           string `json:"size,omitempty"`
 	Style          string `json:"style,omitempty"`
 	User           string `json:"user,omitempty"`
-}
-
-// ImageEditResponseFramework represents the API response for image editing.
-type ImageEditResponseFramework struct {
-	Created int64                    `json:"created"`
-	Data    []ImageEditDataFramework `json:"data"`
-}
-
-// ImageEditDataFramework represents a single edited image.
-type ImageEditDataFramework struct {
-	URL     string `json:"url,omitempty"`
-	B64JSON string `json:"b64_json,omitempty"`
-}
-
-// ImageVariationResponseFramework represents the API response for image variations.
-type ImageVariationResponseFramework struct {
-	Created int64                         `json:"created"`
-	Data    []ImageVariationDataFramework `json:"data"`
-}
 
-// ImageVariationDataFramework represents a single image variation.
-type ImageVariationDataFramework struct {
-	URL     string `json:"url,omitempty"`
-	B64JSON string `json:"b64_json,omitempty"`
+	// gpt-image models only
+	Background        string `json:"background,omitempty"`
+	Moderation        string `json:"moderation,omitempty"`
+	OutputFormat      string `json:"output_format,omitempty"`
+	OutputCompression *int64 `json:"output_compression,omitempty"`
 }
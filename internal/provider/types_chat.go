@@ -16,9 +16,21 @@ type ChatCompletionResponse struct {
 // ChatCompletionChoice represents a single completion option from the model.
 // It contains the generated message and information about why the completion finished.
 type ChatCompletionChoice struct {
-	Index        int                   `json:"index"`         // Index of the choice in the list
-	Message      ChatCompletionMessage `json:"message"`       // The generated message
-	FinishReason string                `json:"finish_reason"` // Reason why the completion finished
+	Index        int                     `json:"index"`              // Index of the choice in the list
+	Message      ChatCompletionMessage   `json:"message"`            // The generated message
+	FinishReason string                  `json:"finish_reason"`      // Reason why the completion finished
+	Logprobs     *ChatCompletionLogprobs `json:"logprobs,omitempty"` // Token log probabilities, when requested
+}
+
+// ChatCompletionLogprobs holds the per-token log probabilities of a choice.
+type ChatCompletionLogprobs struct {
+	Content []ChatTokenLogprob `json:"content"` // Log probabilities of the content tokens
+}
+
+// ChatTokenLogprob is the log probability of a single output token.
+type ChatTokenLogprob struct {
+	Token   string  `json:"token"`   // The token
+	Logprob float64 `json:"logprob"` // Log probability of the token
 }
 
 // ChatCompletionMessage represents a message in the chat completion.
@@ -49,9 +61,17 @@ type ChatFunctionCall struct {
 // ChatCompletionUsage represents token usage statistics for the completion request.
 // It tracks the number of tokens used in the prompt and completion.
 type ChatCompletionUsage struct {
-	PromptTokens     int `json:"prompt_tokens"`     // Number of tokens in the prompt
-	CompletionTokens int `json:"completion_tokens"` // Number of tokens in the completion
-	TotalTokens      int `json:"total_tokens"`      // Total number of tokens used
+	PromptTokens            int                `json:"prompt_tokens"`                       // Number of tokens in the prompt
+	CompletionTokens        int                `json:"completion_tokens"`                   // Number of tokens in the completion
+	TotalTokens             int                `json:"total_tokens"`                        // Total number of tokens used
+	PromptTokensDetails     *ChatTokensDetails `json:"prompt_tokens_details,omitempty"`     // Breakdown of the prompt tokens
+	CompletionTokensDetails *ChatTokensDetails `json:"completion_tokens_details,omitempty"` // Breakdown of the completion tokens
+}
+
+// ChatTokensDetails breaks prompt or completion tokens down by kind.
+type ChatTokensDetails struct {
+	AudioTokens  int `json:"audio_tokens"`  // Audio tokens, billed at the model's audio rate
+	CachedTokens int `json:"cached_tokens"` // Prompt tokens served from the cache
 }
 
 // ChatCompletionRequest represents the request payload for creating a chat completion.
@@ -67,14 +87,78 @@ type ChatCompletionRequest struct {
 	TopP             float64                 `json:"top_p,omitempty"`             // Nucleus sampling parameter
 	N                int                     `json:"n,omitempty"`                 // Number of completions to generate
 	Stream           bool                    `json:"stream,omitempty"`            // Whether to stream the response
+	StreamOptions    *ChatStreamOptions      `json:"stream_options,omitempty"`    // Options for streamed responses
+	Seed             *int64                  `json:"seed,omitempty"`              // Seed for best-effort deterministic sampling
 	Stop             []string                `json:"stop,omitempty"`              // Optional stop sequences
 	MaxTokens        int                     `json:"max_tokens,omitempty"`        // Maximum tokens to generate
 	PresencePenalty  float64                 `json:"presence_penalty,omitempty"`  // Presence penalty parameter
 	FrequencyPenalty float64                 `json:"frequency_penalty,omitempty"` // Frequency penalty parameter
 	LogitBias        map[string]float64      `json:"logit_bias,omitempty"`        // Optional token bias
-	User             string                  `json:"user,omitempty"`              // Optional user identifier
+	Logprobs         bool                    `json:"logprobs,omitempty"`          // Whether to return token log probabilities
+	User             string                  `json:"user,omitempty"`              // Deprecated end-user identifier; use SafetyIdentifier
+	SafetyIdentifier string                  `json:"safety_identifier,omitempty"` // Stable end-user identifier for abuse detection
+	PromptCacheKey   string                  `json:"prompt_cache_key,omitempty"`  // Groups similar requests to improve cache hits
 	Store            bool                    `json:"store,omitempty"`             // Whether to store the completion
 	Metadata         map[string]string       `json:"metadata,omitempty"`          // Optional metadata for filtering
+	ResponseFormat   *ChatResponseFormat     `json:"response_format,omitempty"`   // Optional output format constraint
+}
+
+// ChatStreamOptions configures a streamed chat completion.
+type ChatStreamOptions struct {
+	IncludeUsage bool `json:"include_usage"` // Send token usage in a final chunk with no choices
+}
+
+// ChatCompletionChunk is one server-sent event of a streamed chat
+// completion. Usage is only set on the final chunk, and only when
+// ChatStreamOptions.IncludeUsage was requested.
+type ChatCompletionChunk struct {
+	ID      string                      `json:"id"`              // Identifier shared by every chunk of the completion
+	Object  string                      `json:"object"`          // Always "chat.completion.chunk"
+	Created int                         `json:"created"`         // Unix timestamp when the completion was created
+	Model   string                      `json:"model"`           // Model used for the completion
+	Choices []ChatCompletionChunkChoice `json:"choices"`         // Deltas for the choices, by index
+	Usage   *ChatCompletionUsage        `json:"usage,omitempty"` // Token usage, on the final chunk
+}
+
+// ChatCompletionChunkChoice is the part of one choice carried by a chunk.
+type ChatCompletionChunkChoice struct {
+	Index        int                     `json:"index"`              // Index of the choice
+	Delta        ChatCompletionDelta     `json:"delta"`              // The message fragment
+	FinishReason string                  `json:"finish_reason"`      // Set on the choice's last chunk
+	Logprobs     *ChatCompletionLogprobs `json:"logprobs,omitempty"` // Log probabilities of the fragment's tokens
+}
+
+// ChatCompletionDelta is a fragment of the generated message.
+type ChatCompletionDelta struct {
+	Role         string              `json:"role,omitempty"`          // Set on the first chunk of a choice
+	Content      string              `json:"content,omitempty"`       // Text to append to the content
+	FunctionCall *ChatFunctionCall   `json:"function_call,omitempty"` // Deprecated: fragment of a function call
+	ToolCalls    []ChatToolCallDelta `json:"tool_calls,omitempty"`    // Fragments of tool calls
+}
+
+// ChatToolCallDelta is a fragment of a tool call. The ID, type and name
+// arrive on the call's first fragment; later fragments carry only Index and
+// more of the arguments.
+type ChatToolCallDelta struct {
+	Index    int              `json:"index"`
+	ID       string           `json:"id,omitempty"`
+	Type     string           `json:"type,omitempty"`
+	Function ChatFunctionCall `json:"function"`
+}
+
+// ChatResponseFormat constrains the format of the model's output.
+// JSONSchema is only set when Type is "json_schema".
+type ChatResponseFormat struct {
+	Type       string          `json:"type"`                  // text, json_object or json_schema
+	JSONSchema *ChatJSONSchema `json:"json_schema,omitempty"` // Schema for Structured Outputs
+}
+
+// ChatJSONSchema is the schema the output must match for Structured Outputs.
+type ChatJSONSchema struct {
+	Name        string          `json:"name"`                  // Name of the schema
+	Description string          `json:"description,omitempty"` // Optional description of the output
+	Schema      json.RawMessage `json:"schema"`                // JSON Schema object
+	Strict      *bool           `json:"strict,omitempty"`      // Whether to enforce the schema exactly
 }
 
 // ChatFunction represents a function that can be called by the model.
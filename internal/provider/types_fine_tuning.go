@@ -20,6 +20,14 @@ type FineTuningJobResponse struct {
 	DatasetID       string                   `json:"dataset_id,omitempty"` // New field often appearing
 	Estimator       string                   `json:"estimator,omitempty"`
 	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	Error           *FineTuningJobError      `json:"error,omitempty"`
+}
+
+// FineTuningJobError describes why a fine-tuning job failed.
+type FineTuningJobError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Param   string `json:"param,omitempty"`
 }
 
 type HyperparametersResponse struct {
@@ -84,3 +92,22 @@ type WandBIntegration struct {
 	Name    string   `json:"name,omitempty"`
 	Tags    []string `json:"tags,omitempty"`
 }
+
+// FineTuningMetricsEventData is the data of a "metrics" event. The
+// validation fields are only present when the job has a validation file,
+// and the full_valid ones only on the final step.
+type FineTuningMetricsEventData struct {
+	Step                       int64    `json:"step"`
+	TrainLoss                  *float64 `json:"train_loss,omitempty"`
+	ValidLoss                  *float64 `json:"valid_loss,omitempty"`
+	ValidMeanTokenAccuracy     *float64 `json:"valid_mean_token_accuracy,omitempty"`
+	FullValidLoss              *float64 `json:"full_valid_loss,omitempty"`
+	FullValidMeanTokenAccuracy *float64 `json:"full_valid_mean_token_accuracy,omitempty"`
+}
+
+// FineTuningJobListResponse represents a page of fine-tuning jobs.
+type FineTuningJobListResponse struct {
+	Object  string                  `json:"object"`
+	Data    []FineTuningJobResponse `json:"data"`
+	HasMore bool                    `json:"has_more"`
+}
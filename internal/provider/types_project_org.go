@@ -62,8 +62,9 @@ type APIKeyServiceAccount struct {
 
 // AdminAPIKeyCreateRequest represents the request to create an admin API key.
 type AdminAPIKeyCreateRequest struct {
-	Name   string   `json:"name"`
-	Scopes []string `json:"scopes,omitempty"`
+	Name      string   `json:"name"`
+	ExpiresAt *int64   `json:"expires_at,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
 }
 
 // ProjectGroupResponseFramework represents the API response for a project group.
@@ -58,14 +58,15 @@ type StaticChunking struct {
 
 // VectorStoreFileResponse represents a file inside a vector store.
 type VectorStoreFileResponse struct {
-	ID               string            `json:"id"`
-	Object           string            `json:"object"`
-	UsageBytes       int64             `json:"usage_bytes"`
-	CreatedAt        int64             `json:"created_at"`
-	VectorStoreID    string            `json:"vector_store_id"`
-	Status           string            `json:"status"`
-	LastError        *LastError        `json:"last_error,omitempty"`
-	ChunkingStrategy *ChunkingStrategy `json:"chunking_strategy,omitempty"`
+	ID               string                 `json:"id"`
+	Object           string                 `json:"object"`
+	UsageBytes       int64                  `json:"usage_bytes"`
+	CreatedAt        int64                  `json:"created_at"`
+	VectorStoreID    string                 `json:"vector_store_id"`
+	Status           string                 `json:"status"`
+	LastError        *LastError             `json:"last_error,omitempty"`
+	ChunkingStrategy *ChunkingStrategy      `json:"chunking_strategy,omitempty"`
+	Attributes       map[string]interface{} `json:"attributes,omitempty"` // May hold numbers and booleans
 }
 
 type LastError struct {
@@ -77,6 +78,7 @@ type LastError struct {
 type VectorStoreFileCreateRequest struct {
 	FileID           string            `json:"file_id"`
 	ChunkingStrategy *ChunkingStrategy `json:"chunking_strategy,omitempty"`
+	Attributes       map[string]string `json:"attributes,omitempty"`
 }
 
 // VectorStoreFileBatchResponse represents a batch of files.
@@ -0,0 +1,6 @@
+// Package version holds the provider version, set at build time with
+// -ldflags "-X github.com/mkdev-me/terraform-provider-openai/internal/version.Version=<version>".
+package version
+
+// Version is the provider version. Release builds set it through goreleaser.
+var Version = "0.0.0-dev"
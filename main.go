@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/mkdev-me/terraform-provider-openai/internal/provider"
+	"github.com/mkdev-me/terraform-provider-openai/internal/version"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -14,15 +15,6 @@ import (
 //go:generate terraform fmt -recursive ./examples/
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
-var (
-	// these will be set by the goreleaser configuration
-	// to appropriate values for the compiled binary.
-	version string = "0.0.0-dev"
-
-	// goreleaser can also tag the specific commit that release was built on.
-	// commit  string = ""
-)
-
 func main() {
 	var debug bool
 
@@ -33,7 +25,7 @@ func main() {
 	flag.Parse()
 
 	if printVersion {
-		log.Println(version)
+		log.Println(version.Version)
 		return
 	}
 
@@ -42,7 +34,7 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.NewFrameworkProvider(version), opts)
+	err := providerserver.Serve(context.Background(), provider.NewFrameworkProvider(version.Version), opts)
 
 	if err != nil {
 		log.Fatal(err.Error())